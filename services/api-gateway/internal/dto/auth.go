@@ -2,16 +2,21 @@ package dto
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliirah/task-flow/services/api-gateway/internal/service"
+	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SignUpPayload struct {
 	Email     string `json:"email" validate:"required,email"`
-	Password  string `json:"password" validate:"required,min=8"`
+	Password  string `json:"password" validate:"required"`
 	FirstName string `json:"firstName" validate:"required,min=2"`
 	LastName  string `json:"lastName" validate:"required,min=2"`
 	UserType  string `json:"userType" validate:"omitempty,oneof=user admin"`
+	// InvitationToken comes from an organization invitation email
+	InvitationToken string `json:"invitationToken" validate:"omitempty,max=256"`
 }
 
 func (p SignUpPayload) Build() service.AuthSignUpRequest {
@@ -25,12 +30,14 @@ func (p SignUpPayload) Build() service.AuthSignUpRequest {
 		FirstName: strings.TrimSpace(p.FirstName),
 		LastName:  strings.TrimSpace(p.LastName),
 		UserType:  userType,
+
+		InvitationToken: strings.TrimSpace(p.InvitationToken),
 	}
 }
 
 type LoginPayload struct {
 	Identifier string `json:"identifier" validate:"required"`
-	Password   string `json:"password" validate:"required,min=8"`
+	Password   string `json:"password" validate:"required"`
 }
 
 func (p LoginPayload) Build() service.AuthLoginRequest {
@@ -55,3 +62,69 @@ type LogoutPayload struct {
 func (p LogoutPayload) Build() service.AuthLogoutRequest {
 	return service.AuthLogoutRequest{RefreshToken: strings.TrimSpace(p.RefreshToken)}
 }
+
+type ForgotPasswordPayload struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (p ForgotPasswordPayload) Build() string {
+	return strings.TrimSpace(p.Email)
+}
+
+type ResetPasswordPayload struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required"`
+}
+
+type ChangePasswordPayload struct {
+	CurrentPassword string `json:"currentPassword" validate:"required"`
+	NewPassword     string `json:"newPassword" validate:"required"`
+}
+
+type VerifyEmailPayload struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type ResendVerificationPayload struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+func (p ResendVerificationPayload) Build() string {
+	return strings.TrimSpace(p.Email)
+}
+
+type TOTPCodePayload struct {
+	Code string `json:"code" validate:"required"`
+}
+
+func (p TOTPCodePayload) Build() string {
+	return strings.TrimSpace(p.Code)
+}
+
+type MFAChallengePayload struct {
+	MFAToken string `json:"mfaToken" validate:"required"`
+}
+
+type MFAVerifyPayload struct {
+	MFAToken string `json:"mfaToken" validate:"required"`
+	Code     string `json:"code" validate:"required"`
+}
+
+type CreateAPITokenPayload struct {
+	Name      string     `json:"name" validate:"required,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,required"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (p CreateAPITokenPayload) Build(kind, organizationID string) *authpb.CreateAPITokenRequest {
+	req := &authpb.CreateAPITokenRequest{
+		Kind:           kind,
+		Name:           strings.TrimSpace(p.Name),
+		Scopes:         p.Scopes,
+		OrganizationId: organizationID,
+	}
+	if p.ExpiresAt != nil {
+		req.ExpiresAt = timestamppb.New(*p.ExpiresAt)
+	}
+	return req
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type OrganizationCreatePayload struct {
@@ -20,8 +21,13 @@ func (p OrganizationCreatePayload) Build(ownerID string) *organizationpb.CreateO
 }
 
 type OrganizationUpdatePayload struct {
-	Name        *string `json:"name" validate:"omitempty,min=2"`
-	Description *string `json:"description" validate:"omitempty,max=1024"`
+	Name                 *string `json:"name" validate:"omitempty,min=2"`
+	Description          *string `json:"description" validate:"omitempty,max=1024"`
+	RequireVerifiedEmail *bool   `json:"requireVerifiedEmail"`
+	RequireTwoFactor     *bool   `json:"requireTwoFactor"`
+	PasswordMinLength    *int32  `json:"passwordMinLength" validate:"omitempty,min=0,max=72"`
+	PasswordMinClasses   *int32  `json:"passwordMinClasses" validate:"omitempty,min=0,max=4"`
+	PasswordHistory      *int32  `json:"passwordHistory" validate:"omitempty,min=0,max=24"`
 }
 
 func (p OrganizationUpdatePayload) Build(id string) *organizationpb.UpdateOrganizationRequest {
@@ -32,12 +38,49 @@ func (p OrganizationUpdatePayload) Build(id string) *organizationpb.UpdateOrgani
 	if p.Description != nil {
 		req.Description = strings.TrimSpace(*p.Description)
 	}
+	if p.RequireVerifiedEmail != nil {
+		req.RequireVerifiedEmail = wrapperspb.Bool(*p.RequireVerifiedEmail)
+	}
+	if p.RequireTwoFactor != nil {
+		req.RequireTwoFactor = wrapperspb.Bool(*p.RequireTwoFactor)
+	}
+	if p.PasswordMinLength != nil {
+		req.PasswordMinLength = wrapperspb.Int32(*p.PasswordMinLength)
+	}
+	if p.PasswordMinClasses != nil {
+		req.PasswordMinClasses = wrapperspb.Int32(*p.PasswordMinClasses)
+	}
+	if p.PasswordHistory != nil {
+		req.PasswordHistory = wrapperspb.Int32(*p.PasswordHistory)
+	}
 	return req
 }
 
+type OrganizationDeletePayload struct {
+	ConfirmName string `json:"confirmName" validate:"required"`
+}
+
+func (p OrganizationDeletePayload) Build(orgID string) *organizationpb.DeleteOrganizationRequest {
+	return &organizationpb.DeleteOrganizationRequest{
+		Id:          orgID,
+		ConfirmName: strings.TrimSpace(p.ConfirmName),
+	}
+}
+
+type OrganizationTransferPayload struct {
+	NewOwnerID string `json:"newOwnerId" validate:"required,uuid4"`
+}
+
+func (p OrganizationTransferPayload) Build(orgID string) *organizationpb.TransferOwnershipRequest {
+	return &organizationpb.TransferOwnershipRequest{
+		OrganizationId: orgID,
+		NewOwnerId:     strings.TrimSpace(p.NewOwnerID),
+	}
+}
+
 type OrganizationAddMemberPayload struct {
 	UserID string `json:"userId" validate:"required,uuid4"`
-	Role   string `json:"role" validate:"omitempty,oneof=owner admin member"`
+	Role   string `json:"role" validate:"omitempty,oneof=admin member guest"`
 }
 
 func (p OrganizationAddMemberPayload) Build(orgID string) *organizationpb.AddMemberRequest {
@@ -47,3 +90,161 @@ func (p OrganizationAddMemberPayload) Build(orgID string) *organizationpb.AddMem
 		Role:           strings.TrimSpace(p.Role),
 	}
 }
+
+type OrganizationUpdateMemberRolePayload struct {
+	Role string `json:"role" validate:"required,oneof=admin member guest"`
+}
+
+func (p OrganizationUpdateMemberRolePayload) Build(orgID, userID string) *organizationpb.UpdateMemberRoleRequest {
+	return &organizationpb.UpdateMemberRoleRequest{
+		OrganizationId: orgID,
+		UserId:         userID,
+		Role:           strings.TrimSpace(p.Role),
+	}
+}
+
+type OrganizationInvitePayload struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role" validate:"omitempty,oneof=admin member guest"`
+}
+
+func (p OrganizationInvitePayload) Build(orgID string) *organizationpb.CreateInvitationRequest {
+	return &organizationpb.CreateInvitationRequest{
+		OrganizationId: orgID,
+		Email:          strings.TrimSpace(p.Email),
+		Role:           strings.TrimSpace(p.Role),
+	}
+}
+
+type InvitationTokenPayload struct {
+	Token string `json:"token" validate:"required"`
+}
+
+type TeamCreatePayload struct {
+	Name        string   `json:"name" validate:"required,max=100"`
+	Description string   `json:"description" validate:"omitempty,max=1024"`
+	LeadID      string   `json:"leadId" validate:"omitempty,uuid4"`
+	MemberIDs   []string `json:"memberIds" validate:"omitempty,dive,uuid4"`
+}
+
+func (p TeamCreatePayload) Build(orgID string) *organizationpb.CreateTeamRequest {
+	return &organizationpb.CreateTeamRequest{
+		OrganizationId: orgID,
+		Name:           strings.TrimSpace(p.Name),
+		Description:    strings.TrimSpace(p.Description),
+		LeadId:         strings.TrimSpace(p.LeadID),
+		MemberIds:      p.MemberIDs,
+	}
+}
+
+type TeamUpdatePayload struct {
+	Name        *string `json:"name" validate:"omitempty,min=1,max=100"`
+	Description *string `json:"description" validate:"omitempty,max=1024"`
+	// LeadID replaces the team lead, an empty string removes it
+	LeadID *string `json:"leadId"`
+}
+
+func (p TeamUpdatePayload) Build(orgID, teamID string) *organizationpb.UpdateTeamRequest {
+	req := &organizationpb.UpdateTeamRequest{OrganizationId: orgID, Id: teamID}
+	if p.Name != nil {
+		req.Name = wrapperspb.String(strings.TrimSpace(*p.Name))
+	}
+	if p.Description != nil {
+		req.Description = wrapperspb.String(strings.TrimSpace(*p.Description))
+	}
+	if p.LeadID != nil {
+		req.LeadId = wrapperspb.String(strings.TrimSpace(*p.LeadID))
+	}
+	return req
+}
+
+type TeamMemberPayload struct {
+	UserID string `json:"userId" validate:"required,uuid4"`
+}
+
+func (p TeamMemberPayload) Build(orgID, teamID string) *organizationpb.TeamMemberRequest {
+	return &organizationpb.TeamMemberRequest{
+		OrganizationId: orgID,
+		TeamId:         teamID,
+		UserId:         strings.TrimSpace(p.UserID),
+	}
+}
+
+type OrganizationSettingsPayload struct {
+	// Version is the settings version the client last read, the update is
+	// rejected when they changed since. Zero skips the check.
+	Version int64 `json:"version" validate:"omitempty,min=0"`
+	Tasks   struct {
+		DefaultPriority string   `json:"defaultPriority" validate:"omitempty,oneof=low medium high critical"`
+		AllowedTypes    []string `json:"allowedTypes" validate:"omitempty,dive,oneof=task story sub-task"`
+	} `json:"tasks"`
+	Notifications struct {
+		MutedEvents []string `json:"mutedEvents"`
+	} `json:"notifications"`
+	Security struct {
+		RequireTwoFactor bool `json:"requireTwoFactor"`
+	} `json:"security"`
+	Membership struct {
+		RequireApproval bool `json:"requireApproval"`
+	} `json:"membership"`
+	Retention struct {
+		// Zero keeps the default period
+		ArchivedTaskDays int32 `json:"archivedTaskDays" validate:"omitempty,min=1,max=365"`
+	} `json:"retention"`
+}
+
+func (p OrganizationSettingsPayload) Build(orgID string) *organizationpb.UpdateOrganizationSettingsRequest {
+	return &organizationpb.UpdateOrganizationSettingsRequest{
+		OrganizationId:  orgID,
+		ExpectedVersion: p.Version,
+		Settings: &organizationpb.OrganizationSettings{
+			Tasks: &organizationpb.OrganizationTaskSettings{
+				DefaultPriority: strings.TrimSpace(p.Tasks.DefaultPriority),
+				AllowedTypes:    p.Tasks.AllowedTypes,
+			},
+			Notifications: &organizationpb.OrganizationNotificationSettings{
+				MutedEvents: p.Notifications.MutedEvents,
+			},
+			Security: &organizationpb.OrganizationSecuritySettings{
+				RequireTwoFactor: p.Security.RequireTwoFactor,
+			},
+			Membership: &organizationpb.OrganizationMembershipSettings{
+				RequireApproval: p.Membership.RequireApproval,
+			},
+			Retention: &organizationpb.OrganizationRetentionSettings{
+				ArchivedTaskDays: p.Retention.ArchivedTaskDays,
+			},
+		},
+	}
+}
+
+type DomainCreatePayload struct {
+	Domain      string `json:"domain" validate:"required,max=253"`
+	DefaultRole string `json:"defaultRole" validate:"omitempty,oneof=admin member guest"`
+	AutoJoin    bool   `json:"autoJoin"`
+}
+
+func (p DomainCreatePayload) Build(orgID string) *organizationpb.AddDomainRequest {
+	return &organizationpb.AddDomainRequest{
+		OrganizationId: orgID,
+		Domain:         strings.TrimSpace(p.Domain),
+		DefaultRole:    strings.TrimSpace(p.DefaultRole),
+		AutoJoin:       p.AutoJoin,
+	}
+}
+
+type DomainUpdatePayload struct {
+	DefaultRole *string `json:"defaultRole" validate:"omitempty,oneof=admin member guest"`
+	AutoJoin    *bool   `json:"autoJoin"`
+}
+
+func (p DomainUpdatePayload) Build(orgID, domainID string) *organizationpb.UpdateDomainRequest {
+	req := &organizationpb.UpdateDomainRequest{OrganizationId: orgID, Id: domainID}
+	if p.DefaultRole != nil {
+		req.DefaultRole = wrapperspb.String(strings.TrimSpace(*p.DefaultRole))
+	}
+	if p.AutoJoin != nil {
+		req.AutoJoin = wrapperspb.Bool(*p.AutoJoin)
+	}
+	return req
+}
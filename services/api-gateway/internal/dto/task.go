@@ -50,6 +50,7 @@ type CreateTaskPayload struct {
 	Type           string  `json:"type" validate:"omitempty,oneof=task story sub-task"`
 	OrganizationID string  `json:"organizationId" validate:"required,uuid4"`
 	AssigneeID     *string `json:"assigneeId" validate:"omitempty,uuid4"`
+	AssigneeTeamID *string `json:"assigneeTeamId" validate:"omitempty,uuid4"`
 	ReporterID     *string `json:"reporterId" validate:"omitempty,uuid4"`
 	ParentTaskID   *string `json:"parentTaskId" validate:"omitempty,uuid4"`
 	DisplayOrder   int     `json:"displayOrder" validate:"omitempty"`
@@ -61,7 +62,8 @@ func (p CreateTaskPayload) Build(defaultReporterID string) (*taskpb.CreateTaskRe
 	if err != nil {
 		return nil, err
 	}
-	priority, err := stringset.Normalize(p.Priority, "priority", taskdomain.PrioritySet, "medium")
+	// An empty priority gets the organization's default
+	priority, err := stringset.Normalize(p.Priority, "priority", taskdomain.PrioritySet, "")
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +90,11 @@ func (p CreateTaskPayload) Build(defaultReporterID string) (*taskpb.CreateTaskRe
 		assigneeID = strings.TrimSpace(*p.AssigneeID)
 	}
 
+	assigneeTeamID := ""
+	if p.AssigneeTeamID != nil {
+		assigneeTeamID = strings.TrimSpace(*p.AssigneeTeamID)
+	}
+
 	parentTaskID := ""
 	if p.ParentTaskID != nil {
 		parentTaskID = strings.TrimSpace(*p.ParentTaskID)
@@ -106,6 +113,7 @@ func (p CreateTaskPayload) Build(defaultReporterID string) (*taskpb.CreateTaskRe
 		Type:           taskType,
 		OrganizationId: p.OrganizationID,
 		AssigneeId:     assigneeID,
+		AssigneeTeamId: assigneeTeamID,
 		ReporterId:     reporterID,
 		ParentTaskId:   parentTaskID,
 		DisplayOrder:   int32(p.DisplayOrder),
@@ -124,6 +132,8 @@ type UpdateTaskPayload struct {
 	Type           *string `json:"type" validate:"omitempty,oneof=task story sub-task"`
 	OrganizationID *string `json:"organizationId" validate:"omitempty,uuid4"`
 	AssigneeID     *string `json:"assigneeId" validate:"omitempty,uuid4"`
+	// AssigneeTeamID replaces the assigned team, an empty string removes it
+	AssigneeTeamID *string `json:"assigneeTeamId" validate:"omitempty,uuid4"`
 	ReporterID     *string `json:"reporterId" validate:"omitempty,uuid4"`
 	ParentTaskID   *string `json:"parentTaskId" validate:"omitempty,uuid4"`
 	DisplayOrder   *int    `json:"displayOrder" validate:"omitempty"`
@@ -181,6 +191,9 @@ func (p UpdateTaskPayload) Build(id string) (*taskpb.UpdateTaskRequest, error) {
 			req.AssigneeId = wrapperspb.String(trimmed)
 		}
 	}
+	if p.AssigneeTeamID != nil {
+		req.AssigneeTeamId = wrapperspb.String(strings.TrimSpace(*p.AssigneeTeamID))
+	}
 	if p.ReporterID != nil {
 		trimmed := strings.TrimSpace(*p.ReporterID)
 		if trimmed != "" {
@@ -231,3 +244,66 @@ func (p ReorderTasksPayload) Build() (*taskpb.ReorderTasksRequest, error) {
 		Tasks:          tasks,
 	}, nil
 }
+
+// AddChecklistItemPayload is the HTTP payload for adding a checklist item.
+type AddChecklistItemPayload struct {
+	Text string `json:"text" validate:"required,min=1,max=512"`
+}
+
+func (p AddChecklistItemPayload) Build(taskID string) *taskpb.AddChecklistItemRequest {
+	return &taskpb.AddChecklistItemRequest{
+		TaskId: taskID,
+		Text:   strings.TrimSpace(p.Text),
+	}
+}
+
+// ToggleChecklistItemPayload is the HTTP payload for toggling a checklist item.
+// Omitting done flips the current state.
+type ToggleChecklistItemPayload struct {
+	Done *bool `json:"done"`
+}
+
+func (p ToggleChecklistItemPayload) Build(id string) *taskpb.ToggleChecklistItemRequest {
+	req := &taskpb.ToggleChecklistItemRequest{Id: id}
+	if p.Done != nil {
+		req.Done = wrapperspb.Bool(*p.Done)
+	}
+	return req
+}
+
+// ReorderChecklistPayload is the HTTP payload for reordering a checklist.
+type ReorderChecklistPayload struct {
+	ItemIDs []string `json:"itemIds" validate:"required,dive,uuid4"`
+}
+
+func (p ReorderChecklistPayload) Build(taskID string) *taskpb.ReorderChecklistItemsRequest {
+	return &taskpb.ReorderChecklistItemsRequest{
+		TaskId:  taskID,
+		ItemIds: p.ItemIDs,
+	}
+}
+
+// ShareTaskPayload is the HTTP payload for sharing a task with a guest.
+type ShareTaskPayload struct {
+	UserID string `json:"userId" validate:"required,uuid4"`
+}
+
+// CloneTaskPayload is the HTTP payload for cloning a task.
+type CloneTaskPayload struct {
+	Title                string `json:"title" validate:"omitempty,min=3"`
+	TargetOrganizationID string `json:"targetOrganizationId" validate:"omitempty,uuid4"`
+	IncludeSubtasks      bool   `json:"includeSubtasks"`
+	IncludeChecklist     bool   `json:"includeChecklist"`
+	IncludeComments      bool   `json:"includeComments"`
+}
+
+func (p CloneTaskPayload) Build(id string) *taskpb.CloneTaskRequest {
+	return &taskpb.CloneTaskRequest{
+		Id:                   id,
+		Title:                strings.TrimSpace(p.Title),
+		TargetOrganizationId: p.TargetOrganizationID,
+		IncludeSubtasks:      p.IncludeSubtasks,
+		IncludeChecklist:     p.IncludeChecklist,
+		IncludeComments:      p.IncludeComments,
+	}
+}
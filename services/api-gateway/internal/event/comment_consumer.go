@@ -89,8 +89,8 @@ func (cc *CommentConsumer) handle(ctx context.Context, msg amqp.Delivery) error
 		Data: eventData,
 	}
 
-	// Broadcast to all connections in the organization
-	if err := cc.connMgr.BroadcastToOrg(amqpMsg.OrganizationID, wsMsg); err != nil {
+	// Broadcast to the organization and the guests the task is shared with
+	if err := cc.connMgr.BroadcastToOrgShared(amqpMsg.OrganizationID, amqpMsg.SharedWith, wsMsg); err != nil {
 		logging.S().Errorw("comment consumer failed to broadcast", "orgId", amqpMsg.OrganizationID, "error", err)
 		return err
 	}
@@ -0,0 +1,148 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// OrganizationConsumer keeps WebSocket subscriptions in line with
+// organization membership and relays organization events to subscribers.
+// Every gateway replica holds its own connections, so each consumes from its
+// own queue.
+type OrganizationConsumer struct {
+	rabbitmq *messaging.RabbitMQ
+	connMgr  *messaging.ConnectionManager
+}
+
+// NewOrganizationConsumer creates a new organization event consumer
+func NewOrganizationConsumer(rabbitmq *messaging.RabbitMQ, connMgr *messaging.ConnectionManager) *OrganizationConsumer {
+	return &OrganizationConsumer{
+		rabbitmq: rabbitmq,
+		connMgr:  connMgr,
+	}
+}
+
+// Listen declares this instance's queue and starts consuming organization events
+func (oc *OrganizationConsumer) Listen() error {
+	queue, err := oc.rabbitmq.DeclareInstanceQueue([]string{"organization.*"}, messaging.EventExchange)
+	if err != nil {
+		return err
+	}
+	logging.S().Infow("organization consumer listening for events", "queue", queue)
+	return oc.rabbitmq.ConsumeMessages(queue, oc.handle)
+}
+
+func (oc *OrganizationConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal AMQP message: %w", err)
+	}
+	if amqpMsg.OrganizationID == "" {
+		logging.S().Warn("organization consumer skipping event with empty organization id")
+		return nil
+	}
+
+	switch amqpMsg.EventType {
+	case contracts.OrganizationEventCreated:
+		var event contracts.OrganizationEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse created event", "error", err)
+			return nil
+		}
+		oc.connMgr.GrantUser(event.OwnerID, event.OrganizationID, false)
+
+	case contracts.OrganizationEventUpdated:
+		var event contracts.OrganizationEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse updated event", "error", err)
+			return nil
+		}
+		oc.broadcast(amqpMsg, event)
+
+	case contracts.OrganizationEventDeleted:
+		var event contracts.OrganizationDeletedEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse deleted event", "error", err)
+			return nil
+		}
+		oc.broadcast(amqpMsg, event)
+		oc.connMgr.RevokeOrganization(amqpMsg.OrganizationID)
+
+	case contracts.OrganizationEventMemberAdded:
+		var event contracts.OrganizationMemberEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse member added event", "error", err)
+			return nil
+		}
+		// Pending members get access once approved
+		if event.Status == orgdomain.MemberStatusActive {
+			oc.connMgr.GrantUser(event.UserID, event.OrganizationID, event.Role == orgdomain.RoleGuest)
+		}
+		oc.broadcast(amqpMsg, event)
+
+	case contracts.OrganizationEventMemberRemoved:
+		var event contracts.OrganizationMemberEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse member removed event", "error", err)
+			return nil
+		}
+		// Cut the removed member off before anything else reaches them
+		oc.connMgr.RevokeUser(event.UserID, event.OrganizationID)
+		oc.broadcast(amqpMsg, event)
+		_ = oc.connMgr.SendToUser(event.UserID, contracts.WSMessage{Type: amqpMsg.EventType, Data: event})
+
+	case contracts.OrganizationEventMemberRoleChanged:
+		var event contracts.OrganizationMemberEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse member role changed event", "error", err)
+			return nil
+		}
+		// Becoming a guest narrows live updates to shared tasks
+		if event.Status == orgdomain.MemberStatusActive {
+			oc.connMgr.GrantUser(event.UserID, event.OrganizationID, event.Role == orgdomain.RoleGuest)
+		}
+		oc.broadcast(amqpMsg, event)
+		if event.Role == orgdomain.RoleGuest {
+			_ = oc.connMgr.SendToUser(event.UserID, contracts.WSMessage{Type: amqpMsg.EventType, Data: event})
+		}
+
+	case contracts.OrganizationEventMemberStatusChanged:
+		var event contracts.OrganizationMemberEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("organization consumer failed to parse member status changed event", "error", err)
+			return nil
+		}
+		if event.Status == orgdomain.MemberStatusActive {
+			oc.connMgr.GrantUser(event.UserID, event.OrganizationID, event.Role == orgdomain.RoleGuest)
+			oc.broadcast(amqpMsg, event)
+			break
+		}
+		// Suspended members and those who left lose live updates right away
+		oc.connMgr.RevokeUser(event.UserID, event.OrganizationID)
+		oc.broadcast(amqpMsg, event)
+		_ = oc.connMgr.SendToUser(event.UserID, contracts.WSMessage{Type: amqpMsg.EventType, Data: event})
+
+	default:
+		return nil
+	}
+
+	logging.S().Infow("organization consumer handled event", "eventType", amqpMsg.EventType, "orgId", amqpMsg.OrganizationID)
+	return nil
+}
+
+func (oc *OrganizationConsumer) broadcast(amqpMsg contracts.AmqpMessage, eventData any) {
+	wsMsg := contracts.WSMessage{
+		Type: amqpMsg.EventType,
+		Data: eventData,
+	}
+	if err := oc.connMgr.BroadcastToOrg(amqpMsg.OrganizationID, wsMsg); err != nil {
+		logging.S().Errorw("organization consumer failed to broadcast", "orgId", amqpMsg.OrganizationID, "error", err)
+	}
+}
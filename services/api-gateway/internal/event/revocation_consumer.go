@@ -0,0 +1,62 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	"github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// RevocationApplier receives token revocations pushed by auth-service
+type RevocationApplier interface {
+	ApplyRevocation(event contracts.TokenRevokedEvent)
+}
+
+// RevocationConsumer feeds auth-service revocations into the local token
+// verifier. Each gateway replica consumes from its own queue so every
+// instance sees every revocation.
+type RevocationConsumer struct {
+	rabbitmq *messaging.RabbitMQ
+	applier  RevocationApplier
+}
+
+// NewRevocationConsumer creates a new token revocation consumer
+func NewRevocationConsumer(rabbitmq *messaging.RabbitMQ, applier RevocationApplier) *RevocationConsumer {
+	return &RevocationConsumer{
+		rabbitmq: rabbitmq,
+		applier:  applier,
+	}
+}
+
+// Listen declares this instance's queue and starts consuming revocations
+func (rc *RevocationConsumer) Listen() error {
+	queue, err := rc.rabbitmq.DeclareInstanceQueue([]string{"auth.revocations"}, messaging.EventExchange)
+	if err != nil {
+		return err
+	}
+	logging.S().Infow("revocation consumer listening for events", "queue", queue)
+	return rc.rabbitmq.ConsumeMessages(queue, rc.handle)
+}
+
+func (rc *RevocationConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal AMQP message: %w", err)
+	}
+	if amqpMsg.EventType != contracts.AuthEventTokenRevoked {
+		return nil
+	}
+
+	var event contracts.TokenRevokedEvent
+	if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+		logging.S().Errorw("revocation consumer failed to parse event", "error", err)
+		return nil
+	}
+
+	rc.applier.ApplyRevocation(event)
+	return nil
+}
@@ -69,6 +69,24 @@ func (tc *TaskConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
 		eventData = event
 		logging.S().Infow("task updated event", "taskId", event.TaskID, "title", event.Title)
 
+	case contracts.TaskEventArchived:
+		var event contracts.TaskArchivedEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("task consumer failed to parse archived event", "error", err)
+			return nil
+		}
+		eventData = event
+		logging.S().Infow("task archived event", "taskId", event.TaskID, "title", event.Title)
+
+	case contracts.TaskEventRestored:
+		var event contracts.TaskRestoredEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("task consumer failed to parse restored event", "error", err)
+			return nil
+		}
+		eventData = event
+		logging.S().Infow("task restored event", "taskId", event.TaskID, "title", event.Title)
+
 	default:
 		logging.S().Warnw("task consumer received unknown event type", "eventType", amqpMsg.EventType)
 		return nil
@@ -80,8 +98,8 @@ func (tc *TaskConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
 		Data: eventData,
 	}
 
-	// Broadcast to all connections in the organization
-	if err := tc.connMgr.BroadcastToOrg(amqpMsg.OrganizationID, wsMsg); err != nil {
+	// Broadcast to the organization and the guests the task is shared with
+	if err := tc.connMgr.BroadcastToOrgShared(amqpMsg.OrganizationID, amqpMsg.SharedWith, wsMsg); err != nil {
 		logging.S().Errorw("task consumer failed to broadcast", "orgId", amqpMsg.OrganizationID, "error", err)
 		return err
 	}
@@ -1,24 +1,46 @@
 package http
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 
 	"github.com/aliirah/task-flow/services/api-gateway/internal/dto"
 	"github.com/aliirah/task-flow/services/api-gateway/internal/service"
+	"github.com/aliirah/task-flow/shared/jwks"
+	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
 	"github.com/aliirah/task-flow/shared/rest"
+	authtransform "github.com/aliirah/task-flow/shared/transform/auth"
 	"github.com/aliirah/task-flow/shared/util"
 )
 
+const (
+	// oidcStateCookie ties an SSO callback to the browser that started the
+	// login. It lives as long as the login state in auth-service.
+	oidcStateCookie    = "oidc_state"
+	oidcStateCookieTTL = 10 * time.Minute
+)
+
 type AuthHandler struct {
 	service   service.AuthService
 	validator *validator.Validate
+	// stateSecret signs the SSO state cookie
+	stateSecret []byte
 }
 
-func NewAuthHandler(svc service.AuthService) *AuthHandler {
-	return &AuthHandler{service: svc, validator: util.NewValidator()}
+func NewAuthHandler(svc service.AuthService, stateSecret []byte) *AuthHandler {
+	return &AuthHandler{service: svc, validator: util.NewValidator(), stateSecret: stateSecret}
 }
 
 func (h *AuthHandler) SignUp(c *gin.Context) {
@@ -129,3 +151,463 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 
 	rest.NoContent(c)
 }
+
+// ListSessions handles GET /api/auth/sessions.
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	resp, err := h.service.ListSessions(c.Request.Context())
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	items := make([]gin.H, 0, len(resp.GetSessions()))
+	for _, session := range resp.GetSessions() {
+		items = append(items, authtransform.SessionToMap(session))
+	}
+	rest.Ok(c, gin.H{"items": items})
+}
+
+// RevokeSession handles DELETE /api/auth/sessions/:id.
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	if rest.HandleGRPCError(c, h.service.RevokeSession(c.Request.Context(), c.Param("id")), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// RevokeOtherSessions handles DELETE /api/auth/sessions, logging out every
+// session except the one making the request.
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	resp, err := h.service.RevokeAllOtherSessions(c.Request.Context())
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	rest.Ok(c, gin.H{"revoked": resp.GetRevoked()})
+}
+
+// ForgotPassword handles POST /api/auth/forgot-password. It always answers
+// 204 so callers cannot tell whether the email is registered.
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var payload dto.ForgotPasswordPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.RequestPasswordReset(c.Request.Context(), payload.Build()), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// ResetPassword handles POST /api/auth/reset-password.
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var payload dto.ResetPasswordPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.ResetPassword(c.Request.Context(), strings.TrimSpace(payload.Token), payload.Password), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// ChangePassword handles POST /api/auth/password. Other sessions are logged
+// out, the current one stays.
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	var payload dto.ChangePasswordPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.ChangePassword(c.Request.Context(), payload.CurrentPassword, payload.NewPassword), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// VerifyEmail handles POST /api/auth/verify-email.
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var payload dto.VerifyEmailPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.VerifyEmail(c.Request.Context(), strings.TrimSpace(payload.Token)), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// ResendVerification handles POST /api/auth/verify-email/resend.
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	var payload dto.ResendVerificationPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.RequestEmailVerification(c.Request.Context(), payload.Build()), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// EnrollTOTP handles POST /api/auth/2fa/enroll.
+func (h *AuthHandler) EnrollTOTP(c *gin.Context) {
+	resp, err := h.service.EnrollTOTP(c.Request.Context(), "")
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	rest.Ok(c, totpEnrollmentPayload(resp))
+}
+
+// ConfirmTOTP handles POST /api/auth/2fa/confirm.
+func (h *AuthHandler) ConfirmTOTP(c *gin.Context) {
+	var payload dto.TOTPCodePayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	resp, err := h.service.ConfirmTOTP(c.Request.Context(), payload.Build(), "")
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	rest.Ok(c, gin.H{"recoveryCodes": resp.GetRecoveryCodes()})
+}
+
+// DisableTOTP handles POST /api/auth/2fa/disable.
+func (h *AuthHandler) DisableTOTP(c *gin.Context) {
+	var payload dto.TOTPCodePayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	if rest.HandleGRPCError(c, h.service.DisableTOTP(c.Request.Context(), payload.Build()), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// RegenerateRecoveryCodes handles POST /api/auth/2fa/recovery-codes.
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var payload dto.TOTPCodePayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	resp, err := h.service.RegenerateRecoveryCodes(c.Request.Context(), payload.Build())
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	rest.Ok(c, gin.H{"recoveryCodes": resp.GetRecoveryCodes()})
+}
+
+// VerifyMFA handles POST /api/auth/2fa/verify, the second step of a login
+// that answered with mfaRequired.
+func (h *AuthHandler) VerifyMFA(c *gin.Context) {
+	var payload dto.MFAVerifyPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	tokens, err := h.service.VerifyMFA(c.Request.Context(), strings.TrimSpace(payload.MFAToken), strings.TrimSpace(payload.Code))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	payloadBody, buildErr := h.service.BuildTokenPayload(tokens)
+	if buildErr != nil {
+		rest.InternalError(c, buildErr)
+		return
+	}
+	rest.Ok(c, payloadBody)
+}
+
+// EnrollTOTPChallenge handles POST /api/auth/2fa/challenge/enroll for users
+// whose organization requires 2FA before they have set it up.
+func (h *AuthHandler) EnrollTOTPChallenge(c *gin.Context) {
+	var payload dto.MFAChallengePayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	resp, err := h.service.EnrollTOTP(c.Request.Context(), strings.TrimSpace(payload.MFAToken))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	rest.Ok(c, totpEnrollmentPayload(resp))
+}
+
+// ConfirmTOTPChallenge handles POST /api/auth/2fa/challenge/confirm. It
+// enables TOTP and completes the login in one step.
+func (h *AuthHandler) ConfirmTOTPChallenge(c *gin.Context) {
+	var payload dto.MFAVerifyPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+	resp, err := h.service.ConfirmTOTP(c.Request.Context(), strings.TrimSpace(payload.Code), strings.TrimSpace(payload.MFAToken))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	payloadBody, buildErr := h.service.BuildTokenPayload(resp.GetTokens())
+	if buildErr != nil {
+		rest.InternalError(c, buildErr)
+		return
+	}
+	payloadBody["recoveryCodes"] = resp.GetRecoveryCodes()
+	rest.Ok(c, payloadBody)
+}
+
+// UnlockAccount handles POST /api/auth/users/:id/unlock, clearing a login
+// lockout. Auth-service only allows the configured operators.
+func (h *AuthHandler) UnlockAccount(c *gin.Context) {
+	if rest.HandleGRPCError(c, h.service.UnlockAccount(c.Request.Context(), c.Param("id")), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// OIDCProviders handles GET /api/auth/oidc/providers.
+func (h *AuthHandler) OIDCProviders(c *gin.Context) {
+	resp, err := h.service.ListOIDCProviders(c.Request.Context())
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	items := make([]gin.H, 0, len(resp.GetProviders()))
+	for _, provider := range resp.GetProviders() {
+		items = append(items, gin.H{
+			"name":        provider.GetName(),
+			"displayName": provider.GetDisplayName(),
+		})
+	}
+	rest.Ok(c, gin.H{"items": items})
+}
+
+// OIDCStart handles GET /api/auth/oidc/:provider/start by redirecting the
+// browser to the identity provider. ?redirect= names the web client page
+// that receives the result. The login state is also kept in a signed
+// cookie, so a callback only completes in the browser that started it.
+func (h *AuthHandler) OIDCStart(c *gin.Context) {
+	authURL, err := h.service.StartOIDCLogin(c.Request.Context(), c.Param("provider"), c.Query("redirect"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil || parsed.Query().Get("state") == "" {
+		rest.InternalError(c, fmt.Errorf("sso login url carries no state"))
+		return
+	}
+	h.setOIDCStateCookie(c, parsed.Query().Get("state"))
+	c.Header("Cache-Control", "no-store")
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// OIDCCallback handles GET /api/auth/oidc/:provider/callback. When the login
+// was started with a redirect the browser is sent there with the result in
+// the URL fragment, which never reaches a server; otherwise it is returned
+// as JSON like a password login.
+func (h *AuthHandler) OIDCCallback(c *gin.Context) {
+	stateMatches := h.checkOIDCStateCookie(c, c.Query("state"))
+	h.clearOIDCStateCookie(c)
+
+	// The provider's error text is not ours to show
+	if c.Query("error") != "" {
+		rest.Error(c, http.StatusBadRequest, "sso login was cancelled or rejected by the identity provider",
+			rest.WithErrorCode("auth.sso_failed"))
+		return
+	}
+	if !stateMatches {
+		rest.Error(c, http.StatusBadRequest, "sso login was not started in this browser",
+			rest.WithErrorCode("auth.sso_state_mismatch"))
+		return
+	}
+
+	resp, err := h.service.CompleteOIDCLogin(c.Request.Context(), c.Param("provider"), c.Query("state"), c.Query("code"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	payloadBody, buildErr := h.service.BuildTokenPayload(resp.GetTokens())
+	if buildErr != nil {
+		rest.InternalError(c, buildErr)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	if redirectTo := resp.GetRedirectTo(); redirectTo != "" {
+		fragment := url.Values{}
+		for key, value := range payloadBody {
+			switch v := value.(type) {
+			case string:
+				fragment.Set(key, v)
+			case bool:
+				fragment.Set(key, strconv.FormatBool(v))
+			}
+		}
+		c.Redirect(http.StatusFound, redirectTo+"#"+fragment.Encode())
+		return
+	}
+	rest.Ok(c, payloadBody)
+}
+
+// setOIDCStateCookie stores the login state with its signature, scoped to the
+// provider's start and callback paths. SameSite=Lax still sends it on the
+// provider's top level redirect back.
+func (h *AuthHandler) setOIDCStateCookie(c *gin.Context, state string) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state + "." + h.signOIDCState(c.Param("provider"), state),
+		Path:     path.Dir(c.Request.URL.Path),
+		MaxAge:   int(oidcStateCookieTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   requestIsHTTPS(c),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func (h *AuthHandler) clearOIDCStateCookie(c *gin.Context) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    "",
+		Path:     path.Dir(c.Request.URL.Path),
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   requestIsHTTPS(c),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// checkOIDCStateCookie reports whether the cookie is signed by this gateway
+// for the provider and holds the state the callback came back with
+func (h *AuthHandler) checkOIDCStateCookie(c *gin.Context, state string) bool {
+	cookie, err := c.Cookie(oidcStateCookie)
+	if err != nil || state == "" {
+		return false
+	}
+	cookieState, signature, ok := strings.Cut(cookie, ".")
+	if !ok {
+		return false
+	}
+	expected := h.signOIDCState(c.Param("provider"), cookieState)
+	return hmac.Equal([]byte(signature), []byte(expected)) &&
+		subtle.ConstantTimeCompare([]byte(cookieState), []byte(state)) == 1
+}
+
+func (h *AuthHandler) signOIDCState(provider, state string) string {
+	mac := hmac.New(sha256.New, h.stateSecret)
+	mac.Write([]byte(provider + "\n" + state))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// requestIsHTTPS also trusts the proxy header, as TLS usually ends in front
+// of the gateway
+func requestIsHTTPS(c *gin.Context) bool {
+	return c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https")
+}
+
+// ListAPITokens handles GET /api/auth/tokens.
+func (h *AuthHandler) ListAPITokens(c *gin.Context) {
+	h.listAPITokens(c, "")
+}
+
+// CreateAPIToken handles POST /api/auth/tokens. The secret is only in this
+// response.
+func (h *AuthHandler) CreateAPIToken(c *gin.Context) {
+	h.createAPIToken(c, "personal", "")
+}
+
+// RevokeAPIToken handles DELETE /api/auth/tokens/:id.
+func (h *AuthHandler) RevokeAPIToken(c *gin.Context) {
+	if rest.HandleGRPCError(c, h.service.RevokeAPIToken(c.Request.Context(), c.Param("id")), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// ListServiceKeys handles GET /api/organizations/:id/api-keys.
+func (h *AuthHandler) ListServiceKeys(c *gin.Context) {
+	h.listAPITokens(c, c.Param("id"))
+}
+
+// CreateServiceKey handles POST /api/organizations/:id/api-keys.
+func (h *AuthHandler) CreateServiceKey(c *gin.Context) {
+	h.createAPIToken(c, "service", c.Param("id"))
+}
+
+// RevokeServiceKey handles DELETE /api/organizations/:id/api-keys/:keyId.
+func (h *AuthHandler) RevokeServiceKey(c *gin.Context) {
+	if rest.HandleGRPCError(c, h.service.RevokeAPIToken(c.Request.Context(), c.Param("keyId")), rest.WithNamespace("auth")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+func (h *AuthHandler) listAPITokens(c *gin.Context, organizationID string) {
+	resp, err := h.service.ListAPITokens(c.Request.Context(), organizationID)
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	items := make([]gin.H, 0, len(resp.GetTokens()))
+	for _, token := range resp.GetTokens() {
+		items = append(items, authtransform.APITokenToMap(token))
+	}
+	rest.Ok(c, gin.H{"items": items})
+}
+
+func (h *AuthHandler) createAPIToken(c *gin.Context, kind, organizationID string) {
+	var payload dto.CreateAPITokenPayload
+	if !h.bindPayload(c, &payload) {
+		return
+	}
+
+	resp, err := h.service.CreateAPIToken(c.Request.Context(), payload.Build(kind, organizationID))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	body := authtransform.APITokenToMap(resp.GetToken())
+	body["token"] = resp.GetSecret()
+	c.Header("Cache-Control", "no-store")
+	rest.Created(c, body)
+}
+
+func totpEnrollmentPayload(resp *authpb.EnrollTOTPResponse) gin.H {
+	return gin.H{
+		"secret":          resp.GetSecret(),
+		"provisioningUri": resp.GetProvisioningUri(),
+	}
+}
+
+func (h *AuthHandler) bindPayload(c *gin.Context, payload interface{}) bool {
+	if err := c.ShouldBindJSON(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return false
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return false
+	}
+	return true
+}
+
+// JWKS handles GET /.well-known/jwks.json.
+// Served as a plain JWK Set, not wrapped in the API envelope, so standard
+// JWT libraries can consume it.
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	resp, err := h.service.JWKS(c.Request.Context())
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("auth")) {
+		return
+	}
+
+	set := jwks.Set{Keys: make([]jwks.Key, 0, len(resp.GetKeys()))}
+	for _, key := range resp.GetKeys() {
+		set.Keys = append(set.Keys, jwks.Key{
+			Kty: key.GetKty(),
+			Kid: key.GetKid(),
+			Use: key.GetUse(),
+			Alg: key.GetAlg(),
+			N:   key.GetN(),
+			E:   key.GetE(),
+			Crv: key.GetCrv(),
+			X:   key.GetX(),
+		})
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, set)
+}
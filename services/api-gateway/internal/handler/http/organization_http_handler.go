@@ -48,6 +48,11 @@ func (h *OrganizationHandler) Create(c *gin.Context) {
 			rest.WithErrorCode("auth.missing_identity"))
 		return
 	}
+	if currentUser.OrganizationID != "" {
+		rest.Error(c, http.StatusForbidden, "service keys cannot create organizations",
+			rest.WithErrorCode("auth.api_token_not_allowed"))
+		return
+	}
 
 	req := payload.Build(currentUser.ID)
 
@@ -110,11 +115,95 @@ func (h *OrganizationHandler) Update(c *gin.Context) {
 	rest.Ok(c, orgtransform.ToMap(org))
 }
 
+// Delete schedules the organization for deletion. The caller repeats the
+// organization name as confirmation.
 func (h *OrganizationHandler) Delete(c *gin.Context) {
-	if rest.HandleGRPCError(c, h.orgService.Delete(c.Request.Context(), &organizationpb.DeleteOrganizationRequest{Id: c.Param("id")}), rest.WithNamespace("organization")) {
+	var payload dto.OrganizationDeletePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
 		return
 	}
-	rest.NoContent(c)
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	org, err := h.orgService.Delete(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.ToMap(org))
+}
+
+func (h *OrganizationHandler) CancelDeletion(c *gin.Context) {
+	org, err := h.orgService.CancelDeletion(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.ToMap(org))
+}
+
+func (h *OrganizationHandler) TransferOwnership(c *gin.Context) {
+	var payload dto.OrganizationTransferPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	org, err := h.orgService.TransferOwnership(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.ToMap(org))
+}
+
+func (h *OrganizationHandler) GetSettings(c *gin.Context) {
+	settings, err := h.orgService.GetSettings(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.SettingsToMap(settings))
+}
+
+// UpdateSettings replaces the organization's settings document
+func (h *OrganizationHandler) UpdateSettings(c *gin.Context) {
+	var payload dto.OrganizationSettingsPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	settings, err := h.orgService.UpdateSettings(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.SettingsToMap(settings))
+}
+
+// GetUsage shows the organization's usage next to its plan limits
+func (h *OrganizationHandler) GetUsage(c *gin.Context) {
+	usage, err := h.orgService.GetUsage(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.UsageToMap(usage))
 }
 
 func (h *OrganizationHandler) AddMember(c *gin.Context) {
@@ -152,6 +241,77 @@ func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
 	rest.NoContent(c)
 }
 
+func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
+	var payload dto.OrganizationUpdateMemberRolePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	member, err := h.orgService.UpdateMemberRole(c.Request.Context(), payload.Build(c.Param("id"), c.Param("userId")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.MemberToMap(member))
+}
+
+func (h *OrganizationHandler) SuspendMember(c *gin.Context) {
+	member, err := h.orgService.SuspendMember(c.Request.Context(), memberStatusRequest(c))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.MemberToMap(member))
+}
+
+func (h *OrganizationHandler) ReactivateMember(c *gin.Context) {
+	member, err := h.orgService.ReactivateMember(c.Request.Context(), memberStatusRequest(c))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.MemberToMap(member))
+}
+
+func (h *OrganizationHandler) ApproveMember(c *gin.Context) {
+	member, err := h.orgService.ApproveMember(c.Request.Context(), memberStatusRequest(c))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.MemberToMap(member))
+}
+
+// Leave removes the signed-in user from the organization
+func (h *OrganizationHandler) Leave(c *gin.Context) {
+	user, ok := authctx.UserFromGin(c)
+	if !ok || user.ID == "" {
+		rest.Error(c, http.StatusUnauthorized, "missing user identity",
+			rest.WithErrorCode("auth.missing_identity"))
+		return
+	}
+
+	err := h.orgService.Leave(c.Request.Context(), &organizationpb.LeaveOrganizationRequest{
+		OrganizationId: c.Param("id"),
+		UserId:         user.ID,
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+func memberStatusRequest(c *gin.Context) *organizationpb.MemberStatusRequest {
+	return &organizationpb.MemberStatusRequest{
+		OrganizationId: c.Param("id"),
+		UserId:         c.Param("userId"),
+	}
+}
+
 func (h *OrganizationHandler) ListMembers(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
@@ -193,7 +353,19 @@ func (h *OrganizationHandler) ListUserMemberships(c *gin.Context) {
 		return
 	}
 
-	payload, err := h.orgService.BuildMemberViews(c.Request.Context(), resp.GetMemberships())
+	memberships := resp.GetMemberships()
+	if user.OrganizationID != "" {
+		// A service key only sees the organization it belongs to
+		scoped := memberships[:0]
+		for _, membership := range memberships {
+			if user.CanAccessOrganization(membership.GetOrganizationId()) {
+				scoped = append(scoped, membership)
+			}
+		}
+		memberships = scoped
+	}
+
+	payload, err := h.orgService.BuildMemberViews(c.Request.Context(), memberships)
 	if err != nil {
 		if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
 			return
@@ -203,3 +375,318 @@ func (h *OrganizationHandler) ListUserMemberships(c *gin.Context) {
 	}
 	rest.Ok(c, gin.H{"items": payload})
 }
+
+func (h *OrganizationHandler) CreateInvitation(c *gin.Context) {
+	var payload dto.OrganizationInvitePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	invitation, err := h.orgService.CreateInvitation(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Created(c, orgtransform.InvitationToMap(invitation))
+}
+
+func (h *OrganizationHandler) ListInvitations(c *gin.Context) {
+	resp, err := h.orgService.ListInvitations(c.Request.Context(), &organizationpb.ListInvitationsRequest{
+		OrganizationId: c.Param("id"),
+		Status:         c.Query("status"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": orgtransform.InvitationsToMaps(resp.GetItems())})
+}
+
+func (h *OrganizationHandler) ResendInvitation(c *gin.Context) {
+	invitation, err := h.orgService.ResendInvitation(c.Request.Context(), &organizationpb.InvitationRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("invitationId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.Ok(c, orgtransform.InvitationToMap(invitation))
+}
+
+func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
+	err := h.orgService.RevokeInvitation(c.Request.Context(), &organizationpb.InvitationRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("invitationId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("organization")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// ListMyInvitations lists the open invitations sent to the user's email
+func (h *OrganizationHandler) ListMyInvitations(c *gin.Context) {
+	user, ok := authctx.UserFromGin(c)
+	if !ok || user.ID == "" || user.Email == "" {
+		rest.Error(c, http.StatusUnauthorized, "missing user identity",
+			rest.WithErrorCode("auth.missing_identity"))
+		return
+	}
+
+	resp, err := h.orgService.ListUserInvitations(c.Request.Context(), &organizationpb.ListUserInvitationsRequest{
+		Email: user.Email,
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("invitation")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": orgtransform.InvitationsToMaps(resp.GetItems())})
+}
+
+// AcceptInvitation accepts an invitation by ID, or by the emailed token when
+// the route has no ID
+func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
+	req, ok := h.respondInvitationRequest(c)
+	if !ok {
+		return
+	}
+	member, err := h.orgService.AcceptInvitation(c.Request.Context(), req)
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("invitation")) {
+		return
+	}
+	rest.Ok(c, orgtransform.MemberToMap(member))
+}
+
+// DeclineInvitation declines an invitation by ID, or by the emailed token
+// when the route has no ID
+func (h *OrganizationHandler) DeclineInvitation(c *gin.Context) {
+	req, ok := h.respondInvitationRequest(c)
+	if !ok {
+		return
+	}
+	if rest.HandleGRPCError(c, h.orgService.DeclineInvitation(c.Request.Context(), req), rest.WithNamespace("invitation")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+func (h *OrganizationHandler) respondInvitationRequest(c *gin.Context) (*organizationpb.RespondInvitationRequest, bool) {
+	user, ok := authctx.UserFromGin(c)
+	if !ok || user.ID == "" || user.Email == "" {
+		rest.Error(c, http.StatusUnauthorized, "missing user identity",
+			rest.WithErrorCode("auth.missing_identity"))
+		return nil, false
+	}
+
+	req := &organizationpb.RespondInvitationRequest{
+		Id:     c.Param("invitationId"),
+		UserId: user.ID,
+		Email:  user.Email,
+	}
+	if req.Id != "" {
+		return req, true
+	}
+
+	var payload dto.InvitationTokenPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return nil, false
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return nil, false
+	}
+	req.Token = payload.Token
+	return req, true
+}
+
+func (h *OrganizationHandler) CreateTeam(c *gin.Context) {
+	var payload dto.TeamCreatePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	team, err := h.orgService.CreateTeam(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Created(c, orgtransform.TeamToMap(team))
+}
+
+// ListTeams lists the organization's teams, only those of a user when the
+// userId query parameter is set
+func (h *OrganizationHandler) ListTeams(c *gin.Context) {
+	resp, err := h.orgService.ListTeams(c.Request.Context(), &organizationpb.ListTeamsRequest{
+		OrganizationId: c.Param("id"),
+		UserId:         c.Query("userId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": orgtransform.TeamsToMaps(resp.GetItems())})
+}
+
+func (h *OrganizationHandler) GetTeam(c *gin.Context) {
+	team, err := h.orgService.GetTeam(c.Request.Context(), &organizationpb.TeamRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("teamId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Ok(c, orgtransform.TeamToMap(team))
+}
+
+func (h *OrganizationHandler) UpdateTeam(c *gin.Context) {
+	var payload dto.TeamUpdatePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	team, err := h.orgService.UpdateTeam(c.Request.Context(), payload.Build(c.Param("id"), c.Param("teamId")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Ok(c, orgtransform.TeamToMap(team))
+}
+
+func (h *OrganizationHandler) DeleteTeam(c *gin.Context) {
+	err := h.orgService.DeleteTeam(c.Request.Context(), &organizationpb.TeamRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("teamId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+func (h *OrganizationHandler) AddTeamMember(c *gin.Context) {
+	var payload dto.TeamMemberPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	team, err := h.orgService.AddTeamMember(c.Request.Context(), payload.Build(c.Param("id"), c.Param("teamId")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Ok(c, orgtransform.TeamToMap(team))
+}
+
+func (h *OrganizationHandler) RemoveTeamMember(c *gin.Context) {
+	team, err := h.orgService.RemoveTeamMember(c.Request.Context(), &organizationpb.TeamMemberRequest{
+		OrganizationId: c.Param("id"),
+		TeamId:         c.Param("teamId"),
+		UserId:         c.Param("userId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("team")) {
+		return
+	}
+	rest.Ok(c, orgtransform.TeamToMap(team))
+}
+
+func (h *OrganizationHandler) AddDomain(c *gin.Context) {
+	var payload dto.DomainCreatePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	domain, err := h.orgService.AddDomain(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("domain")) {
+		return
+	}
+	rest.Created(c, orgtransform.DomainToMap(domain))
+}
+
+func (h *OrganizationHandler) ListDomains(c *gin.Context) {
+	resp, err := h.orgService.ListDomains(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("domain")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": orgtransform.DomainsToMaps(resp.GetItems())})
+}
+
+func (h *OrganizationHandler) UpdateDomain(c *gin.Context) {
+	var payload dto.DomainUpdatePayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	domain, err := h.orgService.UpdateDomain(c.Request.Context(), payload.Build(c.Param("id"), c.Param("domainId")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("domain")) {
+		return
+	}
+	rest.Ok(c, orgtransform.DomainToMap(domain))
+}
+
+// VerifyDomain checks the domain's DNS verification record
+func (h *OrganizationHandler) VerifyDomain(c *gin.Context) {
+	domain, err := h.orgService.VerifyDomain(c.Request.Context(), &organizationpb.DomainRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("domainId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("domain")) {
+		return
+	}
+	rest.Ok(c, orgtransform.DomainToMap(domain))
+}
+
+func (h *OrganizationHandler) RemoveDomain(c *gin.Context) {
+	err := h.orgService.RemoveDomain(c.Request.Context(), &organizationpb.DomainRequest{
+		OrganizationId: c.Param("id"),
+		Id:             c.Param("domainId"),
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("domain")) {
+		return
+	}
+	rest.NoContent(c)
+}
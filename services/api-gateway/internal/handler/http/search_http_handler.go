@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
@@ -35,15 +36,13 @@ func (h *SearchHandler) Search(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "organizationId is required"})
 		return
 	}
-	userCtx, _ := authctx.UserFromGin(c)
-	userID := strings.TrimSpace(c.Query("userId"))
-	if userID == "" {
-		userID = userCtx.ID
+	if !checkSearchCaller(c, organizationID) {
+		return
 	}
 
-	response, err := h.service.Search(c.Request.Context(), query, types, limit, organizationID, userID)
+	response, err := h.service.Search(c.Request.Context(), query, types, limit, organizationID)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeSearchError(c, err)
 		return
 	}
 
@@ -63,21 +62,46 @@ func (h *SearchHandler) Suggest(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "organizationId is required"})
 		return
 	}
-	userCtx, _ := authctx.UserFromGin(c)
-	userID := strings.TrimSpace(c.Query("userId"))
-	if userID == "" {
-		userID = userCtx.ID
+	if !checkSearchCaller(c, organizationID) {
+		return
 	}
 
-	results, err := h.service.Suggest(c.Request.Context(), query, limit, organizationID, userID)
+	results, err := h.service.Suggest(c.Request.Context(), query, limit, organizationID)
 	if err != nil {
-		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		writeSearchError(c, err)
 		return
 	}
 
 	rest.Ok(c, gin.H{"results": results})
 }
 
+// checkSearchCaller makes sure the search runs as the authenticated user. The
+// userId parameter is still accepted when it names that user.
+func checkSearchCaller(c *gin.Context, organizationID string) bool {
+	userCtx, ok := authctx.UserFromGin(c)
+	if !ok || userCtx.ID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing user identity"})
+		return false
+	}
+	if !userCtx.CanAccessOrganization(organizationID) {
+		c.JSON(http.StatusForbidden, gin.H{"error": "api token is not valid for this organization"})
+		return false
+	}
+	if userID := strings.TrimSpace(c.Query("userId")); userID != "" && userID != userCtx.ID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "cannot search on behalf of another user"})
+		return false
+	}
+	return true
+}
+
+func writeSearchError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrSearchForbidden) {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+}
+
 func filterTypes(raw []string) []string {
 	types := make([]string, 0, len(raw))
 	for _, t := range raw {
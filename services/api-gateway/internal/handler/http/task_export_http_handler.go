@@ -0,0 +1,352 @@
+package http
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	taskdomain "github.com/aliirah/task-flow/shared/domain/task"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/aliirah/task-flow/shared/rest"
+	tasktransform "github.com/aliirah/task-flow/shared/transform/task"
+	"github.com/aliirah/task-flow/shared/util/stringset"
+)
+
+var exportCSVHeader = []string{
+	"id", "title", "description", "status", "priority", "type", "parent",
+	"assignee", "assignee_email", "reporter", "reporter_email",
+	"due_at", "checklist_done", "checklist_total", "created_at", "updated_at",
+}
+
+// Export handles GET /api/organizations/:id/tasks/export.
+// Accepts the same filters as List plus ?format=csv|json|ics.
+func (h *TaskHandler) Export(c *gin.Context) {
+	format := strings.ToLower(c.DefaultQuery("format", "csv"))
+	if format != "csv" && format != "json" && format != "ics" {
+		rest.Error(c, http.StatusBadRequest, "format must be csv, json or ics",
+			rest.WithErrorCode("task.export_invalid_format"))
+		return
+	}
+
+	status, err := stringset.Normalize(c.Query("status"), "status", taskdomain.StatusSet, "")
+	if err != nil {
+		rest.Error(c, http.StatusBadRequest, err.Error(),
+			rest.WithErrorCode("validation.invalid_status"))
+		return
+	}
+
+	stream, err := h.taskService.Export(c.Request.Context(), &taskpb.ExportTasksRequest{
+		OrganizationId:  c.Param("id"),
+		AssigneeId:      c.Query("assigneeId"),
+		ReporterId:      c.Query("reporterId"),
+		Status:          status,
+		SortBy:          c.Query("sortBy"),
+		SortOrder:       c.Query("sortOrder"),
+		Search:          c.Query("search"),
+		OnlyWithDueDate: format == "ics",
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	h.writeExport(c, stream, format, "tasks-"+time.Now().UTC().Format("20060102"))
+}
+
+// CalendarFeed handles GET /api/calendar/:token.
+// Public so calendar apps can subscribe, the token identifies the user.
+func (h *TaskHandler) CalendarFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	stream, err := h.taskService.Export(c.Request.Context(), &taskpb.ExportTasksRequest{
+		FeedToken:       token,
+		OnlyWithDueDate: true,
+		SortBy:          "dueAt",
+		SortOrder:       "asc",
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	h.writeExport(c, stream, "ics", "")
+}
+
+// CreateCalendarToken handles POST /api/organizations/:id/tasks/export/calendar-token.
+// Issuing a new token invalidates the previous one.
+func (h *TaskHandler) CreateCalendarToken(c *gin.Context) {
+	token, err := h.taskService.CreateCalendarFeedToken(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+	rest.Created(c, gin.H{
+		"token":          token.GetToken(),
+		"organizationId": token.GetOrganizationId(),
+		"url":            "/api/calendar/" + token.GetToken() + ".ics",
+		"createdAt":      token.GetCreatedAt().AsTime(),
+	})
+}
+
+// RevokeCalendarToken handles DELETE /api/organizations/:id/tasks/export/calendar-token.
+func (h *TaskHandler) RevokeCalendarToken(c *gin.Context) {
+	err := h.taskService.RevokeCalendarFeedToken(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+	rest.NoContent(c)
+}
+
+// writeExport waits for the first row before sending headers so errors from
+// the task service still turn into a regular error response.
+func (h *TaskHandler) writeExport(c *gin.Context, stream grpc.ServerStreamingClient[taskpb.ExportTaskRow], format, filename string) {
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+			return
+		}
+		rest.InternalError(c, err)
+		return
+	}
+
+	next := func() (*taskpb.ExportTaskRow, error) {
+		if first != nil {
+			row := first
+			first = nil
+			return row, nil
+		}
+		return stream.Recv()
+	}
+	if errors.Is(err, io.EOF) {
+		next = func() (*taskpb.ExportTaskRow, error) { return nil, io.EOF }
+	}
+
+	var writeErr error
+	switch format {
+	case "json":
+		setExportHeaders(c, "application/json; charset=utf-8", filename, "json")
+		writeErr = writeJSONExport(c.Writer, next)
+	case "ics":
+		setExportHeaders(c, "text/calendar; charset=utf-8", filename, "ics")
+		writeErr = writeICSExport(c.Writer, next)
+	default:
+		setExportHeaders(c, "text/csv; charset=utf-8", filename, "csv")
+		writeErr = writeCSVExport(c.Writer, next)
+	}
+	if writeErr != nil {
+		// Headers are already out, all we can do is stop the body
+		_ = c.Error(writeErr)
+	}
+}
+
+func setExportHeaders(c *gin.Context, contentType, filename, ext string) {
+	c.Header("Content-Type", contentType)
+	c.Header("Cache-Control", "no-store")
+	if filename != "" {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+"."+ext))
+	}
+	c.Status(http.StatusOK)
+}
+
+func writeCSVExport(w gin.ResponseWriter, next func() (*taskpb.ExportTaskRow, error)) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return err
+	}
+
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		task := row.GetTask()
+		if err := writer.Write([]string{
+			task.GetId(),
+			task.GetTitle(),
+			task.GetDescription(),
+			task.GetStatus(),
+			task.GetPriority(),
+			task.GetType(),
+			task.GetParentTaskId(),
+			row.GetAssigneeName(),
+			row.GetAssigneeEmail(),
+			row.GetReporterName(),
+			row.GetReporterEmail(),
+			formatExportTime(task.GetDueAt().AsTime(), task.GetDueAt() != nil),
+			fmt.Sprint(task.GetChecklistDone()),
+			fmt.Sprint(task.GetChecklistTotal()),
+			formatExportTime(task.GetCreatedAt().AsTime(), task.GetCreatedAt() != nil),
+			formatExportTime(task.GetUpdatedAt().AsTime(), task.GetUpdatedAt() != nil),
+		}); err != nil {
+			return err
+		}
+		writer.Flush()
+		w.Flush()
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeJSONExport(w gin.ResponseWriter, next func() (*taskpb.ExportTaskRow, error)) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	for count := 0; ; count++ {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		item := tasktransform.ToMap(row.GetTask())
+		item["assignee"] = exportPerson(row.GetAssigneeName(), row.GetAssigneeEmail())
+		item["reporter"] = exportPerson(row.GetReporterName(), row.GetReporterEmail())
+
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		w.Flush()
+	}
+
+	_, err := io.WriteString(w, "]")
+	return err
+}
+
+func writeICSExport(w gin.ResponseWriter, next func() (*taskpb.ExportTaskRow, error)) error {
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	header := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Task Flow//Tasks//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:Task Flow",
+	}
+	for _, line := range header {
+		if err := writeICSLine(w, line); err != nil {
+			return err
+		}
+	}
+
+	for {
+		row, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		task := row.GetTask()
+		if task.GetDueAt() == nil {
+			continue
+		}
+		due := task.GetDueAt().AsTime().UTC()
+
+		lines := []string{
+			"BEGIN:VEVENT",
+			"UID:" + task.GetId() + "@task-flow",
+			"DTSTAMP:" + stamp,
+			"DTSTART:" + due.Format("20060102T150405Z"),
+			"DTEND:" + due.Format("20060102T150405Z"),
+			"SUMMARY:" + escapeICSText(task.GetTitle()),
+		}
+		if desc := task.GetDescription(); desc != "" {
+			lines = append(lines, "DESCRIPTION:"+escapeICSText(desc))
+		}
+		lines = append(lines, "STATUS:"+icsStatus(task.GetStatus()))
+		if categories := escapeICSText(task.GetPriority()); categories != "" {
+			lines = append(lines, "CATEGORIES:"+categories)
+		}
+		if task.GetUpdatedAt() != nil {
+			lines = append(lines, "LAST-MODIFIED:"+task.GetUpdatedAt().AsTime().UTC().Format("20060102T150405Z"))
+		}
+		lines = append(lines, "END:VEVENT")
+
+		for _, line := range lines {
+			if err := writeICSLine(w, line); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+	}
+
+	return writeICSLine(w, "END:VCALENDAR")
+}
+
+// writeICSLine folds content lines longer than 75 octets as RFC 5545 asks,
+// without splitting a UTF-8 sequence.
+func writeICSLine(w io.Writer, line string) error {
+	const limit = 75
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func escapeICSText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+func icsStatus(status string) string {
+	switch status {
+	case "completed":
+		return "CONFIRMED"
+	case "cancelled":
+		return "CANCELLED"
+	}
+	return "TENTATIVE"
+}
+
+func exportPerson(name, email string) gin.H {
+	if name == "" && email == "" {
+		return nil
+	}
+	return gin.H{"name": name, "email": email}
+}
+
+func formatExportTime(t time.Time, ok bool) string {
+	if !ok {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -100,6 +100,7 @@ func (h *TaskHandler) List(c *gin.Context) {
 	req := &taskpb.ListTasksRequest{
 		OrganizationId: c.Query("organizationId"),
 		AssigneeId:     c.Query("assigneeId"),
+		AssigneeTeamId: c.Query("assigneeTeamId"),
 		ReporterId:     c.Query("reporterId"),
 		Status:         status,
 		Page:           int32(page),
@@ -107,6 +108,9 @@ func (h *TaskHandler) List(c *gin.Context) {
 		SortBy:         c.Query("sortBy"),
 		SortOrder:      c.Query("sortOrder"),
 		Search:         c.Query("search"),
+		Archived:       c.Query("archived") == "true",
+		// includeTeams widens assigneeId to the tasks of the assignee's teams
+		IncludeAssigneeTeams: c.Query("includeTeams") == "true",
 	}
 
 	resp, err := h.taskService.List(c.Request.Context(), req)
@@ -208,6 +212,123 @@ func (h *TaskHandler) Delete(c *gin.Context) {
 	rest.NoContent(c)
 }
 
+// Restore handles POST /api/tasks/:id/restore.
+func (h *TaskHandler) Restore(c *gin.Context) {
+	task, err := h.taskService.Restore(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	items, err := h.taskService.BuildView(c.Request.Context(), []*taskpb.Task{task})
+	if err != nil {
+		if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+			return
+		}
+		rest.InternalError(c, err)
+		return
+	}
+	if len(items) == 0 {
+		rest.Ok(c, gin.H{})
+		return
+	}
+	rest.Ok(c, items[0])
+}
+
+// Clone handles POST /api/tasks/:id/clone.
+// Assignees who aren't members of the target organization are left unassigned
+// and listed in unassignedTaskIds.
+func (h *TaskHandler) Clone(c *gin.Context) {
+	var payload dto.CloneTaskPayload
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			rest.Error(c, http.StatusBadRequest, "invalid request payload",
+				rest.WithErrorCode("validation.invalid_payload"))
+			return
+		}
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	resp, err := h.taskService.Clone(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	tasks := append([]*taskpb.Task{resp.GetTask()}, resp.GetSubtasks()...)
+	items, err := h.taskService.BuildView(c.Request.Context(), tasks)
+	if err != nil {
+		if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+			return
+		}
+		rest.InternalError(c, err)
+		return
+	}
+	if len(items) == 0 {
+		rest.Created(c, gin.H{})
+		return
+	}
+
+	unassigned := resp.GetUnassignedTaskIds()
+	if unassigned == nil {
+		unassigned = []string{}
+	}
+	rest.Created(c, gin.H{
+		"task":              items[0],
+		"subtasks":          items[1:],
+		"unassignedTaskIds": unassigned,
+	})
+}
+
+// Share handles POST /api/tasks/:id/shares.
+// Guests of the organization only see the tasks shared with them.
+func (h *TaskHandler) Share(c *gin.Context) {
+	var payload dto.ShareTaskPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	task, err := h.taskService.Share(c.Request.Context(), c.Param("id"), payload.UserID)
+	h.respondTask(c, task, err)
+}
+
+// Unshare handles DELETE /api/tasks/:id/shares/:userId.
+func (h *TaskHandler) Unshare(c *gin.Context) {
+	task, err := h.taskService.Unshare(c.Request.Context(), c.Param("id"), c.Param("userId"))
+	h.respondTask(c, task, err)
+}
+
+func (h *TaskHandler) respondTask(c *gin.Context, task *taskpb.Task, err error) {
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	items, err := h.taskService.BuildView(c.Request.Context(), []*taskpb.Task{task})
+	if err != nil {
+		if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+			return
+		}
+		rest.InternalError(c, err)
+		return
+	}
+	if len(items) == 0 {
+		rest.Ok(c, gin.H{})
+		return
+	}
+	rest.Ok(c, items[0])
+}
+
 // Reorder handles POST /api/tasks/reorder.
 func (h *TaskHandler) Reorder(c *gin.Context) {
 	var payload dto.ReorderTasksPayload
@@ -338,3 +459,82 @@ func (h *TaskHandler) DeleteComment(c *gin.Context) {
 	}
 	rest.NoContent(c)
 }
+
+// ListChecklist handles GET /api/tasks/:id/checklist.
+func (h *TaskHandler) ListChecklist(c *gin.Context) {
+	resp, err := h.taskService.ListChecklist(c.Request.Context(), c.Param("id"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("checklist")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": tasktransform.ChecklistToMaps(resp.GetItems())})
+}
+
+// AddChecklistItem handles POST /api/tasks/:id/checklist.
+func (h *TaskHandler) AddChecklistItem(c *gin.Context) {
+	var payload dto.AddChecklistItemPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	item, err := h.taskService.AddChecklistItem(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("checklist")) {
+		return
+	}
+	rest.Created(c, tasktransform.ChecklistItemToMap(item))
+}
+
+// ToggleChecklistItem handles PATCH /api/tasks/:id/checklist/:itemId.
+func (h *TaskHandler) ToggleChecklistItem(c *gin.Context) {
+	var payload dto.ToggleChecklistItemPayload
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&payload); err != nil {
+			rest.Error(c, http.StatusBadRequest, "invalid request payload",
+				rest.WithErrorCode("validation.invalid_payload"))
+			return
+		}
+	}
+
+	item, err := h.taskService.ToggleChecklistItem(c.Request.Context(), payload.Build(c.Param("itemId")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("checklist")) {
+		return
+	}
+	rest.Ok(c, tasktransform.ChecklistItemToMap(item))
+}
+
+// ReorderChecklist handles POST /api/tasks/:id/checklist/reorder.
+func (h *TaskHandler) ReorderChecklist(c *gin.Context) {
+	var payload dto.ReorderChecklistPayload
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "invalid request payload",
+			rest.WithErrorCode("validation.invalid_payload"))
+		return
+	}
+	if err := h.validator.Struct(payload); err != nil {
+		rest.Error(c, http.StatusBadRequest, "validation failed",
+			rest.WithErrorCode("validation.failed"),
+			rest.WithErrorDetails(util.CollectValidationErrors(err)))
+		return
+	}
+
+	resp, err := h.taskService.ReorderChecklist(c.Request.Context(), payload.Build(c.Param("id")))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("checklist")) {
+		return
+	}
+	rest.Ok(c, gin.H{"items": tasktransform.ChecklistToMaps(resp.GetItems())})
+}
+
+// DeleteChecklistItem handles DELETE /api/tasks/:id/checklist/:itemId.
+func (h *TaskHandler) DeleteChecklistItem(c *gin.Context) {
+	if rest.HandleGRPCError(c, h.taskService.DeleteChecklistItem(c.Request.Context(), c.Param("itemId")), rest.WithNamespace("checklist")) {
+		return
+	}
+	rest.NoContent(c)
+}
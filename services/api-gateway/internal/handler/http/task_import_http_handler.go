@@ -0,0 +1,132 @@
+package http
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/aliirah/task-flow/shared/rest"
+	tasktransform "github.com/aliirah/task-flow/shared/transform/task"
+)
+
+// maxImportFileSize keeps uploads below the default gRPC message limit.
+const maxImportFileSize = 3 << 20
+
+// Import handles POST /api/organizations/:id/tasks/import.
+// The file is sent either as multipart form field "file" or as the raw body.
+// The format comes from ?format=, the file extension or the content type.
+func (h *TaskHandler) Import(c *gin.Context) {
+	data, filename, err := readImportUpload(c)
+	if err != nil {
+		rest.Error(c, http.StatusBadRequest, err.Error(),
+			rest.WithErrorCode("task.import_invalid_file"))
+		return
+	}
+
+	format := detectImportFormat(c.Query("format"), filename, c.ContentType())
+	if format == "" {
+		rest.Error(c, http.StatusBadRequest, "unable to detect import format, use ?format=csv or ?format=json",
+			rest.WithErrorCode("task.import_invalid_format"))
+		return
+	}
+
+	job, err := h.taskService.StartImport(c.Request.Context(), &taskpb.StartTaskImportRequest{
+		OrganizationId: c.Param("id"),
+		Format:         format,
+		Data:           data,
+		DryRun:         c.Query("dryRun") == "true",
+	})
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+
+	if job.GetStatus() == "running" {
+		c.Header("Location", "/api/organizations/"+c.Param("id")+"/tasks/import/"+job.GetId())
+		rest.Custom(c, http.StatusAccepted, "success", tasktransform.ImportJobToMap(job), nil)
+		return
+	}
+	rest.Ok(c, tasktransform.ImportJobToMap(job))
+}
+
+// GetImport handles GET /api/organizations/:id/tasks/import/:jobId.
+func (h *TaskHandler) GetImport(c *gin.Context) {
+	job, err := h.taskService.GetImport(c.Request.Context(), c.Param("jobId"))
+	if rest.HandleGRPCError(c, err, rest.WithNamespace("task")) {
+		return
+	}
+	if job.GetOrganizationId() != c.Param("id") {
+		rest.Error(c, http.StatusNotFound, "import not found",
+			rest.WithErrorCode("task.not_found"))
+		return
+	}
+	rest.Ok(c, tasktransform.ImportJobToMap(job))
+}
+
+func readImportUpload(c *gin.Context) ([]byte, string, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxImportFileSize+(1<<20))
+
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return nil, "", errors.New("multipart upload must include a file field")
+		}
+		if fileHeader.Size > maxImportFileSize {
+			return nil, "", errors.New("import file is too large")
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return nil, "", errors.New("unable to read uploaded file")
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize+1))
+		if err != nil {
+			return nil, "", errors.New("unable to read uploaded file")
+		}
+		if len(data) > maxImportFileSize {
+			return nil, "", errors.New("import file is too large")
+		}
+		return data, fileHeader.Filename, nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(c.Request.Body, maxImportFileSize+1))
+	if err != nil {
+		return nil, "", errors.New("unable to read request body")
+	}
+	if len(data) > maxImportFileSize {
+		return nil, "", errors.New("import file is too large")
+	}
+	if len(data) == 0 {
+		return nil, "", errors.New("import file is empty")
+	}
+	return data, "", nil
+}
+
+func detectImportFormat(query, filename, contentType string) string {
+	switch strings.ToLower(strings.TrimSpace(query)) {
+	case "csv":
+		return "csv"
+	case "json":
+		return "json"
+	}
+
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".csv":
+		return "csv"
+	case ".json":
+		return "json"
+	}
+
+	switch contentType {
+	case "text/csv", "application/csv":
+		return "csv"
+	case "application/json":
+		return "json"
+	}
+	return ""
+}
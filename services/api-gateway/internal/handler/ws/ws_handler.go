@@ -1,6 +1,7 @@
 package ws
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,7 @@ import (
 
 type Handler struct {
 	authService gatewayservice.AuthService
+	verifier    gatewayservice.TokenVerifier
 	orgService  gatewayservice.OrganizationService
 	connMgr     *messaging.ConnectionManager
 }
@@ -23,8 +25,8 @@ type ackMessage struct {
 	Data any    `json:"data,omitempty"`
 }
 
-func NewHandler(authSvc gatewayservice.AuthService, orgSvc gatewayservice.OrganizationService, connMgr *messaging.ConnectionManager) *Handler {
-	return &Handler{authService: authSvc, orgService: orgSvc, connMgr: connMgr}
+func NewHandler(authSvc gatewayservice.AuthService, verifier gatewayservice.TokenVerifier, orgSvc gatewayservice.OrganizationService, connMgr *messaging.ConnectionManager) *Handler {
+	return &Handler{authService: authSvc, verifier: verifier, orgService: orgSvc, connMgr: connMgr}
 }
 
 func (h *Handler) Handle(c *gin.Context) {
@@ -34,14 +36,19 @@ func (h *Handler) Handle(c *gin.Context) {
 		return
 	}
 
-	validateResp, err := h.authService.Validate(c.Request.Context(), &gatewayservice.AuthValidateRequest{AccessToken: token})
+	user, err := h.verifier.Verify(c.Request.Context(), token)
 	if err != nil {
+		if errors.Is(err, gatewayservice.ErrTokenInvalid) || errors.Is(err, gatewayservice.ErrTokenRevoked) {
+			rest.Error(c, http.StatusUnauthorized, "invalid access token", rest.WithErrorCode("auth.unauthenticated"))
+			return
+		}
 		rest.HandleGRPCError(c, err, rest.WithNamespace("auth"))
 		return
 	}
-	user := validateResp.GetUser()
-	if user == nil {
-		rest.Error(c, http.StatusUnauthorized, "invalid access token", rest.WithErrorCode("auth.unauthenticated"))
+	// Realtime updates are for the web client, not scripts
+	if user.IsAPIToken() {
+		rest.Error(c, http.StatusForbidden, "api tokens cannot open realtime connections",
+			rest.WithErrorCode("auth.api_token_not_allowed"))
 		return
 	}
 
@@ -53,19 +60,18 @@ func (h *Handler) Handle(c *gin.Context) {
 
 	h.orgService.ConfigureConnection(conn)
 
-	connID := h.connMgr.Add(user.GetId(), conn)
+	connID := h.connMgr.Add(user.ID, conn)
 
-	allowed, err := h.orgService.SubscribeMemberships(c.Request.Context(), user.GetId(), connID, h.connMgr)
-	if err != nil {
-		log.Warn("list user memberships for websocket", zap.Error(err), zap.String("userId", user.GetId()))
+	if err := h.orgService.SubscribeMemberships(c.Request.Context(), user.ID, connID, h.connMgr); err != nil {
+		log.Warn("list user memberships for websocket", zap.Error(err), zap.String("userId", user.ID))
 	}
 
 	if writeErr := conn.WriteJSON(ackMessage{Type: "connection.established"}); writeErr != nil {
-		log.Warn("write websocket ack", zap.Error(writeErr), zap.String("userId", user.GetId()))
+		log.Warn("write websocket ack", zap.Error(writeErr), zap.String("userId", user.ID))
 		h.connMgr.Remove(connID)
 		_ = conn.Close()
 		return
 	}
 
-	h.orgService.HandleSubscriptionMessages(conn, h.connMgr, connID, user.GetId(), allowed)
+	h.orgService.HandleSubscriptionMessages(conn, h.connMgr, connID, user.ID)
 }
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"github.com/aliirah/task-flow/shared/authctx"
 	"github.com/aliirah/task-flow/shared/logging"
 	"github.com/aliirah/task-flow/shared/rest"
 	requestid "github.com/gin-contrib/requestid"
@@ -9,8 +10,9 @@ import (
 	"go.uber.org/zap"
 )
 
-// RequestContext enriches the request with identifiers and logging metadata. It
-// relies on gin-contrib/requestid to populate the base request ID.
+// RequestContext enriches the request with identifiers, client details and
+// logging metadata. It relies on gin-contrib/requestid to populate the base
+// request ID.
 func RequestContext() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		requestID := requestid.Get(c)
@@ -27,6 +29,10 @@ func RequestContext() gin.HandlerFunc {
 			zap.String("method", c.Request.Method),
 			zap.String("path", c.Request.URL.Path),
 		)
+		ctx = authctx.WithClient(ctx, authctx.Client{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+		})
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
@@ -11,9 +11,9 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// JWTAuth validates bearer tokens via the auth service and attaches user
+// JWTAuth validates bearer tokens with the token verifier and attaches user
 // identity details to the request context.
-func JWTAuth(authSvc service.AuthService) gin.HandlerFunc {
+func JWTAuth(verifier service.TokenVerifier) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if !strings.HasPrefix(authHeader, "Bearer ") {
@@ -31,9 +31,7 @@ func JWTAuth(authSvc service.AuthService) gin.HandlerFunc {
 			return
 		}
 
-		resp, err := authSvc.Validate(c.Request.Context(), &service.AuthValidateRequest{
-			AccessToken: token,
-		})
+		user, err := verifier.Verify(c.Request.Context(), token)
 		if err != nil {
 			rest.Error(c, http.StatusUnauthorized, "invalid or expired token",
 				rest.WithErrorCode("auth.invalid_token"),
@@ -42,16 +40,6 @@ func JWTAuth(authSvc service.AuthService) gin.HandlerFunc {
 			return
 		}
 
-		user := authctx.User{
-			ID:        resp.GetUser().GetId(),
-			Email:     resp.GetUser().GetEmail(),
-			FirstName: resp.GetUser().GetFirstName(),
-			LastName:  resp.GetUser().GetLastName(),
-			Roles:     resp.GetUser().GetRoles(),
-			Status:    resp.GetUser().GetStatus(),
-			UserType:  resp.GetUser().GetUserType(),
-		}
-
 		ctx := logging.ContextWithFields(c.Request.Context(), user.Fields()...)
 		c.Request = c.Request.WithContext(ctx)
 		authctx.AttachToGin(c, user)
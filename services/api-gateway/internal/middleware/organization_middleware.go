@@ -9,15 +9,19 @@ import (
 
 	"github.com/aliirah/task-flow/services/api-gateway/internal/service"
 	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
 	"github.com/aliirah/task-flow/shared/rest"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // RequireOrganizationMember validates that the authenticated user is a member
-// of the organization specified in the request (via query param, path param, or body).
+// of the organization specified in the request (via query param, path param, or body)
+// and that their role grants permission. An empty permission only requires membership.
 // This middleware should be applied after JWTAuth middleware.
-func RequireOrganizationMember(orgSvc service.OrganizationService, paramName string) gin.HandlerFunc {
+func RequireOrganizationMember(orgSvc service.OrganizationService, paramName, permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		user, ok := authctx.UserFromGin(c)
 		if !ok {
@@ -72,34 +76,59 @@ func RequireOrganizationMember(orgSvc service.OrganizationService, paramName str
 			return
 		}
 
-		// Check if user is a member of the organization
-		resp, err := orgSvc.ListUserMemberships(c.Request.Context(), &organizationpb.ListUserMembershipsRequest{
-			UserId: user.ID,
+		// Service keys only reach the organization they were created for
+		if !user.CanAccessOrganization(orgID) {
+			rest.Error(c, http.StatusForbidden, "api token is not valid for this organization",
+				rest.WithErrorCode("organization.not_member"))
+			c.Abort()
+			return
+		}
+
+		// Check the user's role in the organization grants the permission
+		resp, err := orgSvc.CheckPermission(c.Request.Context(), &organizationpb.CheckPermissionRequest{
+			OrganizationId: orgID,
+			UserId:         user.ID,
+			Permission:     permission,
 		})
 		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				rest.Error(c, http.StatusBadRequest, "invalid organization id",
+					rest.WithErrorCode("organization.invalid_id"))
+				c.Abort()
+				return
+			}
 			rest.Error(c, http.StatusInternalServerError, "failed to verify organization membership",
 				rest.WithErrorCode("organization.membership_check_failed"))
 			c.Abort()
 			return
 		}
 
-		isMember := false
-		for _, membership := range resp.GetMemberships() {
-			if membership.GetOrganizationId() == orgID {
-				isMember = true
-				break
+		if !resp.GetMember() {
+			switch resp.GetStatus() {
+			case orgdomain.MemberStatusPending:
+				rest.Error(c, http.StatusForbidden, "your membership in this organization is waiting for approval",
+					rest.WithErrorCode("organization.membership_pending"))
+			case orgdomain.MemberStatusSuspended:
+				rest.Error(c, http.StatusForbidden, "your membership in this organization is suspended",
+					rest.WithErrorCode("organization.membership_suspended"))
+			default:
+				rest.Error(c, http.StatusForbidden, "user is not a member of this organization",
+					rest.WithErrorCode("organization.not_member"))
 			}
+			c.Abort()
+			return
 		}
 
-		if !isMember {
-			rest.Error(c, http.StatusForbidden, "user is not a member of this organization",
-				rest.WithErrorCode("organization.not_member"))
+		if !resp.GetAllowed() {
+			rest.Error(c, http.StatusForbidden, "your role does not allow "+permission+" in this organization",
+				rest.WithErrorCode("organization.permission_denied"))
 			c.Abort()
 			return
 		}
 
-		// Store organization ID in context for handlers to use
+		// Store organization ID and role in context for handlers to use
 		c.Set("organizationId", orgID)
+		c.Set("organizationRole", resp.GetRole())
 		c.Next()
 	}
 }
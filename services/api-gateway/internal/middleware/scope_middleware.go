@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/rest"
+	"github.com/gin-gonic/gin"
+)
+
+// RequireScope limits API tokens to routes their scopes cover: reads need
+// resource:read, anything else resource:write. Logins pass through. Apply
+// after JWTAuth.
+func RequireScope(resource string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := authctx.UserFromGin(c)
+		if !ok {
+			rest.Error(c, http.StatusUnauthorized, "user not authenticated",
+				rest.WithErrorCode("auth.not_authenticated"))
+			c.Abort()
+			return
+		}
+
+		write := c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead
+		scope := authctx.ResourceScope(resource, write)
+		if !user.HasScope(scope) {
+			rest.Error(c, http.StatusForbidden, "api token lacks the "+scope+" scope",
+				rest.WithErrorCode("auth.insufficient_scope"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireSession refuses API tokens, for account management routes only a
+// logged in user may call. Apply after JWTAuth.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := authctx.UserFromGin(c); ok && user.IsAPIToken() {
+			rest.Error(c, http.StatusForbidden, "api tokens cannot be used here",
+				rest.WithErrorCode("auth.api_token_not_allowed"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
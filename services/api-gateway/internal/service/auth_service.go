@@ -9,6 +9,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 type AuthSignUpRequest = authpb.SignUpRequest
@@ -25,6 +26,28 @@ type AuthService interface {
 	Refresh(ctx context.Context, req *AuthRefreshRequest) (*AuthTokenResponse, error)
 	Logout(ctx context.Context, req *AuthLogoutRequest) error
 	Validate(ctx context.Context, req *AuthValidateRequest) (*AuthValidateResponse, error)
+	JWKS(ctx context.Context) (*authpb.JWKS, error)
+	ListRevocations(ctx context.Context) (*authpb.ListRevocationsResponse, error)
+	ListSessions(ctx context.Context) (*authpb.ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, id string) error
+	RevokeAllOtherSessions(ctx context.Context) (*authpb.RevokeSessionsResponse, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	VerifyEmail(ctx context.Context, token string) error
+	RequestEmailVerification(ctx context.Context, email string) error
+	EnrollTOTP(ctx context.Context, mfaToken string) (*authpb.EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, code, mfaToken string) (*authpb.ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, code string) error
+	RegenerateRecoveryCodes(ctx context.Context, code string) (*authpb.RecoveryCodesResponse, error)
+	VerifyMFA(ctx context.Context, mfaToken, code string) (*AuthTokenResponse, error)
+	UnlockAccount(ctx context.Context, userID string) error
+	ListOIDCProviders(ctx context.Context) (*authpb.ListOIDCProvidersResponse, error)
+	StartOIDCLogin(ctx context.Context, provider, redirectTo string) (string, error)
+	CompleteOIDCLogin(ctx context.Context, provider, state, code string) (*authpb.CompleteOIDCLoginResponse, error)
+	CreateAPIToken(ctx context.Context, req *authpb.CreateAPITokenRequest) (*authpb.CreateAPITokenResponse, error)
+	ListAPITokens(ctx context.Context, organizationID string) (*authpb.ListAPITokensResponse, error)
+	RevokeAPIToken(ctx context.Context, id string) error
+	ChangePassword(ctx context.Context, currentPassword, newPassword string) error
 	BuildTokenPayload(resp *AuthTokenResponse) (gin.H, error)
 	ExtractToken(c *gin.Context) string
 }
@@ -41,21 +64,21 @@ func (s *authService) Login(ctx context.Context, req *AuthLoginRequest) (*AuthTo
 	if s.client == nil {
 		return nil, errors.New("auth service client not configured")
 	}
-	return s.client.Login(ctx, req)
+	return s.client.Login(withOutgoingClient(ctx), req)
 }
 
 func (s *authService) SignUp(ctx context.Context, req *AuthSignUpRequest) (*AuthTokenResponse, error) {
 	if s.client == nil {
 		return nil, errors.New("auth service client not configured")
 	}
-	return s.client.SignUp(ctx, req)
+	return s.client.SignUp(withOutgoingClient(ctx), req)
 }
 
 func (s *authService) Refresh(ctx context.Context, req *AuthRefreshRequest) (*AuthTokenResponse, error) {
 	if s.client == nil {
 		return nil, errors.New("auth service client not configured")
 	}
-	return s.client.Refresh(ctx, req)
+	return s.client.Refresh(withOutgoingClient(ctx), req)
 }
 
 func (s *authService) Logout(ctx context.Context, req *AuthLogoutRequest) error {
@@ -70,7 +93,201 @@ func (s *authService) Validate(ctx context.Context, req *AuthValidateRequest) (*
 	if s.client == nil {
 		return nil, errors.New("auth service client not configured")
 	}
-	return s.client.ValidateToken(ctx, req)
+	return s.client.ValidateToken(withOutgoingClient(ctx), req)
+}
+
+func (s *authService) JWKS(ctx context.Context) (*authpb.JWKS, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.GetJWKS(ctx, &emptypb.Empty{})
+}
+
+func (s *authService) ListRevocations(ctx context.Context) (*authpb.ListRevocationsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.ListRevocations(ctx, &emptypb.Empty{})
+}
+
+func (s *authService) ListSessions(ctx context.Context) (*authpb.ListSessionsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListSessions(ctx, &emptypb.Empty{})
+}
+
+func (s *authService) RevokeSession(ctx context.Context, id string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.RevokeSession(ctx, &authpb.RevokeSessionRequest{Id: id})
+	return err
+}
+
+func (s *authService) RevokeAllOtherSessions(ctx context.Context) (*authpb.RevokeSessionsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.RevokeAllOtherSessions(ctx, &emptypb.Empty{})
+}
+
+func (s *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.RequestPasswordReset(withOutgoingClient(ctx), &authpb.RequestPasswordResetRequest{Email: email})
+	return err
+}
+
+func (s *authService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.ResetPassword(withOutgoingClient(ctx), &authpb.ResetPasswordRequest{
+		Token:       token,
+		NewPassword: newPassword,
+	})
+	return err
+}
+
+func (s *authService) VerifyEmail(ctx context.Context, token string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.VerifyEmail(withOutgoingClient(ctx), &authpb.VerifyEmailRequest{Token: token})
+	return err
+}
+
+func (s *authService) RequestEmailVerification(ctx context.Context, email string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.RequestEmailVerification(withOutgoingClient(ctx), &authpb.RequestEmailVerificationRequest{Email: email})
+	return err
+}
+
+// EnrollTOTP acts on the signed in user, or on the owner of mfaToken when
+// enrollment is part of a login challenge.
+func (s *authService) EnrollTOTP(ctx context.Context, mfaToken string) (*authpb.EnrollTOTPResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	if mfaToken == "" {
+		ctx = withOutgoingAuth(ctx)
+	}
+	return s.client.EnrollTOTP(withOutgoingClient(ctx), &authpb.EnrollTOTPRequest{MfaToken: mfaToken})
+}
+
+func (s *authService) ConfirmTOTP(ctx context.Context, code, mfaToken string) (*authpb.ConfirmTOTPResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	if mfaToken == "" {
+		ctx = withOutgoingAuth(ctx)
+	}
+	return s.client.ConfirmTOTP(withOutgoingClient(ctx), &authpb.ConfirmTOTPRequest{Code: code, MfaToken: mfaToken})
+}
+
+func (s *authService) DisableTOTP(ctx context.Context, code string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.DisableTOTP(ctx, &authpb.DisableTOTPRequest{Code: code})
+	return err
+}
+
+func (s *authService) RegenerateRecoveryCodes(ctx context.Context, code string) (*authpb.RecoveryCodesResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.RegenerateRecoveryCodes(ctx, &authpb.RegenerateRecoveryCodesRequest{Code: code})
+}
+
+func (s *authService) VerifyMFA(ctx context.Context, mfaToken, code string) (*AuthTokenResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.VerifyMFA(withOutgoingClient(ctx), &authpb.VerifyMFARequest{MfaToken: mfaToken, Code: code})
+}
+
+func (s *authService) UnlockAccount(ctx context.Context, userID string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.UnlockAccount(ctx, &authpb.UnlockAccountRequest{UserId: userID})
+	return err
+}
+
+func (s *authService) ListOIDCProviders(ctx context.Context) (*authpb.ListOIDCProvidersResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.ListOIDCProviders(ctx, &emptypb.Empty{})
+}
+
+func (s *authService) StartOIDCLogin(ctx context.Context, provider, redirectTo string) (string, error) {
+	if s.client == nil {
+		return "", errors.New("auth service client not configured")
+	}
+	resp, err := s.client.StartOIDCLogin(withOutgoingClient(ctx), &authpb.StartOIDCLoginRequest{
+		Provider:   provider,
+		RedirectTo: redirectTo,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.GetAuthorizationUrl(), nil
+}
+
+func (s *authService) CompleteOIDCLogin(ctx context.Context, provider, state, code string) (*authpb.CompleteOIDCLoginResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.CompleteOIDCLogin(withOutgoingClient(ctx), &authpb.CompleteOIDCLoginRequest{
+		Provider: provider,
+		State:    state,
+		Code:     code,
+	})
+}
+
+func (s *authService) CreateAPIToken(ctx context.Context, req *authpb.CreateAPITokenRequest) (*authpb.CreateAPITokenResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.CreateAPIToken(withOutgoingAuth(ctx), req)
+}
+
+func (s *authService) ListAPITokens(ctx context.Context, organizationID string) (*authpb.ListAPITokensResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("auth service client not configured")
+	}
+	return s.client.ListAPITokens(withOutgoingAuth(ctx), &authpb.ListAPITokensRequest{OrganizationId: organizationID})
+}
+
+func (s *authService) RevokeAPIToken(ctx context.Context, id string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.RevokeAPIToken(withOutgoingAuth(ctx), &authpb.RevokeAPITokenRequest{Id: id})
+	return err
+}
+
+func (s *authService) ChangePassword(ctx context.Context, currentPassword, newPassword string) error {
+	if s.client == nil {
+		return errors.New("auth service client not configured")
+	}
+	_, err := s.client.ChangePassword(withOutgoingClient(withOutgoingAuth(ctx)), &authpb.ChangePasswordRequest{
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	})
+	return err
 }
 
 func (s *authService) BuildTokenPayload(resp *AuthTokenResponse) (gin.H, error) {
@@ -78,11 +295,25 @@ func (s *authService) BuildTokenPayload(resp *AuthTokenResponse) (gin.H, error)
 		return nil, errors.New("auth service returned empty response")
 	}
 
+	// The login needs a second factor before any token is issued
+	if challenge := resp.GetMfaChallenge(); challenge != nil {
+		return gin.H{
+			"mfaRequired":        true,
+			"mfaToken":           challenge.GetToken(),
+			"mfaExpiresAt":       challenge.GetExpiresAt().AsTime().UTC().Format(time.RFC3339),
+			"enrollmentRequired": challenge.GetEnrollmentRequired(),
+		}, nil
+	}
+
 	payload := gin.H{
 		"accessToken":  resp.GetAccessToken(),
 		"refreshToken": resp.GetRefreshToken(),
 	}
 
+	if sessionID := resp.GetSessionId(); sessionID != "" {
+		payload["sessionId"] = sessionID
+	}
+
 	if expires := resp.GetExpiresAt(); expires != nil {
 		payload["expiresAt"] = expires.AsTime().UTC().Format(time.RFC3339)
 	}
@@ -96,6 +327,9 @@ func (s *authService) BuildTokenPayload(resp *AuthTokenResponse) (gin.H, error)
 			"roles":     user.GetRoles(),
 			"status":    user.GetStatus(),
 			"userType":  user.GetUserType(),
+
+			"emailVerified":    user.GetEmailVerified(),
+			"twoFactorEnabled": user.GetTwoFactorEnabled(),
 		}
 	}
 
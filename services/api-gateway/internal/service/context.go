@@ -12,3 +12,12 @@ func withOutgoingAuth(ctx context.Context) context.Context {
 	}
 	return ctx
 }
+
+// withOutgoingClient forwards the caller's IP and user agent to services
+// that record them, such as auth sessions.
+func withOutgoingClient(ctx context.Context) context.Context {
+	if client, ok := authctx.ClientFromContext(ctx); ok {
+		return authctx.OutgoingClientContext(ctx, client)
+	}
+	return ctx
+}
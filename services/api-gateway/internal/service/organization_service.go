@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	gatewaylog "github.com/aliirah/task-flow/shared/logging"
 	"github.com/aliirah/task-flow/shared/messaging"
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
@@ -24,16 +25,51 @@ type OrganizationService interface {
 	List(ctx context.Context, req *organizationpb.ListOrganizationsRequest) (*organizationpb.ListOrganizationsResponse, error)
 	ListByIDs(ctx context.Context, ids []string) ([]*organizationpb.Organization, error)
 	Update(ctx context.Context, req *organizationpb.UpdateOrganizationRequest) (*organizationpb.Organization, error)
-	Delete(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) error
+	Delete(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) (*organizationpb.Organization, error)
+	CancelDeletion(ctx context.Context, id string) (*organizationpb.Organization, error)
+	TransferOwnership(ctx context.Context, req *organizationpb.TransferOwnershipRequest) (*organizationpb.Organization, error)
 
 	AddMember(ctx context.Context, req *organizationpb.AddMemberRequest) (*organizationpb.OrganizationMember, error)
 	RemoveMember(ctx context.Context, req *organizationpb.RemoveMemberRequest) error
 	ListMembers(ctx context.Context, req *organizationpb.ListMembersRequest) (*organizationpb.ListMembersResponse, error)
 	ListUserMemberships(ctx context.Context, req *organizationpb.ListUserMembershipsRequest) (*organizationpb.ListUserMembershipsResponse, error)
+	UpdateMemberRole(ctx context.Context, req *organizationpb.UpdateMemberRoleRequest) (*organizationpb.OrganizationMember, error)
+	SuspendMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error)
+	ReactivateMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error)
+	ApproveMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error)
+	Leave(ctx context.Context, req *organizationpb.LeaveOrganizationRequest) error
+	CheckPermission(ctx context.Context, req *organizationpb.CheckPermissionRequest) (*organizationpb.CheckPermissionResponse, error)
+
+	CreateInvitation(ctx context.Context, req *organizationpb.CreateInvitationRequest) (*organizationpb.Invitation, error)
+	ListInvitations(ctx context.Context, req *organizationpb.ListInvitationsRequest) (*organizationpb.ListInvitationsResponse, error)
+	ResendInvitation(ctx context.Context, req *organizationpb.InvitationRequest) (*organizationpb.Invitation, error)
+	RevokeInvitation(ctx context.Context, req *organizationpb.InvitationRequest) error
+	ListUserInvitations(ctx context.Context, req *organizationpb.ListUserInvitationsRequest) (*organizationpb.ListInvitationsResponse, error)
+	AcceptInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) (*organizationpb.OrganizationMember, error)
+	DeclineInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) error
+
+	CreateTeam(ctx context.Context, req *organizationpb.CreateTeamRequest) (*organizationpb.Team, error)
+	GetTeam(ctx context.Context, req *organizationpb.TeamRequest) (*organizationpb.Team, error)
+	ListTeams(ctx context.Context, req *organizationpb.ListTeamsRequest) (*organizationpb.ListTeamsResponse, error)
+	UpdateTeam(ctx context.Context, req *organizationpb.UpdateTeamRequest) (*organizationpb.Team, error)
+	DeleteTeam(ctx context.Context, req *organizationpb.TeamRequest) error
+	AddTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error)
+	RemoveTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error)
+
+	GetSettings(ctx context.Context, organizationID string) (*organizationpb.OrganizationSettings, error)
+	UpdateSettings(ctx context.Context, req *organizationpb.UpdateOrganizationSettingsRequest) (*organizationpb.OrganizationSettings, error)
+	GetUsage(ctx context.Context, organizationID string) (*organizationpb.OrganizationUsage, error)
+
+	AddDomain(ctx context.Context, req *organizationpb.AddDomainRequest) (*organizationpb.OrganizationDomain, error)
+	ListDomains(ctx context.Context, organizationID string) (*organizationpb.ListDomainsResponse, error)
+	UpdateDomain(ctx context.Context, req *organizationpb.UpdateDomainRequest) (*organizationpb.OrganizationDomain, error)
+	VerifyDomain(ctx context.Context, req *organizationpb.DomainRequest) (*organizationpb.OrganizationDomain, error)
+	RemoveDomain(ctx context.Context, req *organizationpb.DomainRequest) error
+
 	BuildMemberViews(ctx context.Context, members []*organizationpb.OrganizationMember) ([]gin.H, error)
 	ConfigureConnection(conn *websocket.Conn)
-	SubscribeMemberships(ctx context.Context, userID, connID string, connMgr *messaging.ConnectionManager) (map[string]struct{}, error)
-	HandleSubscriptionMessages(conn *websocket.Conn, connMgr *messaging.ConnectionManager, connID, userID string, allowed map[string]struct{})
+	SubscribeMemberships(ctx context.Context, userID, connID string, connMgr *messaging.ConnectionManager) error
+	HandleSubscriptionMessages(conn *websocket.Conn, connMgr *messaging.ConnectionManager, connID, userID string)
 }
 
 func NewOrganizationService(client organizationpb.OrganizationServiceClient, userSvc UserService) OrganizationService {
@@ -92,13 +128,28 @@ func (s *organizationService) Update(ctx context.Context, req *organizationpb.Up
 	return s.client.UpdateOrganization(ctx, req)
 }
 
-func (s *organizationService) Delete(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) error {
+func (s *organizationService) Delete(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) (*organizationpb.Organization, error) {
 	if s.client == nil {
-		return errors.New("organization service client not configured")
+		return nil, errors.New("organization service client not configured")
 	}
 	ctx = withOutgoingAuth(ctx)
-	_, err := s.client.DeleteOrganization(ctx, req)
-	return err
+	return s.client.DeleteOrganization(ctx, req)
+}
+
+func (s *organizationService) CancelDeletion(ctx context.Context, id string) (*organizationpb.Organization, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CancelOrganizationDeletion(ctx, &organizationpb.CancelOrganizationDeletionRequest{Id: id})
+}
+
+func (s *organizationService) TransferOwnership(ctx context.Context, req *organizationpb.TransferOwnershipRequest) (*organizationpb.Organization, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.TransferOwnership(ctx, req)
 }
 
 func (s *organizationService) AddMember(ctx context.Context, req *organizationpb.AddMemberRequest) (*organizationpb.OrganizationMember, error) {
@@ -134,6 +185,235 @@ func (s *organizationService) ListUserMemberships(ctx context.Context, req *orga
 	return s.client.ListUserMemberships(ctx, req)
 }
 
+func (s *organizationService) UpdateMemberRole(ctx context.Context, req *organizationpb.UpdateMemberRoleRequest) (*organizationpb.OrganizationMember, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.UpdateMemberRole(ctx, req)
+}
+
+func (s *organizationService) SuspendMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.SuspendMember(ctx, req)
+}
+
+func (s *organizationService) ReactivateMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ReactivateMember(ctx, req)
+}
+
+func (s *organizationService) ApproveMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ApproveMember(ctx, req)
+}
+
+func (s *organizationService) Leave(ctx context.Context, req *organizationpb.LeaveOrganizationRequest) error {
+	if s.client == nil {
+		return errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.LeaveOrganization(ctx, req)
+	return err
+}
+
+func (s *organizationService) CheckPermission(ctx context.Context, req *organizationpb.CheckPermissionRequest) (*organizationpb.CheckPermissionResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CheckPermission(ctx, req)
+}
+
+func (s *organizationService) CreateInvitation(ctx context.Context, req *organizationpb.CreateInvitationRequest) (*organizationpb.Invitation, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CreateInvitation(ctx, req)
+}
+
+func (s *organizationService) ListInvitations(ctx context.Context, req *organizationpb.ListInvitationsRequest) (*organizationpb.ListInvitationsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListInvitations(ctx, req)
+}
+
+func (s *organizationService) ResendInvitation(ctx context.Context, req *organizationpb.InvitationRequest) (*organizationpb.Invitation, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ResendInvitation(ctx, req)
+}
+
+func (s *organizationService) RevokeInvitation(ctx context.Context, req *organizationpb.InvitationRequest) error {
+	if s.client == nil {
+		return errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.RevokeInvitation(ctx, req)
+	return err
+}
+
+func (s *organizationService) ListUserInvitations(ctx context.Context, req *organizationpb.ListUserInvitationsRequest) (*organizationpb.ListInvitationsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListUserInvitations(ctx, req)
+}
+
+func (s *organizationService) AcceptInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) (*organizationpb.OrganizationMember, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.AcceptInvitation(ctx, req)
+}
+
+func (s *organizationService) DeclineInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) error {
+	if s.client == nil {
+		return errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.DeclineInvitation(ctx, req)
+	return err
+}
+
+func (s *organizationService) CreateTeam(ctx context.Context, req *organizationpb.CreateTeamRequest) (*organizationpb.Team, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CreateTeam(ctx, req)
+}
+
+func (s *organizationService) GetTeam(ctx context.Context, req *organizationpb.TeamRequest) (*organizationpb.Team, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.GetTeam(ctx, req)
+}
+
+func (s *organizationService) ListTeams(ctx context.Context, req *organizationpb.ListTeamsRequest) (*organizationpb.ListTeamsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListTeams(ctx, req)
+}
+
+func (s *organizationService) UpdateTeam(ctx context.Context, req *organizationpb.UpdateTeamRequest) (*organizationpb.Team, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.UpdateTeam(ctx, req)
+}
+
+func (s *organizationService) DeleteTeam(ctx context.Context, req *organizationpb.TeamRequest) error {
+	if s.client == nil {
+		return errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.DeleteTeam(ctx, req)
+	return err
+}
+
+func (s *organizationService) AddTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.AddTeamMember(ctx, req)
+}
+
+func (s *organizationService) RemoveTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.RemoveTeamMember(ctx, req)
+}
+
+func (s *organizationService) GetSettings(ctx context.Context, organizationID string) (*organizationpb.OrganizationSettings, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.GetOrganizationSettings(ctx, &organizationpb.GetOrganizationSettingsRequest{OrganizationId: organizationID})
+}
+
+func (s *organizationService) UpdateSettings(ctx context.Context, req *organizationpb.UpdateOrganizationSettingsRequest) (*organizationpb.OrganizationSettings, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.UpdateOrganizationSettings(ctx, req)
+}
+
+func (s *organizationService) GetUsage(ctx context.Context, organizationID string) (*organizationpb.OrganizationUsage, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.GetOrganizationUsage(ctx, &organizationpb.GetOrganizationUsageRequest{OrganizationId: organizationID})
+}
+
+func (s *organizationService) AddDomain(ctx context.Context, req *organizationpb.AddDomainRequest) (*organizationpb.OrganizationDomain, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.AddDomain(ctx, req)
+}
+
+func (s *organizationService) ListDomains(ctx context.Context, organizationID string) (*organizationpb.ListDomainsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListDomains(ctx, &organizationpb.ListDomainsRequest{OrganizationId: organizationID})
+}
+
+func (s *organizationService) UpdateDomain(ctx context.Context, req *organizationpb.UpdateDomainRequest) (*organizationpb.OrganizationDomain, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.UpdateDomain(ctx, req)
+}
+
+func (s *organizationService) VerifyDomain(ctx context.Context, req *organizationpb.DomainRequest) (*organizationpb.OrganizationDomain, error) {
+	if s.client == nil {
+		return nil, errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.VerifyDomain(ctx, req)
+}
+
+func (s *organizationService) RemoveDomain(ctx context.Context, req *organizationpb.DomainRequest) error {
+	if s.client == nil {
+		return errors.New("organization service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.RemoveDomain(ctx, req)
+	return err
+}
+
 func (s *organizationService) BuildMemberViews(ctx context.Context, members []*organizationpb.OrganizationMember) ([]gin.H, error) {
 	if len(members) == 0 {
 		return []gin.H{}, nil
@@ -212,26 +492,31 @@ func (s *organizationService) ConfigureConnection(conn *websocket.Conn) {
 	})
 }
 
-func (s *organizationService) SubscribeMemberships(ctx context.Context, userID, connID string, connMgr *messaging.ConnectionManager) (map[string]struct{}, error) {
-	allowed := make(map[string]struct{})
+// SubscribeMemberships grants and subscribes the connection to every
+// organization the user is an active member of. Later membership changes
+// arrive as organization events.
+func (s *organizationService) SubscribeMemberships(ctx context.Context, userID, connID string, connMgr *messaging.ConnectionManager) error {
 	resp, err := s.ListUserMemberships(ctx, &organizationpb.ListUserMembershipsRequest{UserId: userID})
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if connMgr == nil {
+		return nil
 	}
 	for _, membership := range resp.GetMemberships() {
 		orgID := membership.GetOrganizationId()
-		if orgID == "" {
+		if orgID == "" || membership.GetStatus() != orgdomain.MemberStatusActive {
 			continue
 		}
-		allowed[orgID] = struct{}{}
-		if connMgr != nil {
-			_ = connMgr.Subscribe(connID, orgID)
+		if err := connMgr.Grant(connID, orgID, membership.GetRole() == orgdomain.RoleGuest); err != nil {
+			return err
 		}
+		_ = connMgr.Subscribe(connID, orgID)
 	}
-	return allowed, nil
+	return nil
 }
 
-func (s *organizationService) HandleSubscriptionMessages(conn *websocket.Conn, connMgr *messaging.ConnectionManager, connID, userID string, allowed map[string]struct{}) {
+func (s *organizationService) HandleSubscriptionMessages(conn *websocket.Conn, connMgr *messaging.ConnectionManager, connID, userID string) {
 	defer func() {
 		connMgr.Remove(connID)
 		_ = conn.Close()
@@ -254,10 +539,8 @@ func (s *organizationService) HandleSubscriptionMessages(conn *websocket.Conn, c
 			if msg.OrganizationID == "" {
 				continue
 			}
-			if _, ok := allowed[msg.OrganizationID]; ok {
-				if err := connMgr.Subscribe(connID, msg.OrganizationID); err != nil {
-					gatewaylog.Warn("subscribe organization channel", zap.Error(err), zap.String("userId", userID), zap.String("orgId", msg.OrganizationID))
-				}
+			if err := connMgr.Subscribe(connID, msg.OrganizationID); err != nil {
+				gatewaylog.Warn("subscribe organization channel", zap.Error(err), zap.String("userId", userID), zap.String("orgId", msg.OrganizationID))
 			}
 		case "unsubscribe":
 			if msg.OrganizationID == "" {
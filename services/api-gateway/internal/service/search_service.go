@@ -4,17 +4,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/aliirah/task-flow/shared/authctx"
 	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
 	searchpb "github.com/aliirah/task-flow/shared/proto/search/v1"
 )
 
+// ErrSearchForbidden is returned when the caller is not an active member of
+// the organization searched
+var ErrSearchForbidden = errors.New("not an active member of this organization")
+
 type SearchService struct {
 	rpcClient     searchpb.SearchServiceClient
 	httpClient    *http.Client
@@ -42,10 +49,26 @@ func NewSearchService(rpcClient searchpb.SearchServiceClient, orgService Organiz
 	}
 }
 
-func (s *SearchService) Search(ctx context.Context, query string, types []string, limit int, organizationID, userID string) (*contracts.SearchResponse, error) {
+// Search searches an organization on behalf of the authenticated user
+func (s *SearchService) Search(ctx context.Context, query string, types []string, limit int, organizationID string) (*contracts.SearchResponse, error) {
 	if s.rpcClient == nil {
 		return nil, fmt.Errorf("search client not configured")
 	}
+	caller, ok := authctx.UserFromContext(ctx)
+	if !ok || caller.ID == "" {
+		return nil, ErrSearchForbidden
+	}
+	userID := caller.ID
+	membership, err := s.activeMembership(ctx, userID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, ErrSearchForbidden
+	}
+
+	// Guests only find the tasks shared with them and no members
+	guest := membership.GetRole() == orgdomain.RoleGuest
 
 	req := &searchpb.SearchRequest{
 		Query:          query,
@@ -53,6 +76,7 @@ func (s *SearchService) Search(ctx context.Context, query string, types []string
 		Limit:          int32(limit),
 		OrganizationId: organizationID,
 		UserId:         userID,
+		SharedOnly:     guest,
 	}
 	resp, err := s.rpcClient.Search(ctx, req)
 	if err != nil {
@@ -65,13 +89,19 @@ func (s *SearchService) Search(ctx context.Context, query string, types []string
 		if r == nil {
 			continue
 		}
+		if r.GetType() == contracts.SearchTypeUser && guest {
+			continue
+		}
 		if r.GetType() == contracts.SearchTypeUser && organizationID != "" && s.orgService != nil {
 			if allowed, ok := membershipCache[r.GetId()]; ok {
 				if !allowed {
 					continue
 				}
 			} else {
-				allowed := s.userBelongsToOrg(ctx, r.GetId(), organizationID)
+				allowed, err := s.userBelongsToOrg(ctx, r.GetId(), organizationID)
+				if err != nil {
+					return nil, err
+				}
 				membershipCache[r.GetId()] = allowed
 				if !allowed {
 					continue
@@ -99,12 +129,12 @@ func (s *SearchService) Search(ctx context.Context, query string, types []string
 	}, nil
 }
 
-func (s *SearchService) Suggest(ctx context.Context, query string, limit int, organizationID, userID string) ([]string, error) {
+func (s *SearchService) Suggest(ctx context.Context, query string, limit int, organizationID string) ([]string, error) {
 	if limit <= 0 {
 		limit = 8
 	}
 
-	resp, err := s.Search(ctx, query, nil, limit*3, organizationID, userID)
+	resp, err := s.Search(ctx, query, nil, limit*3, organizationID)
 	if err != nil {
 		return nil, err
 	}
@@ -158,9 +188,17 @@ func (s *SearchService) TriggerReindex(ctx context.Context, types []string) erro
 	return nil
 }
 
-func (s *SearchService) userBelongsToOrg(ctx context.Context, userID, organizationID string) bool {
+func (s *SearchService) userBelongsToOrg(ctx context.Context, userID, organizationID string) (bool, error) {
+	membership, err := s.activeMembership(ctx, userID, organizationID)
+	return membership != nil, err
+}
+
+// activeMembership returns the user's membership of the organization, nil
+// when they are not an active member. Failed lookups are returned as errors
+// so they are not mistaken for a missing membership.
+func (s *SearchService) activeMembership(ctx context.Context, userID, organizationID string) (*organizationpb.OrganizationMember, error) {
 	if userID == "" || organizationID == "" || s.orgService == nil {
-		return false
+		return nil, nil
 	}
 
 	ctx = withOutgoingAuth(ctx)
@@ -168,15 +206,18 @@ func (s *SearchService) userBelongsToOrg(ctx context.Context, userID, organizati
 		UserId: userID,
 	})
 	if err != nil {
-		return false
+		return nil, fmt.Errorf("failed to fetch memberships: %w", err)
 	}
 
 	for _, m := range resp.GetMemberships() {
 		if m.GetOrganizationId() == organizationID {
-			return true
+			if m.GetStatus() != orgdomain.MemberStatusActive {
+				return nil, nil
+			}
+			return m, nil
 		}
 	}
-	return false
+	return nil, nil
 }
 
 var htmlStripper = regexp.MustCompile(`<[^>]*>`)
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -22,7 +23,11 @@ type TaskService interface {
 	List(ctx context.Context, req *taskpb.ListTasksRequest) (*taskpb.ListTasksResponse, error)
 	Update(ctx context.Context, req *taskpb.UpdateTaskRequest) (*taskpb.Task, error)
 	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (*taskpb.Task, error)
+	Clone(ctx context.Context, req *taskpb.CloneTaskRequest) (*taskpb.CloneTaskResponse, error)
 	Reorder(ctx context.Context, req *taskpb.ReorderTasksRequest) error
+	Share(ctx context.Context, taskID, userID string) (*taskpb.Task, error)
+	Unshare(ctx context.Context, taskID, userID string) (*taskpb.Task, error)
 	BuildView(ctx context.Context, tasks []*taskpb.Task) ([]gin.H, error)
 
 	// Comment operations
@@ -31,6 +36,22 @@ type TaskService interface {
 	ListComments(ctx context.Context, req *taskpb.ListCommentsRequest) (*taskpb.ListCommentsResponse, error)
 	UpdateComment(ctx context.Context, req *taskpb.UpdateCommentRequest) (*taskpb.Comment, error)
 	DeleteComment(ctx context.Context, id string) error
+
+	// Checklist operations
+	ListChecklist(ctx context.Context, taskID string) (*taskpb.ListChecklistItemsResponse, error)
+	AddChecklistItem(ctx context.Context, req *taskpb.AddChecklistItemRequest) (*taskpb.ChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, req *taskpb.ToggleChecklistItemRequest) (*taskpb.ChecklistItem, error)
+	ReorderChecklist(ctx context.Context, req *taskpb.ReorderChecklistItemsRequest) (*taskpb.ListChecklistItemsResponse, error)
+	DeleteChecklistItem(ctx context.Context, id string) error
+
+	// Bulk import
+	StartImport(ctx context.Context, req *taskpb.StartTaskImportRequest) (*taskpb.ImportJob, error)
+	GetImport(ctx context.Context, id string) (*taskpb.ImportJob, error)
+
+	// Export and calendar feeds
+	Export(ctx context.Context, req *taskpb.ExportTasksRequest) (grpc.ServerStreamingClient[taskpb.ExportTaskRow], error)
+	CreateCalendarFeedToken(ctx context.Context, organizationID string) (*taskpb.CalendarFeedToken, error)
+	RevokeCalendarFeedToken(ctx context.Context, organizationID string) error
 }
 
 type taskService struct {
@@ -85,6 +106,38 @@ func (s *taskService) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+func (s *taskService) Restore(ctx context.Context, id string) (*taskpb.Task, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.RestoreTask(ctx, &taskpb.RestoreTaskRequest{Id: id})
+}
+
+func (s *taskService) Clone(ctx context.Context, req *taskpb.CloneTaskRequest) (*taskpb.CloneTaskResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CloneTask(ctx, req)
+}
+
+func (s *taskService) Share(ctx context.Context, taskID, userID string) (*taskpb.Task, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ShareTask(ctx, &taskpb.TaskShareRequest{TaskId: taskID, UserId: userID})
+}
+
+func (s *taskService) Unshare(ctx context.Context, taskID, userID string) (*taskpb.Task, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.UnshareTask(ctx, &taskpb.TaskShareRequest{TaskId: taskID, UserId: userID})
+}
+
 func (s *taskService) BuildView(ctx context.Context, tasks []*taskpb.Task) ([]gin.H, error) {
 	if len(tasks) == 0 {
 		return []gin.H{}, nil
@@ -227,3 +280,85 @@ func (s *taskService) Reorder(ctx context.Context, req *taskpb.ReorderTasksReque
 	_, err := s.client.ReorderTasks(ctx, req)
 	return err
 }
+
+func (s *taskService) ListChecklist(ctx context.Context, taskID string) (*taskpb.ListChecklistItemsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ListChecklistItems(ctx, &taskpb.ListChecklistItemsRequest{TaskId: taskID})
+}
+
+func (s *taskService) AddChecklistItem(ctx context.Context, req *taskpb.AddChecklistItemRequest) (*taskpb.ChecklistItem, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.AddChecklistItem(ctx, req)
+}
+
+func (s *taskService) ToggleChecklistItem(ctx context.Context, req *taskpb.ToggleChecklistItemRequest) (*taskpb.ChecklistItem, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ToggleChecklistItem(ctx, req)
+}
+
+func (s *taskService) ReorderChecklist(ctx context.Context, req *taskpb.ReorderChecklistItemsRequest) (*taskpb.ListChecklistItemsResponse, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ReorderChecklistItems(ctx, req)
+}
+
+func (s *taskService) DeleteChecklistItem(ctx context.Context, id string) error {
+	if s.client == nil {
+		return errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.DeleteChecklistItem(ctx, &taskpb.DeleteChecklistItemRequest{Id: id})
+	return err
+}
+
+func (s *taskService) StartImport(ctx context.Context, req *taskpb.StartTaskImportRequest) (*taskpb.ImportJob, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.StartTaskImport(ctx, req)
+}
+
+func (s *taskService) GetImport(ctx context.Context, id string) (*taskpb.ImportJob, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.GetTaskImport(ctx, &taskpb.GetTaskImportRequest{Id: id})
+}
+
+func (s *taskService) Export(ctx context.Context, req *taskpb.ExportTasksRequest) (grpc.ServerStreamingClient[taskpb.ExportTaskRow], error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.ExportTasks(ctx, req)
+}
+
+func (s *taskService) CreateCalendarFeedToken(ctx context.Context, organizationID string) (*taskpb.CalendarFeedToken, error) {
+	if s.client == nil {
+		return nil, errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	return s.client.CreateCalendarFeedToken(ctx, &taskpb.CreateCalendarFeedTokenRequest{OrganizationId: organizationID})
+}
+
+func (s *taskService) RevokeCalendarFeedToken(ctx context.Context, organizationID string) error {
+	if s.client == nil {
+		return errors.New("task service client not configured")
+	}
+	ctx = withOutgoingAuth(ctx)
+	_, err := s.client.RevokeCalendarFeedToken(ctx, &taskpb.RevokeCalendarFeedTokenRequest{OrganizationId: organizationID})
+	return err
+}
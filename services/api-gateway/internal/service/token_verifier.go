@@ -0,0 +1,363 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/contracts"
+	"github.com/aliirah/task-flow/shared/jwks"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/metrics"
+)
+
+var (
+	ErrTokenInvalid = errors.New("invalid or expired token")
+	ErrTokenRevoked = errors.New("token revoked")
+)
+
+const (
+	// jwksMinRefresh stops unknown kids from hammering auth-service
+	jwksMinRefresh = 30 * time.Second
+	// bootstrapRetry is the delay between attempts to seed the caches
+	bootstrapRetry = 5 * time.Second
+	// apiTokenPrefix starts personal access tokens and service keys
+	apiTokenPrefix = "tf_"
+)
+
+// TokenVerifier checks access tokens locally against the published JWKS and
+// a revocation list pushed by auth-service. Tokens it cannot decide on, such
+// as unknown kids or legacy HS256 tokens, fall back to the Validate RPC, as
+// do API tokens, which are opaque.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (authctx.User, error)
+	ApplyRevocation(event contracts.TokenRevokedEvent)
+	Run(ctx context.Context, refreshInterval time.Duration)
+}
+
+func NewTokenVerifier(authSvc AuthService, issuer string) TokenVerifier {
+	return &tokenVerifier{
+		auth:          authSvc,
+		issuer:        issuer,
+		keys:          map[string]verificationKey{},
+		revokedTokens: map[string]time.Time{},
+		revokedUsers:  map[string]userRevocation{},
+	}
+}
+
+type verificationKey struct {
+	alg    string
+	public crypto.PublicKey
+}
+
+type userRevocation struct {
+	before    time.Time
+	expiresAt time.Time
+}
+
+type tokenClaims struct {
+	Email     string   `json:"email"`
+	FirstName string   `json:"given_name"`
+	LastName  string   `json:"family_name"`
+	Roles     []string `json:"roles"`
+	Status    string   `json:"status"`
+	UserType  string   `json:"user_type"`
+	SessionID string   `json:"sid"`
+	// EmailVerified is missing from tokens issued before it was added
+	EmailVerified *bool `json:"email_verified"`
+	jwt.RegisteredClaims
+}
+
+type tokenVerifier struct {
+	auth   AuthService
+	issuer string
+
+	mu            sync.RWMutex
+	ready         bool
+	keys          map[string]verificationKey
+	fetchedAt     time.Time
+	revokedTokens map[string]time.Time // jti -> token expiry
+	revokedUsers  map[string]userRevocation
+
+	refreshMu sync.Mutex
+}
+
+// Run seeds the key set and revocation list, then refreshes keys every
+// refreshInterval until ctx is done. Until seeding succeeds every token is
+// checked over RPC so no revocation is missed.
+func (v *tokenVerifier) Run(ctx context.Context, refreshInterval time.Duration) {
+	for {
+		err := v.bootstrap(ctx)
+		if err == nil {
+			break
+		}
+		log.S().Warnw("token verifier bootstrap failed, using auth-service fallback", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(bootstrapRetry):
+		}
+	}
+
+	ticker := time.NewTicker(refreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if err := v.refreshKeys(ctx); err != nil {
+			log.S().Warnw("failed to refresh jwks", "error", err)
+		}
+		v.pruneRevocations()
+	}
+}
+
+func (v *tokenVerifier) bootstrap(ctx context.Context) error {
+	if err := v.refreshKeys(ctx); err != nil {
+		return err
+	}
+
+	resp, err := v.auth.ListRevocations(ctx)
+	if err != nil {
+		return fmt.Errorf("list revocations: %w", err)
+	}
+	for _, item := range resp.GetRevocations() {
+		event := contracts.TokenRevokedEvent{
+			TokenID:   item.GetTokenId(),
+			UserID:    item.GetUserId(),
+			ExpiresAt: item.GetExpiresAt().AsTime().Format(time.RFC3339),
+		}
+		if item.GetRevokedBefore() != nil {
+			event.RevokedBefore = item.GetRevokedBefore().AsTime().Format(time.RFC3339)
+		}
+		v.ApplyRevocation(event)
+	}
+
+	v.mu.Lock()
+	v.ready = true
+	v.mu.Unlock()
+	log.S().Infow("token verifier ready", "revocations", len(resp.GetRevocations()))
+	return nil
+}
+
+func (v *tokenVerifier) Verify(ctx context.Context, token string) (authctx.User, error) {
+	if strings.HasPrefix(token, apiTokenPrefix) {
+		metrics.TokenVerificationTotal.WithLabelValues("api_token").Inc()
+		return v.validate(ctx, token)
+	}
+
+	v.mu.RLock()
+	ready := v.ready
+	v.mu.RUnlock()
+	if !ready {
+		return v.fallback(ctx, token)
+	}
+
+	claims := &tokenClaims{}
+	unverified, _, err := jwt.NewParser().ParseUnverified(token, claims)
+	if err != nil {
+		metrics.TokenVerificationTotal.WithLabelValues("invalid").Inc()
+		return authctx.User{}, ErrTokenInvalid
+	}
+	// Tokens without a jti predate local verification and lack profile claims
+	if unverified.Method.Alg() == jwt.SigningMethodHS256.Alg() || claims.ID == "" || claims.EmailVerified == nil {
+		return v.fallback(ctx, token)
+	}
+
+	kid, _ := unverified.Header["kid"].(string)
+	key, ok := v.lookupKey(ctx, kid)
+	if !ok {
+		return v.fallback(ctx, token)
+	}
+
+	claims = &tokenClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return key.public, nil
+	},
+		jwt.WithValidMethods([]string{key.alg}),
+		jwt.WithIssuer(v.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil || !parsed.Valid {
+		metrics.TokenVerificationTotal.WithLabelValues("invalid").Inc()
+		return authctx.User{}, ErrTokenInvalid
+	}
+
+	if v.isRevoked(claims) {
+		metrics.TokenVerificationTotal.WithLabelValues("revoked").Inc()
+		return authctx.User{}, ErrTokenRevoked
+	}
+
+	metrics.TokenVerificationTotal.WithLabelValues("local").Inc()
+	return authctx.User{
+		ID:        claims.Subject,
+		Email:     claims.Email,
+		FirstName: claims.FirstName,
+		LastName:  claims.LastName,
+		Roles:     claims.Roles,
+		Status:    claims.Status,
+		UserType:  claims.UserType,
+		SessionID: claims.SessionID,
+
+		EmailVerified: *claims.EmailVerified,
+	}, nil
+}
+
+func (v *tokenVerifier) ApplyRevocation(event contracts.TokenRevokedEvent) {
+	expiresAt, err := time.Parse(time.RFC3339, event.ExpiresAt)
+	if err != nil || !expiresAt.After(time.Now()) {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if event.TokenID != "" {
+		v.revokedTokens[event.TokenID] = expiresAt
+		return
+	}
+
+	before, err := time.Parse(time.RFC3339, event.RevokedBefore)
+	if err != nil || event.UserID == "" {
+		return
+	}
+	// Keep the latest cut-off, it covers every earlier one
+	if current, ok := v.revokedUsers[event.UserID]; ok && current.before.After(before) {
+		return
+	}
+	v.revokedUsers[event.UserID] = userRevocation{before: before, expiresAt: expiresAt}
+}
+
+func (v *tokenVerifier) fallback(ctx context.Context, token string) (authctx.User, error) {
+	metrics.TokenVerificationTotal.WithLabelValues("fallback").Inc()
+	return v.validate(ctx, token)
+}
+
+// validate resolves the token with auth-service
+func (v *tokenVerifier) validate(ctx context.Context, token string) (authctx.User, error) {
+	resp, err := v.auth.Validate(ctx, &AuthValidateRequest{AccessToken: token})
+	if err != nil {
+		return authctx.User{}, err
+	}
+	user := resp.GetUser()
+	if user == nil {
+		return authctx.User{}, ErrTokenInvalid
+	}
+	return authctx.User{
+		ID:        user.GetId(),
+		Email:     user.GetEmail(),
+		FirstName: user.GetFirstName(),
+		LastName:  user.GetLastName(),
+		Roles:     user.GetRoles(),
+		Status:    user.GetStatus(),
+		UserType:  user.GetUserType(),
+		SessionID: resp.GetSessionId(),
+
+		EmailVerified:  user.GetEmailVerified(),
+		TokenID:        resp.GetApiTokenId(),
+		Scopes:         resp.GetScopes(),
+		OrganizationID: resp.GetOrganizationId(),
+	}, nil
+}
+
+func (v *tokenVerifier) lookupKey(ctx context.Context, kid string) (verificationKey, bool) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	fetchedAt := v.fetchedAt
+	v.mu.RUnlock()
+	if ok || kid == "" || time.Since(fetchedAt) < jwksMinRefresh {
+		return key, ok
+	}
+
+	// A new kid usually means auth-service rotated its key
+	if err := v.refreshKeys(ctx); err != nil {
+		log.S().Warnw("failed to refresh jwks for unknown kid", "kid", kid, "error", err)
+		return verificationKey{}, false
+	}
+
+	v.mu.RLock()
+	key, ok = v.keys[kid]
+	v.mu.RUnlock()
+	return key, ok
+}
+
+func (v *tokenVerifier) refreshKeys(ctx context.Context) error {
+	v.refreshMu.Lock()
+	defer v.refreshMu.Unlock()
+
+	resp, err := v.auth.JWKS(ctx)
+	if err != nil {
+		metrics.JWKSRefreshTotal.WithLabelValues("error").Inc()
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+
+	keys := make(map[string]verificationKey, len(resp.GetKeys()))
+	for _, item := range resp.GetKeys() {
+		key := jwks.Key{
+			Kty: item.GetKty(),
+			Kid: item.GetKid(),
+			Use: item.GetUse(),
+			Alg: item.GetAlg(),
+			N:   item.GetN(),
+			E:   item.GetE(),
+			Crv: item.GetCrv(),
+			X:   item.GetX(),
+		}
+		public, err := key.PublicKey()
+		if err != nil {
+			log.S().Warnw("skipping unusable jwk", "kid", key.Kid, "error", err)
+			continue
+		}
+		keys[key.Kid] = verificationKey{alg: key.Alg, public: public}
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	metrics.JWKSRefreshTotal.WithLabelValues("success").Inc()
+	return nil
+}
+
+func (v *tokenVerifier) isRevoked(claims *tokenClaims) bool {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	if _, ok := v.revokedTokens[claims.ID]; ok {
+		return true
+	}
+	revocation, ok := v.revokedUsers[claims.Subject]
+	if !ok || claims.IssuedAt == nil {
+		return ok
+	}
+	return claims.IssuedAt.Time.Before(revocation.before)
+}
+
+func (v *tokenVerifier) pruneRevocations() {
+	now := time.Now()
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for id, expiresAt := range v.revokedTokens {
+		if !expiresAt.After(now) {
+			delete(v.revokedTokens, id)
+		}
+	}
+	for userID, revocation := range v.revokedUsers {
+		if !revocation.expiresAt.After(now) {
+			delete(v.revokedUsers, userID)
+		}
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	requestid "github.com/gin-contrib/requestid"
@@ -153,8 +155,23 @@ func main() {
 	}()
 	log.Info("Notification event consumer successfully initialized")
 
+	// Keep live subscriptions in line with organization membership
+	organizationEventConsumer := gatewayevent.NewOrganizationConsumer(rabbitmq, connMgr)
+	if err := organizationEventConsumer.Listen(); err != nil {
+		log.Error(fmt.Errorf("failed to start organization event consumer: %w", err))
+		os.Exit(1)
+	}
+
 	// Initialize router with metrics middleware
 	router := gin.Default()
+	// Only trusted proxies may set X-Forwarded-For, the client IP feeds
+	// login throttling and session records
+	if proxies := env.GetString("TRUSTED_PROXIES", ""); proxies != "" {
+		if err := router.SetTrustedProxies(strings.Split(proxies, ",")); err != nil {
+			log.Error(fmt.Errorf("invalid TRUSTED_PROXIES: %w", err))
+			os.Exit(1)
+		}
+	}
 	router.Use(
 		requestid.New(),
 		gatewaymiddleware.RequestContext(),
@@ -168,6 +185,16 @@ func main() {
 	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 	healthHandler := httphandler.NewHealthHandler(gatewayservice.NewHealthService())
 	authSvc := gatewayservice.NewAuthService(grpcClients.Auth)
+
+	// Verify access tokens locally; revocations are pushed by auth-service
+	tokenVerifier := gatewayservice.NewTokenVerifier(authSvc, env.GetString("AUTH_JWT_ISSUER", "task-flow"))
+	revocationConsumer := gatewayevent.NewRevocationConsumer(rabbitmq, tokenVerifier)
+	if err := revocationConsumer.Listen(); err != nil {
+		log.Error(fmt.Errorf("failed to start revocation consumer: %w", err))
+		os.Exit(1)
+	}
+	go tokenVerifier.Run(ctx, time.Duration(env.GetInt("AUTH_JWKS_REFRESH_MINUTES", 5))*time.Minute)
+
 	userSvc := gatewayservice.NewUserService(grpcClients.User)
 	orgSvc := gatewayservice.NewOrganizationService(grpcClients.Organization, userSvc)
 	taskSvc := gatewayservice.NewTaskService(grpcClients.Task, userSvc, orgSvc)
@@ -179,18 +206,18 @@ func main() {
 		env.GetString("SEARCH_SERVICE_TOKEN", ""),
 	)
 
-	authHandler := httphandler.NewAuthHandler(authSvc)
+	authHandler := httphandler.NewAuthHandler(authSvc, oidcStateSecret())
 	userHandler := httphandler.NewUserHandler(userSvc)
 	organizationHandler := httphandler.NewOrganizationHandler(orgSvc)
 	taskHandler := httphandler.NewTaskHandler(taskSvc)
 	notificationHandler := httphandler.NewNotificationHandler(notifSvc)
 	searchHandler := httphandler.NewSearchHandler(searchSvc)
-	wsHandler := wshandler.NewHandler(authSvc, orgSvc, connMgr)
-	authMiddleware := gatewaymiddleware.JWTAuth(authSvc)
+	wsHandler := wshandler.NewHandler(authSvc, tokenVerifier, orgSvc, connMgr)
+	authMiddleware := gatewaymiddleware.JWTAuth(tokenVerifier)
 
-	// Organization membership middleware generator
-	orgMiddlewareGen := func(paramName string) gin.HandlerFunc {
-		return gatewaymiddleware.RequireOrganizationMember(orgSvc, paramName)
+	// Organization membership and permission middleware generator
+	orgMiddlewareGen := func(paramName, permission string) gin.HandlerFunc {
+		return gatewaymiddleware.RequireOrganizationMember(orgSvc, paramName, permission)
 	}
 
 	routes.Register(router, routes.Dependencies{
@@ -217,3 +244,18 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// oidcStateSecret keys the SSO state cookie. Gateway replicas must share it;
+// without one a random key is used, which only works for a single gateway.
+func oidcStateSecret() []byte {
+	if secret := env.GetString("AUTH_OIDC_STATE_SECRET", ""); secret != "" {
+		return []byte(secret)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Error(fmt.Errorf("failed to generate sso state secret: %w", err))
+		os.Exit(1)
+	}
+	log.Warn("AUTH_OIDC_STATE_SECRET is not set, sso logins only complete on the gateway that started them")
+	return secret
+}
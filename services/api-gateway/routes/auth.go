@@ -2,18 +2,62 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	"github.com/gin-gonic/gin"
 )
 
-func registerAuthRoutes(api *gin.RouterGroup, handler *httphandler.AuthHandler, authMiddleware gin.HandlerFunc) {
+func registerAuthRoutes(api *gin.RouterGroup, handler *httphandler.AuthHandler, authMiddleware gin.HandlerFunc, orgMiddlewareGen func(string, string) gin.HandlerFunc) {
 	auth := api.Group("/auth")
 	auth.POST("/signup", handler.SignUp)
 	auth.POST("/login", handler.Login)
 	auth.POST("/refresh", handler.Refresh)
+	auth.POST("/forgot-password", handler.ForgotPassword)
+	auth.POST("/reset-password", handler.ResetPassword)
+	auth.POST("/verify-email", handler.VerifyEmail)
+	auth.POST("/verify-email/resend", handler.ResendVerification)
+	auth.POST("/2fa/verify", handler.VerifyMFA)
+	auth.POST("/2fa/challenge/enroll", handler.EnrollTOTPChallenge)
+	auth.POST("/2fa/challenge/confirm", handler.ConfirmTOTPChallenge)
+	auth.GET("/oidc/providers", handler.OIDCProviders)
+	auth.GET("/oidc/:provider/start", handler.OIDCStart)
+	auth.GET("/oidc/:provider/callback", handler.OIDCCallback)
 
+	// Account management needs a login, API tokens are refused
 	protected := auth.Group("/")
 	if authMiddleware != nil {
-		protected.Use(authMiddleware)
+		protected.Use(authMiddleware, middleware.RequireSession())
 	}
 	protected.POST("/logout", handler.Logout)
+	protected.POST("/password", handler.ChangePassword)
+	protected.GET("/sessions", handler.ListSessions)
+	protected.DELETE("/sessions", handler.RevokeOtherSessions)
+	protected.DELETE("/sessions/:id", handler.RevokeSession)
+	protected.POST("/2fa/enroll", handler.EnrollTOTP)
+	protected.POST("/2fa/confirm", handler.ConfirmTOTP)
+	protected.POST("/2fa/disable", handler.DisableTOTP)
+	protected.POST("/2fa/recovery-codes", handler.RegenerateRecoveryCodes)
+	protected.POST("/users/:id/unlock", handler.UnlockAccount)
+	protected.GET("/tokens", handler.ListAPITokens)
+	protected.POST("/tokens", handler.CreateAPIToken)
+	protected.DELETE("/tokens/:id", handler.RevokeAPIToken)
+
+	// Organization service keys, managed by organization owners and admins
+	serviceKeys := api.Group("/organizations/:id/api-keys")
+	if authMiddleware != nil {
+		serviceKeys.Use(authMiddleware, middleware.RequireSession())
+	}
+	if orgMiddlewareGen != nil {
+		serviceKeys.Use(orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate))
+	}
+	serviceKeys.GET("", handler.ListServiceKeys)
+	serviceKeys.POST("", handler.CreateServiceKey)
+	serviceKeys.DELETE("/:keyId", handler.RevokeServiceKey)
+}
+
+func registerWellKnownRoutes(router *gin.Engine, handler *httphandler.AuthHandler) {
+	if handler == nil {
+		return
+	}
+	router.GET("/.well-known/jwks.json", handler.JWKS)
 }
@@ -2,11 +2,12 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
 	"github.com/gin-gonic/gin"
 )
 
 func registerNotificationRoutes(api *gin.RouterGroup, handler *httphandler.NotificationHandler, authMiddleware gin.HandlerFunc) {
-	notifications := api.Group("/notifications", authMiddleware)
+	notifications := api.Group("/notifications", authMiddleware, middleware.RequireScope("notifications"))
 	{
 		notifications.GET("", handler.List)
 		notifications.GET("/unread/count", handler.GetUnreadCount)
@@ -2,41 +2,116 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	"github.com/gin-gonic/gin"
 )
 
-func registerOrganizationRoutes(api *gin.RouterGroup, handler *httphandler.OrganizationHandler, authMiddleware gin.HandlerFunc, orgMiddlewareGen func(string) gin.HandlerFunc) {
+func registerOrganizationRoutes(api *gin.RouterGroup, handler *httphandler.OrganizationHandler, authMiddleware gin.HandlerFunc, orgMiddlewareGen func(string, string) gin.HandlerFunc) {
 	if handler == nil {
 		return
 	}
 
 	orgs := api.Group("/organizations")
 	if authMiddleware != nil {
-		orgs.Use(authMiddleware)
+		orgs.Use(authMiddleware, middleware.RequireScope("organizations"))
 	}
 
 	orgs.POST("", handler.Create)
 	orgs.GET("", handler.List)
 	orgs.GET("/mine", handler.ListUserMemberships)
+	// Pending and suspended members can leave too, so only the organization
+	// service checks the membership. Like joining, it needs a session.
+	orgs.POST("/:id/leave", middleware.RequireSession(), handler.Leave)
 
-	// Organization-specific routes with membership validation
+	// Organization-specific routes, each gated on a permission of the
+	// member's role
 	if orgMiddlewareGen != nil {
-		orgs.GET("/:id", orgMiddlewareGen("id"), handler.Get)
-		orgs.PATCH("/:id", orgMiddlewareGen("id"), handler.Update)
-		orgs.PUT("/:id", orgMiddlewareGen("id"), handler.Update)
-		orgs.DELETE("/:id", orgMiddlewareGen("id"), handler.Delete)
-
-		orgs.POST("/:id/members", orgMiddlewareGen("id"), handler.AddMember)
-		orgs.GET("/:id/members", orgMiddlewareGen("id"), handler.ListMembers)
-		orgs.DELETE("/:id/members/:userId", orgMiddlewareGen("id"), handler.RemoveMember)
+		orgs.GET("/:id", orgMiddlewareGen("id", orgdomain.PermissionOrgRead), handler.Get)
+		orgs.PATCH("/:id", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.Update)
+		orgs.PUT("/:id", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.Update)
+		orgs.DELETE("/:id", orgMiddlewareGen("id", orgdomain.PermissionOrgDelete), handler.Delete)
+		orgs.POST("/:id/deletion/cancel", orgMiddlewareGen("id", orgdomain.PermissionOrgDelete), handler.CancelDeletion)
+		orgs.POST("/:id/transfer", orgMiddlewareGen("id", orgdomain.PermissionOrgDelete), handler.TransferOwnership)
+		orgs.GET("/:id/settings", orgMiddlewareGen("id", orgdomain.PermissionOrgRead), handler.GetSettings)
+		orgs.PUT("/:id/settings", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.UpdateSettings)
+		orgs.GET("/:id/usage", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.GetUsage)
+
+		orgs.POST("/:id/domains", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.AddDomain)
+		orgs.GET("/:id/domains", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.ListDomains)
+		orgs.PATCH("/:id/domains/:domainId", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.UpdateDomain)
+		orgs.POST("/:id/domains/:domainId/verify", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.VerifyDomain)
+		orgs.DELETE("/:id/domains/:domainId", orgMiddlewareGen("id", orgdomain.PermissionOrgUpdate), handler.RemoveDomain)
+
+		orgs.POST("/:id/members", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.AddMember)
+		orgs.GET("/:id/members", orgMiddlewareGen("id", orgdomain.PermissionMemberRead), handler.ListMembers)
+		orgs.PATCH("/:id/members/:userId", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.UpdateMemberRole)
+		orgs.DELETE("/:id/members/:userId", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.RemoveMember)
+		orgs.POST("/:id/members/:userId/suspend", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.SuspendMember)
+		orgs.POST("/:id/members/:userId/reactivate", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.ReactivateMember)
+		orgs.POST("/:id/members/:userId/approve", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.ApproveMember)
+
+		orgs.POST("/:id/invitations", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.CreateInvitation)
+		orgs.GET("/:id/invitations", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.ListInvitations)
+		orgs.POST("/:id/invitations/:invitationId/resend", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.ResendInvitation)
+		orgs.DELETE("/:id/invitations/:invitationId", orgMiddlewareGen("id", orgdomain.PermissionMemberManage), handler.RevokeInvitation)
+
+		orgs.POST("/:id/teams", orgMiddlewareGen("id", orgdomain.PermissionTeamManage), handler.CreateTeam)
+		orgs.GET("/:id/teams", orgMiddlewareGen("id", orgdomain.PermissionMemberRead), handler.ListTeams)
+		orgs.GET("/:id/teams/:teamId", orgMiddlewareGen("id", orgdomain.PermissionMemberRead), handler.GetTeam)
+		orgs.PATCH("/:id/teams/:teamId", orgMiddlewareGen("id", orgdomain.PermissionTeamManage), handler.UpdateTeam)
+		orgs.DELETE("/:id/teams/:teamId", orgMiddlewareGen("id", orgdomain.PermissionTeamManage), handler.DeleteTeam)
+		// Team leads manage their own team's members, checked by the organization service
+		orgs.POST("/:id/teams/:teamId/members", orgMiddlewareGen("id", orgdomain.PermissionMemberRead), handler.AddTeamMember)
+		orgs.DELETE("/:id/teams/:teamId/members/:userId", orgMiddlewareGen("id", orgdomain.PermissionMemberRead), handler.RemoveTeamMember)
 	} else {
 		orgs.GET("/:id", handler.Get)
 		orgs.PATCH("/:id", handler.Update)
 		orgs.PUT("/:id", handler.Update)
 		orgs.DELETE("/:id", handler.Delete)
+		orgs.POST("/:id/deletion/cancel", handler.CancelDeletion)
+		orgs.POST("/:id/transfer", handler.TransferOwnership)
+		orgs.GET("/:id/settings", handler.GetSettings)
+		orgs.PUT("/:id/settings", handler.UpdateSettings)
+		orgs.GET("/:id/usage", handler.GetUsage)
+
+		orgs.POST("/:id/domains", handler.AddDomain)
+		orgs.GET("/:id/domains", handler.ListDomains)
+		orgs.PATCH("/:id/domains/:domainId", handler.UpdateDomain)
+		orgs.POST("/:id/domains/:domainId/verify", handler.VerifyDomain)
+		orgs.DELETE("/:id/domains/:domainId", handler.RemoveDomain)
 
 		orgs.POST("/:id/members", handler.AddMember)
 		orgs.GET("/:id/members", handler.ListMembers)
+		orgs.PATCH("/:id/members/:userId", handler.UpdateMemberRole)
 		orgs.DELETE("/:id/members/:userId", handler.RemoveMember)
+		orgs.POST("/:id/members/:userId/suspend", handler.SuspendMember)
+		orgs.POST("/:id/members/:userId/reactivate", handler.ReactivateMember)
+		orgs.POST("/:id/members/:userId/approve", handler.ApproveMember)
+
+		orgs.POST("/:id/invitations", handler.CreateInvitation)
+		orgs.GET("/:id/invitations", handler.ListInvitations)
+		orgs.POST("/:id/invitations/:invitationId/resend", handler.ResendInvitation)
+		orgs.DELETE("/:id/invitations/:invitationId", handler.RevokeInvitation)
+
+		orgs.POST("/:id/teams", handler.CreateTeam)
+		orgs.GET("/:id/teams", handler.ListTeams)
+		orgs.GET("/:id/teams/:teamId", handler.GetTeam)
+		orgs.PATCH("/:id/teams/:teamId", handler.UpdateTeam)
+		orgs.DELETE("/:id/teams/:teamId", handler.DeleteTeam)
+		orgs.POST("/:id/teams/:teamId/members", handler.AddTeamMember)
+		orgs.DELETE("/:id/teams/:teamId/members/:userId", handler.RemoveTeamMember)
+	}
+
+	// Invitations addressed to the signed-in user. Accepting joins an
+	// organization, so service keys and access tokens can't do it.
+	invitations := api.Group("/invitations")
+	if authMiddleware != nil {
+		invitations.Use(authMiddleware, middleware.RequireSession())
 	}
+	invitations.GET("", handler.ListMyInvitations)
+	invitations.POST("/accept", handler.AcceptInvitation)
+	invitations.POST("/decline", handler.DeclineInvitation)
+	invitations.POST("/:invitationId/accept", handler.AcceptInvitation)
+	invitations.POST("/:invitationId/decline", handler.DeclineInvitation)
 }
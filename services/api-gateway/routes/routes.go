@@ -16,14 +16,15 @@ type Dependencies struct {
 	Search                    *httphandler.SearchHandler
 	WS                        *wshandler.Handler
 	AuthMiddleware            gin.HandlerFunc
-	OrganizationMiddlewareGen func(paramName string) gin.HandlerFunc
+	OrganizationMiddlewareGen func(paramName, permission string) gin.HandlerFunc
 }
 
 func Register(router *gin.Engine, deps Dependencies) {
 	api := router.Group("/api")
 
+	registerWellKnownRoutes(router, deps.Auth)
 	registerHealthRoutes(api, deps.Health)
-	registerAuthRoutes(api, deps.Auth, deps.AuthMiddleware)
+	registerAuthRoutes(api, deps.Auth, deps.AuthMiddleware, deps.OrganizationMiddlewareGen)
 	registerUserRoutes(api, deps.User, deps.AuthMiddleware)
 	registerOrganizationRoutes(api, deps.Organization, deps.AuthMiddleware, deps.OrganizationMiddlewareGen)
 	registerTaskRoutes(api, deps.Task, deps.AuthMiddleware, deps.OrganizationMiddlewareGen)
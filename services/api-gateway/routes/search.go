@@ -2,6 +2,7 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
 	"github.com/gin-gonic/gin"
 )
 
@@ -11,7 +12,7 @@ func registerSearchRoutes(rg *gin.RouterGroup, handler *httphandler.SearchHandle
 	}
 
 	group := rg.Group("/search")
-	group.Use(auth)
+	group.Use(auth, middleware.RequireScope("tasks"))
 	{
 		group.GET("", handler.Search)
 		group.GET("/suggest", handler.Suggest)
@@ -2,44 +2,82 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	"github.com/gin-gonic/gin"
 )
 
-func registerTaskRoutes(api *gin.RouterGroup, handler *httphandler.TaskHandler, authMiddleware gin.HandlerFunc, orgMiddlewareGen func(string) gin.HandlerFunc) {
+func registerTaskRoutes(api *gin.RouterGroup, handler *httphandler.TaskHandler, authMiddleware gin.HandlerFunc, orgMiddlewareGen func(string, string) gin.HandlerFunc) {
 	if handler == nil {
 		return
 	}
 
 	group := api.Group("/tasks")
 	if authMiddleware != nil {
-		group.Use(authMiddleware)
+		group.Use(authMiddleware, middleware.RequireScope("tasks"))
 	}
 
 	// Create and list don't need per-task org validation
 	// Create: org validation via organizationId in body
 	if orgMiddlewareGen != nil {
-		group.POST("", orgMiddlewareGen(""), handler.Create)
+		group.POST("", orgMiddlewareGen("", orgdomain.PermissionTaskCreate), handler.Create)
+		group.POST("/reorder", orgMiddlewareGen("", orgdomain.PermissionTaskUpdate), handler.Reorder)
 	} else {
 		group.POST("", handler.Create)
+		group.POST("/reorder", handler.Reorder)
 	}
 	
 	group.GET("", handler.List)
-	group.POST("/reorder", handler.Reorder)
 	
-	// Task-specific operations - org membership validated at backend
+	// Task-specific operations - org permissions validated at backend
 	group.GET("/:id", handler.Get)
 	group.PATCH("/:id", handler.Update)
 	group.PUT("/:id", handler.Update)
 	group.DELETE("/:id", handler.Delete)
+	group.POST("/:id/restore", handler.Restore)
+	group.POST("/:id/clone", handler.Clone)
+
+	// Sharing with guests - task.update validated at backend (task's org)
+	group.POST("/:id/shares", handler.Share)
+	group.DELETE("/:id/shares/:userId", handler.Unshare)
 
 	// Comment routes - org membership validated at backend (task's org)
 	group.POST("/:id/comments", handler.CreateComment)
 	group.GET("/:id/comments", handler.ListComments)
 
+	// Checklist routes - org membership validated at backend (task's org)
+	group.GET("/:id/checklist", handler.ListChecklist)
+	group.POST("/:id/checklist", handler.AddChecklistItem)
+	group.POST("/:id/checklist/reorder", handler.ReorderChecklist)
+	group.PATCH("/:id/checklist/:itemId", handler.ToggleChecklistItem)
+	group.DELETE("/:id/checklist/:itemId", handler.DeleteChecklistItem)
+
+	// Organization-scoped task operations
+	orgTasks := api.Group("/organizations/:id/tasks")
+	if authMiddleware != nil {
+		orgTasks.Use(authMiddleware, middleware.RequireScope("tasks"))
+	}
+	if orgMiddlewareGen != nil {
+		orgTasks.POST("/import", orgMiddlewareGen("id", orgdomain.PermissionTaskCreate), handler.Import)
+		orgTasks.GET("/import/:jobId", orgMiddlewareGen("id", orgdomain.PermissionTaskRead), handler.GetImport)
+		orgTasks.GET("/export", orgMiddlewareGen("id", orgdomain.PermissionTaskRead), handler.Export)
+		orgTasks.POST("/export/calendar-token", orgMiddlewareGen("id", orgdomain.PermissionTaskRead), handler.CreateCalendarToken)
+		orgTasks.DELETE("/export/calendar-token", orgMiddlewareGen("id", orgdomain.PermissionTaskRead), handler.RevokeCalendarToken)
+	} else {
+		orgTasks.POST("/import", handler.Import)
+		orgTasks.GET("/import/:jobId", handler.GetImport)
+		orgTasks.GET("/export", handler.Export)
+		orgTasks.POST("/export/calendar-token", handler.CreateCalendarToken)
+		orgTasks.DELETE("/export/calendar-token", handler.RevokeCalendarToken)
+	}
+
+	// Calendar feed - public, authorised by the feed token in the path
+	api.GET("/calendar/:token", handler.CalendarFeed)
+
 	// Comment operations by comment ID - org membership validated at backend
 	comments := api.Group("/comments")
 	if authMiddleware != nil {
-		comments.Use(authMiddleware)
+		comments.Use(authMiddleware, middleware.RequireScope("tasks"))
 	}
 	comments.GET("/:id", handler.GetComment)
 	comments.PATCH("/:id", handler.UpdateComment)
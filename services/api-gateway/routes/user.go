@@ -2,13 +2,14 @@ package routes
 
 import (
 	httphandler "github.com/aliirah/task-flow/services/api-gateway/internal/handler/http"
+	"github.com/aliirah/task-flow/services/api-gateway/internal/middleware"
 	"github.com/gin-gonic/gin"
 )
 
 func registerUserRoutes(api *gin.RouterGroup, handler *httphandler.UserHandler, authMiddleware gin.HandlerFunc) {
 	users := api.Group("/users")
 	if authMiddleware != nil {
-		users.Use(authMiddleware)
+		users.Use(authMiddleware, middleware.RequireScope("users"))
 	}
 	users.GET("", handler.List)
 	users.POST("", handler.Create)
@@ -19,7 +20,7 @@ func registerUserRoutes(api *gin.RouterGroup, handler *httphandler.UserHandler,
 
 	profile := api.Group("/profile")
 	if authMiddleware != nil {
-		profile.Use(authMiddleware)
+		profile.Use(authMiddleware, middleware.RequireScope("users"))
 	}
 	profile.PUT("", handler.UpdateProfile)
 }
@@ -0,0 +1,60 @@
+// Package breach checks passwords against a local copy of a breached
+// password corpus. The copy is laid out like the Pwned Passwords range API:
+// one file per five character SHA-1 prefix, PREFIX.txt, holding
+// "SUFFIX:COUNT" lines. Only the file for the password's prefix is read.
+package breach
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const prefixLength = 5
+
+// Checker reports whether a password is known to be breached
+type Checker interface {
+	Breached(password string) (bool, error)
+}
+
+// RangeDir is a Checker backed by a directory of range files
+type RangeDir struct {
+	dir string
+}
+
+func NewRangeDir(dir string) *RangeDir {
+	return &RangeDir{dir: dir}
+}
+
+func (d *RangeDir) Breached(password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:prefixLength], hash[prefixLength:]
+
+	file, err := os.Open(filepath.Join(d.dir, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry, count, _ := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		// Padding entries, added to hide the real size of a range, count 0
+		if count == "0" {
+			continue
+		}
+		if strings.EqualFold(entry, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
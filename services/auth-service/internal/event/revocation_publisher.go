@@ -0,0 +1,62 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/contracts"
+	"github.com/aliirah/task-flow/shared/messaging"
+)
+
+type RevocationPublisher interface {
+	TokenRevoked(ctx context.Context, revocation *models.TokenRevocation) error
+}
+
+type revocationPublisher struct {
+	mq *messaging.RabbitMQ
+}
+
+func NewRevocationPublisher(mq *messaging.RabbitMQ) RevocationPublisher {
+	if mq == nil {
+		return noopRevocationPublisher{}
+	}
+	return &revocationPublisher{mq: mq}
+}
+
+func (p *revocationPublisher) TokenRevoked(ctx context.Context, revocation *models.TokenRevocation) error {
+	if p == nil || p.mq == nil || revocation == nil {
+		return nil
+	}
+
+	event := contracts.TokenRevokedEvent{
+		TokenID:   revocation.TokenID,
+		UserID:    revocation.UserID.String(),
+		ExpiresAt: revocation.ExpiresAt.UTC().Format(time.RFC3339),
+		Reason:    revocation.Reason,
+	}
+	if revocation.RevokedBefore != nil {
+		event.RevokedBefore = revocation.RevokedBefore.UTC().Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal token revoked event: %w", err)
+	}
+
+	msg := contracts.AmqpMessage{
+		UserID:    event.UserID,
+		EventType: contracts.AuthEventTokenRevoked,
+		Data:      data,
+	}
+
+	return p.mq.PublishMessage(ctx, "auth.revocations", msg)
+}
+
+type noopRevocationPublisher struct{}
+
+func (noopRevocationPublisher) TokenRevoked(context.Context, *models.TokenRevocation) error {
+	return nil
+}
@@ -0,0 +1,64 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// UserStatusSyncer applies user-service status changes to auth records
+type UserStatusSyncer interface {
+	SyncUserStatus(ctx context.Context, userID uuid.UUID, status string) error
+}
+
+// UserConsumer keeps auth users in step with user-service so disabling or
+// deleting a profile revokes its sessions.
+type UserConsumer struct {
+	rabbitmq *messaging.RabbitMQ
+	syncer   UserStatusSyncer
+}
+
+func NewUserConsumer(rabbitmq *messaging.RabbitMQ, syncer UserStatusSyncer) *UserConsumer {
+	return &UserConsumer{rabbitmq: rabbitmq, syncer: syncer}
+}
+
+func (c *UserConsumer) Listen() error {
+	log.S().Info("auth user consumer listening for events")
+	return c.rabbitmq.ConsumeMessages(messaging.AuthUserEventsQueue, c.handle)
+}
+
+func (c *UserConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("unmarshal user amqp message: %w", err)
+	}
+
+	var status string
+	switch amqpMsg.EventType {
+	case contracts.UserEventUpdated:
+		var event contracts.UserEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			return fmt.Errorf("unmarshal user updated event: %w", err)
+		}
+		status = event.Status
+	case contracts.UserEventDeleted:
+		status = "deleted"
+	default:
+		return nil
+	}
+
+	userID, err := uuid.Parse(amqpMsg.UserID)
+	if err != nil || status == "" {
+		// Nothing actionable, drop the message rather than dead-letter it
+		log.S().Warnw("auth user consumer skipping event", "eventType", amqpMsg.EventType, "userId", amqpMsg.UserID)
+		return nil
+	}
+
+	return c.syncer.SyncUserStatus(ctx, userID, status)
+}
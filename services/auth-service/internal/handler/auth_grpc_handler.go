@@ -3,9 +3,13 @@ package handler
 import (
 	"context"
 	"errors"
+	"strings"
 
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
 	"github.com/aliirah/task-flow/services/auth-service/internal/service"
+	"github.com/aliirah/task-flow/shared/authctx"
 	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -28,6 +32,8 @@ func (h *AuthHandler) SignUp(ctx context.Context, req *authpb.SignUpRequest) (*a
 		FirstName: req.GetFirstName(),
 		LastName:  req.GetLastName(),
 		UserType:  req.GetUserType(),
+
+		InvitationToken: req.GetInvitationToken(),
 	})
 	if err != nil {
 		return nil, mapError(err)
@@ -60,23 +66,38 @@ func (h *AuthHandler) Logout(ctx context.Context, req *authpb.LogoutRequest) (*e
 }
 
 func (h *AuthHandler) ValidateToken(ctx context.Context, req *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
-	profile, expiresAt, err := h.svc.Validate(ctx, req.GetAccessToken())
+	info, err := h.svc.Validate(ctx, req.GetAccessToken())
 	if err != nil {
 		return nil, mapError(err)
 	}
 
-	resp := &authpb.ValidateTokenResponse{User: toUserProfile(profile)}
-	if !expiresAt.IsZero() {
-		resp.ExpiresAt = timestamppb.New(expiresAt)
+	resp := &authpb.ValidateTokenResponse{
+		User:           toUserProfile(info.Profile),
+		SessionId:      info.SessionID,
+		ApiTokenId:     info.APITokenID,
+		Scopes:         info.Scopes,
+		OrganizationId: info.OrganizationID,
+	}
+	if !info.ExpiresAt.IsZero() {
+		resp.ExpiresAt = timestamppb.New(info.ExpiresAt)
 	}
 	return resp, nil
 }
 
 func toTokenResponse(bundle service.TokenBundle) *authpb.TokenResponse {
+	if challenge := bundle.MFAChallenge; challenge != nil {
+		return &authpb.TokenResponse{MfaChallenge: &authpb.MFAChallenge{
+			Token:              challenge.Token,
+			ExpiresAt:          timestamppb.New(challenge.ExpiresAt),
+			EnrollmentRequired: challenge.EnrollmentRequired,
+		}}
+	}
+
 	resp := &authpb.TokenResponse{
 		AccessToken:  bundle.AccessToken,
 		RefreshToken: bundle.RefreshToken,
 		ExpiresAt:    timestamppb.New(bundle.ExpiresAt),
+		SessionId:    bundle.SessionID,
 	}
 	if bundle.Profile.ID != "" {
 		resp.User = toUserProfile(bundle.Profile)
@@ -93,6 +114,9 @@ func toUserProfile(profile service.UserProfile) *authpb.UserProfile {
 		Roles:     append([]string{}, profile.Roles...),
 		Status:    profile.Status,
 		UserType:  profile.UserType,
+
+		EmailVerified:    profile.EmailVerified,
+		TwoFactorEnabled: profile.TwoFactorEnabled,
 	}
 }
 
@@ -108,11 +132,407 @@ func mapError(err error) error {
 		return status.Error(codes.Unauthenticated, err.Error())
 	case errors.Is(err, service.ErrAccountDisabled):
 		return status.Error(codes.PermissionDenied, err.Error())
-	case errors.Is(err, service.ErrRefreshTokenInvalid), errors.Is(err, service.ErrRefreshTokenExpired), errors.Is(err, service.ErrTokenInvalid), errors.Is(err, service.ErrTokenExpired):
+	case errors.Is(err, service.ErrEmailNotVerified), errors.Is(err, service.ErrTwoFactorEnabled), errors.Is(err, service.ErrTwoFactorNotEnabled), errors.Is(err, service.ErrTwoFactorRequired), errors.Is(err, service.ErrTOTPNotEnrolled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrAccountLocked), errors.Is(err, service.ErrTooManyLoginAttempts), errors.Is(err, service.ErrAPITokenLimit):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrMFAChallengeInvalid), errors.Is(err, service.ErrOIDCStateInvalid), errors.Is(err, service.ErrOIDCLoginFailed):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrAccountTokenInvalid), errors.Is(err, service.ErrInvalidPassword), errors.Is(err, service.ErrCurrentPasswordInvalid), errors.Is(err, service.ErrMFACodeInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrRefreshTokenInvalid), errors.Is(err, service.ErrRefreshTokenExpired), errors.Is(err, service.ErrRefreshTokenReused), errors.Is(err, service.ErrTokenInvalid), errors.Is(err, service.ErrTokenExpired), errors.Is(err, service.ErrTokenRevoked):
 		return status.Error(codes.Unauthenticated, err.Error())
-	case errors.Is(err, service.ErrUserNotFound):
+	case errors.Is(err, service.ErrOIDCEmailUnverified), errors.Is(err, service.ErrAPITokenNotAllowed), errors.Is(err, service.ErrOrganizationAdminRequired), errors.Is(err, service.ErrUnlockNotAllowed):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrRedirectNotAllowed), errors.Is(err, service.ErrAPITokenInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrUserNotFound), errors.Is(err, service.ErrSessionNotFound), errors.Is(err, service.ErrOIDCProviderNotFound), errors.Is(err, service.ErrAPITokenNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+func (h *AuthHandler) GetJWKS(ctx context.Context, _ *emptypb.Empty) (*authpb.JWKS, error) {
+	set, err := h.svc.JWKS()
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &authpb.JWKS{Keys: make([]*authpb.JSONWebKey, 0, len(set.Keys))}
+	for _, key := range set.Keys {
+		resp.Keys = append(resp.Keys, &authpb.JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) ListRevocations(ctx context.Context, _ *emptypb.Empty) (*authpb.ListRevocationsResponse, error) {
+	revocations, err := h.svc.ListRevocations(ctx)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &authpb.ListRevocationsResponse{Revocations: make([]*authpb.TokenRevocation, 0, len(revocations))}
+	for _, revocation := range revocations {
+		item := &authpb.TokenRevocation{
+			TokenId:   revocation.TokenID,
+			UserId:    revocation.UserID.String(),
+			ExpiresAt: timestamppb.New(revocation.ExpiresAt),
+		}
+		if revocation.RevokedBefore != nil {
+			item.RevokedBefore = timestamppb.New(*revocation.RevokedBefore)
+		}
+		resp.Revocations = append(resp.Revocations, item)
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) ListSessions(ctx context.Context, _ *emptypb.Empty) (*authpb.ListSessionsResponse, error) {
+	user, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := h.svc.ListSessions(ctx, userID, user.SessionID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &authpb.ListSessionsResponse{Sessions: make([]*authpb.Session, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &authpb.Session{
+			Id:           session.ID,
+			Device:       session.Device,
+			UserAgent:    session.UserAgent,
+			IpAddress:    session.IPAddress,
+			CreatedAt:    timestamppb.New(session.CreatedAt),
+			LastActiveAt: timestamppb.New(session.LastActiveAt),
+			ExpiresAt:    timestamppb.New(session.ExpiresAt),
+			Current:      session.Current,
+		})
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *authpb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	_, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	if err := h.svc.RevokeSession(ctx, userID, req.GetId()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) RevokeAllOtherSessions(ctx context.Context, _ *emptypb.Empty) (*authpb.RevokeSessionsResponse, error) {
+	user, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.SessionID == "" {
+		return nil, status.Error(codes.FailedPrecondition, "current session unknown, log in again")
+	}
+
+	revoked, err := h.svc.RevokeOtherSessions(ctx, userID, user.SessionID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.RevokeSessionsResponse{Revoked: int32(revoked)}, nil
+}
+
+func sessionUser(ctx context.Context) (authctx.User, uuid.UUID, error) {
+	user, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return authctx.User{}, uuid.Nil, status.Error(codes.Unauthenticated, "missing user context")
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return authctx.User{}, uuid.Nil, status.Error(codes.Unauthenticated, "invalid user context")
+	}
+	return user, userID, nil
+}
+
+func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *authpb.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if err := h.svc.RequestPasswordReset(ctx, req.GetEmail()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*emptypb.Empty, error) {
+	if err := h.svc.ResetPassword(ctx, req.GetToken(), req.GetNewPassword()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *authpb.VerifyEmailRequest) (*emptypb.Empty, error) {
+	if err := h.svc.VerifyEmail(ctx, req.GetToken()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) RequestEmailVerification(ctx context.Context, req *authpb.RequestEmailVerificationRequest) (*emptypb.Empty, error) {
+	if req.GetEmail() == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+	if err := h.svc.RequestEmailVerification(ctx, req.GetEmail()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// EnrollTOTP starts enrollment for the caller, or for the owner of
+// mfa_token during a login that requires 2FA.
+func (h *AuthHandler) EnrollTOTP(ctx context.Context, req *authpb.EnrollTOTPRequest) (*authpb.EnrollTOTPResponse, error) {
+	var (
+		enrollment service.TOTPEnrollment
+		err        error
+	)
+	if req.GetMfaToken() != "" {
+		enrollment, err = h.svc.EnrollTOTPForChallenge(ctx, req.GetMfaToken())
+	} else {
+		_, userID, userErr := sessionUser(ctx)
+		if userErr != nil {
+			return nil, userErr
+		}
+		enrollment, err = h.svc.EnrollTOTP(ctx, userID)
+	}
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.EnrollTOTPResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmTOTP(ctx context.Context, req *authpb.ConfirmTOTPRequest) (*authpb.ConfirmTOTPResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if req.GetMfaToken() != "" {
+		recoveryCodes, bundle, err := h.svc.ConfirmTOTPForChallenge(ctx, req.GetMfaToken(), req.GetCode())
+		if err != nil {
+			return nil, mapError(err)
+		}
+		return &authpb.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes, Tokens: toTokenResponse(bundle)}, nil
+	}
+
+	_, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recoveryCodes, err := h.svc.ConfirmTOTP(ctx, userID, req.GetCode())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.ConfirmTOTPResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *AuthHandler) DisableTOTP(ctx context.Context, req *authpb.DisableTOTPRequest) (*emptypb.Empty, error) {
+	_, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	if err := h.svc.DisableTOTP(ctx, userID, req.GetCode()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) RegenerateRecoveryCodes(ctx context.Context, req *authpb.RegenerateRecoveryCodesRequest) (*authpb.RecoveryCodesResponse, error) {
+	_, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	recoveryCodes, err := h.svc.RegenerateRecoveryCodes(ctx, userID, req.GetCode())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (h *AuthHandler) VerifyMFA(ctx context.Context, req *authpb.VerifyMFARequest) (*authpb.TokenResponse, error) {
+	if req.GetMfaToken() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa token and code are required")
+	}
+
+	bundle, err := h.svc.VerifyMFA(ctx, req.GetMfaToken(), req.GetCode())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toTokenResponse(bundle), nil
+}
+
+// UnlockAccount clears a login lockout. Operators only.
+func (h *AuthHandler) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*emptypb.Empty, error) {
+	_, actorID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	if err := h.svc.UnlockAccount(ctx, actorID, userID); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) ListOIDCProviders(ctx context.Context, _ *emptypb.Empty) (*authpb.ListOIDCProvidersResponse, error) {
+	providers := h.svc.OIDCProviders()
+	resp := &authpb.ListOIDCProvidersResponse{Providers: make([]*authpb.OIDCProvider, 0, len(providers))}
+	for _, provider := range providers {
+		resp.Providers = append(resp.Providers, &authpb.OIDCProvider{
+			Name:        provider.Name,
+			DisplayName: provider.DisplayName,
+		})
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) StartOIDCLogin(ctx context.Context, req *authpb.StartOIDCLoginRequest) (*authpb.StartOIDCLoginResponse, error) {
+	authURL, err := h.svc.StartOIDCLogin(ctx, req.GetProvider(), req.GetRedirectTo())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.StartOIDCLoginResponse{AuthorizationUrl: authURL}, nil
+}
+
+func (h *AuthHandler) CompleteOIDCLogin(ctx context.Context, req *authpb.CompleteOIDCLoginRequest) (*authpb.CompleteOIDCLoginResponse, error) {
+	if req.GetState() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "state and code are required")
+	}
+
+	bundle, redirectTo, err := h.svc.CompleteOIDCLogin(ctx, req.GetProvider(), req.GetState(), req.GetCode())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.CompleteOIDCLoginResponse{Tokens: toTokenResponse(bundle), RedirectTo: redirectTo}, nil
+}
+
+func (h *AuthHandler) CreateAPIToken(ctx context.Context, req *authpb.CreateAPITokenRequest) (*authpb.CreateAPITokenResponse, error) {
+	user, _, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := service.CreateAPITokenInput{
+		Kind:           req.GetKind(),
+		Name:           req.GetName(),
+		Scopes:         req.GetScopes(),
+		OrganizationID: req.GetOrganizationId(),
+	}
+	if req.GetExpiresAt() != nil {
+		expiresAt := req.GetExpiresAt().AsTime()
+		input.ExpiresAt = &expiresAt
+	}
+
+	token, secret, err := h.svc.CreateAPIToken(ctx, user, input)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return &authpb.CreateAPITokenResponse{Token: toAPIToken(token), Secret: secret}, nil
+}
+
+func (h *AuthHandler) ListAPITokens(ctx context.Context, req *authpb.ListAPITokensRequest) (*authpb.ListAPITokensResponse, error) {
+	user, _, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := h.svc.ListAPITokens(ctx, user, req.GetOrganizationId())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &authpb.ListAPITokensResponse{Tokens: make([]*authpb.APIToken, 0, len(tokens))}
+	for _, token := range tokens {
+		resp.Tokens = append(resp.Tokens, toAPIToken(token))
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) RevokeAPIToken(ctx context.Context, req *authpb.RevokeAPITokenRequest) (*emptypb.Empty, error) {
+	user, _, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokenID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid token id")
+	}
+
+	if err := h.svc.RevokeAPIToken(ctx, user, tokenID); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *authpb.ChangePasswordRequest) (*emptypb.Empty, error) {
+	user, userID, err := sessionUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCurrentPassword() == "" || req.GetNewPassword() == "" {
+		return nil, status.Error(codes.InvalidArgument, "current and new password are required")
+	}
+
+	if err := h.svc.ChangePassword(ctx, userID, user.SessionID, req.GetCurrentPassword(), req.GetNewPassword()); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toAPIToken(token models.APIToken) *authpb.APIToken {
+	resp := &authpb.APIToken{
+		Id:         token.ID.String(),
+		Kind:       token.Kind,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     strings.Fields(token.Scopes),
+		CreatedBy:  token.UserID.String(),
+		LastUsedIp: token.LastUsedIP,
+		CreatedAt:  timestamppb.New(token.CreatedAt),
+	}
+	if token.OrganizationID != nil {
+		resp.OrganizationId = token.OrganizationID.String()
+	}
+	if token.ExpiresAt != nil {
+		resp.ExpiresAt = timestamppb.New(*token.ExpiresAt)
+	}
+	if token.LastUsedAt != nil {
+		resp.LastUsedAt = timestamppb.New(*token.LastUsedAt)
+	}
+	return resp
+}
@@ -13,9 +13,23 @@ type AuthUser struct {
 	PasswordHash string    `gorm:"not null"`
 	Status       string    `gorm:"not null;default:active"`
 	UserType     string    `gorm:"type:text;not null;default:user"`
-	LastLoginAt  *time.Time
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	// EmailVerifiedAt is set once the user follows a verification or reset link
+	EmailVerifiedAt *time.Time
+	// TOTPSecret is set on enrollment; TOTP is only enforced once
+	// TOTPEnabledAt is set by a confirmed code. TOTPLastCounter is the last
+	// accepted time step, so a code cannot be replayed.
+	TOTPSecret      string
+	TOTPEnabledAt   *time.Time
+	TOTPLastCounter int64
+	// FailedLoginAttempts counts wrong passwords and second factor codes
+	// since the last completed login;
+	// past a threshold logins are refused until LockedUntil.
+	FailedLoginAttempts int `gorm:"not null;default:0"`
+	LastFailedLoginAt   *time.Time
+	LockedUntil         *time.Time
+	LastLoginAt         *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
 func (u *AuthUser) BeforeCreate(tx *gorm.DB) error {
@@ -25,26 +39,234 @@ func (u *AuthUser) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// RefreshToken is single use. Rotating one links it to its replacement and
+// keeps the FamilyID, so every token descending from one login shares it.
 type RefreshToken struct {
 	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
 	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	FamilyID  uuid.UUID `gorm:"type:uuid;index"`
 	TokenHash string    `gorm:"not null;index"`
-	IssuedAt  time.Time `gorm:"not null"`
-	ExpiresAt time.Time `gorm:"not null;index"`
-	RevokedAt *time.Time
-	CreatedAt time.Time
+	// AccessTokenID is the jti of the access token issued alongside, so
+	// logging out can revoke it too.
+	AccessTokenID string
+	IssuedAt      time.Time `gorm:"not null"`
+	ExpiresAt     time.Time `gorm:"not null;index"`
+	RevokedAt     *time.Time
+	ReplacedByID  *uuid.UUID `gorm:"type:uuid"`
+	// Session details of the client that last used the family
+	UserAgent        string
+	IPAddress        string
+	Device           string
+	SessionStartedAt *time.Time
+	CreatedAt        time.Time
 }
 
 func (t *RefreshToken) BeforeCreate(tx *gorm.DB) error {
 	if t.ID == uuid.Nil {
 		t.ID = uuid.New()
 	}
+	if t.FamilyID == uuid.Nil {
+		t.FamilyID = t.ID
+	}
 	if t.IssuedAt.IsZero() {
 		t.IssuedAt = time.Now().UTC()
 	}
 	return nil
 }
 
+// SigningKey is an asymmetric key pair used to sign access tokens. Only the
+// newest key signs; retired keys stay published until ExpiresAt so tokens
+// they signed keep verifying.
+type SigningKey struct {
+	ID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Kid        string    `gorm:"uniqueIndex;not null"`
+	Algorithm  string    `gorm:"not null"`
+	PrivateKey []byte    `gorm:"not null"` // PKCS#8 PEM
+	PublicKey  []byte    `gorm:"not null"` // PKIX PEM
+	RetiredAt  *time.Time
+	ExpiresAt  *time.Time `gorm:"index"`
+	CreatedAt  time.Time
+}
+
+func (k *SigningKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == uuid.Nil {
+		k.ID = uuid.New()
+	}
+	return nil
+}
+
+// TokenRevocation invalidates access tokens before they expire. A row with a
+// TokenID revokes one token; without it every token issued to the user before
+// RevokedBefore is revoked. Rows are dropped once ExpiresAt passes because the
+// tokens they cover have expired by then.
+type TokenRevocation struct {
+	ID            uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;index"`
+	TokenID       string    `gorm:"index"`
+	RevokedBefore *time.Time
+	Reason        string
+	ExpiresAt     time.Time `gorm:"not null;index"`
+	CreatedAt     time.Time
+}
+
+func (r *TokenRevocation) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+const (
+	AccountTokenPasswordReset     = "password_reset"
+	AccountTokenEmailVerification = "email_verification"
+	AccountTokenMFAChallenge      = "mfa_challenge"
+)
+
+// AccountToken is a single-use token sent by email or handed out as a login
+// challenge. Only its hash is stored.
+type AccountToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	Purpose   string    `gorm:"not null"`
+	TokenHash string    `gorm:"not null;uniqueIndex"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	Attempts  int `gorm:"not null;default:0"` // failed codes against a challenge
+	CreatedAt time.Time
+}
+
+func (t *AccountToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// LoginThrottle tracks failed logins from one client address, whatever
+// account they target
+type LoginThrottle struct {
+	IPAddress     string    `gorm:"primaryKey"`
+	Failures      int       `gorm:"not null;default:0"`
+	LastFailureAt time.Time `gorm:"not null;index"`
+	LockedUntil   *time.Time
+}
+
+// RecoveryCode is a single-use fallback for a lost TOTP device
+type RecoveryCode struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	CodeHash  string    `gorm:"not null;index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func (c *RecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// OIDCIdentity links an identity provider account to a user
+type OIDCIdentity struct {
+	ID          uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Provider    string    `gorm:"not null;uniqueIndex:idx_oidc_subject"`
+	Subject     string    `gorm:"not null;uniqueIndex:idx_oidc_subject"`
+	Email       string
+	LastLoginAt *time.Time
+	CreatedAt   time.Time
+}
+
+func (i *OIDCIdentity) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// OIDCLoginState holds what a started SSO login needs on the callback. Only
+// the hash of the state parameter is stored.
+type OIDCLoginState struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Provider     string    `gorm:"not null"`
+	StateHash    string    `gorm:"not null;uniqueIndex"`
+	Nonce        string    `gorm:"not null"`
+	CodeVerifier string    `gorm:"not null"`
+	RedirectTo   string
+	ExpiresAt    time.Time `gorm:"not null;index"`
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
+
+func (s *OIDCLoginState) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// APIToken is a long-lived credential for scripts. Personal tokens act as
+// their owner; service keys belong to an organization and can only reach it,
+// UserID is the admin who created the key. Only the hash is stored.
+type APIToken struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	Kind           string     `gorm:"not null;index"`
+	Name           string     `gorm:"not null"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index"`
+	OrganizationID *uuid.UUID `gorm:"type:uuid;index"`
+	// Prefix is the start of the token, shown so users can tell keys apart
+	Prefix     string `gorm:"not null"`
+	TokenHash  string `gorm:"not null;uniqueIndex"`
+	Scopes     string `gorm:"not null"` // space separated
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (t *APIToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// PasswordHistory keeps previous password hashes so recent passwords
+// cannot be reused
+type PasswordHistory struct {
+	ID           uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	PasswordHash string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"index"`
+}
+
+func (h *PasswordHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+// SecurityEvent is an audit record of suspicious account activity
+type SecurityEvent struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Type      string     `gorm:"not null;index"`
+	FamilyID  *uuid.UUID `gorm:"type:uuid"`
+	Details   string
+	CreatedAt time.Time
+}
+
+func (e *SecurityEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&AuthUser{}, &RefreshToken{})
+	return db.AutoMigrate(&AuthUser{}, &RefreshToken{}, &SigningKey{}, &TokenRevocation{}, &SecurityEvent{}, &AccountToken{}, &RecoveryCode{}, &LoginThrottle{}, &OIDCIdentity{}, &OIDCLoginState{}, &APIToken{}, &PasswordHistory{})
 }
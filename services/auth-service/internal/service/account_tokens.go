@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/mailer"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const minPasswordLength = 8
+
+var (
+	ErrAccountTokenInvalid = errors.New("invalid or expired token")
+	ErrEmailNotVerified    = errors.New("email address not verified")
+	ErrInvalidPassword     = errors.New("password does not meet the password policy")
+)
+
+// RequestPasswordReset emails a reset link. Unknown or disabled accounts are
+// ignored silently so the endpoint cannot be used to probe for emails.
+func (s *AuthService) RequestPasswordReset(ctx context.Context, email string) error {
+	user, ok, err := s.findActiveUserByEmail(ctx, email)
+	if err != nil || !ok {
+		return err
+	}
+
+	token, err := s.issueAccountToken(ctx, user.ID, models.AccountTokenPasswordReset, s.cfg.PasswordResetTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your Task Flow password",
+		Body: fmt.Sprintf("Someone asked to reset the password for this account.\n\n"+
+			"Choose a new password here:\n%s\n\n"+
+			"The link expires in %s. If it wasn't you, ignore this email.\n",
+			s.accountLink("reset-password", token), s.cfg.PasswordResetTTL),
+	})
+}
+
+// ResetPassword sets a new password from a reset token. Every existing
+// session is revoked, and the email counts as verified since the user read
+// the reset mail.
+func (s *AuthService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	// Validate before consuming the token so a rejected password can be
+	// retried with the same link
+	record, err := s.lookupAccountToken(ctx, token, models.AccountTokenPasswordReset)
+	if err != nil {
+		return err
+	}
+	user, err := s.loadAuthUser(ctx, record.UserID)
+	if err != nil {
+		return err
+	}
+	profile, err := s.fetchUserProfile(ctx, user)
+	if err != nil {
+		return err
+	}
+	if err := s.validateNewPassword(ctx, user, newPassword, profile.FirstName, profile.LastName); err != nil {
+		return err
+	}
+
+	if _, err := s.consumeAccountToken(ctx, token, models.AccountTokenPasswordReset); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err := s.storePassword(ctx, user, newPassword, map[string]interface{}{
+		"email_verified_at":     gorm.Expr("COALESCE(email_verified_at, ?)", now),
+		"failed_login_attempts": 0,
+		"locked_until":          nil,
+	}); err != nil {
+		return err
+	}
+
+	return s.RevokeUserTokens(ctx, record.UserID, RevocationReasonPasswordChanged)
+}
+
+// RequestEmailVerification re-sends the verification link. Unknown and
+// already verified accounts are ignored.
+func (s *AuthService) RequestEmailVerification(ctx context.Context, email string) error {
+	user, ok, err := s.findActiveUserByEmail(ctx, email)
+	if err != nil || !ok || user.EmailVerifiedAt != nil {
+		return err
+	}
+	return s.sendEmailVerification(ctx, user)
+}
+
+// VerifyEmail marks the token owner's email as verified and joins the
+// organizations it was invited to
+func (s *AuthService) VerifyEmail(ctx context.Context, token string) error {
+	record, err := s.consumeAccountToken(ctx, token, models.AccountTokenEmailVerification)
+	if err != nil {
+		return err
+	}
+
+	if err := s.db.WithContext(ctx).
+		Model(&models.AuthUser{}).
+		Where("id = ? AND email_verified_at IS NULL", record.UserID).
+		Update("email_verified_at", time.Now().UTC()).Error; err != nil {
+		return err
+	}
+
+	if user, err := s.loadAuthUser(ctx, record.UserID); err == nil {
+		s.claimInvitations(ctx, user)
+	}
+	return nil
+}
+
+func (s *AuthService) sendEmailVerification(ctx context.Context, user models.AuthUser) error {
+	token, err := s.issueAccountToken(ctx, user.ID, models.AccountTokenEmailVerification, s.cfg.EmailVerificationTTL)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your Task Flow email address",
+		Body: fmt.Sprintf("Confirm this is your email address by opening:\n%s\n\n"+
+			"The link expires in %s.\n",
+			s.accountLink("verify-email", token), s.cfg.EmailVerificationTTL),
+	})
+}
+
+// issueAccountToken creates a token for purpose, invalidating any earlier
+// unused one so only the latest emailed link works.
+func (s *AuthService) issueAccountToken(ctx context.Context, userID uuid.UUID, purpose string, ttl time.Duration) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	now := time.Now().UTC()
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.AccountToken{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+			Update("used_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Create(&models.AccountToken{
+			UserID:    userID,
+			Purpose:   purpose,
+			TokenHash: hashToken(token),
+			ExpiresAt: now.Add(ttl),
+		}).Error
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// consumeAccountToken marks the token used, failing if it was already used,
+// has expired or belongs to another purpose.
+func (s *AuthService) consumeAccountToken(ctx context.Context, token, purpose string) (models.AccountToken, error) {
+	record, err := s.lookupAccountToken(ctx, token, purpose)
+	if err != nil {
+		return models.AccountToken{}, err
+	}
+
+	now := time.Now().UTC()
+	result := s.db.WithContext(ctx).
+		Model(&models.AccountToken{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", record.ID, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return models.AccountToken{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.AccountToken{}, ErrAccountTokenInvalid
+	}
+	return record, nil
+}
+
+// lookupAccountToken finds an unused, unexpired token without consuming it
+func (s *AuthService) lookupAccountToken(ctx context.Context, token, purpose string) (models.AccountToken, error) {
+	if token == "" {
+		return models.AccountToken{}, ErrAccountTokenInvalid
+	}
+
+	var record models.AccountToken
+	if err := s.db.WithContext(ctx).
+		Where("token_hash = ? AND purpose = ? AND used_at IS NULL AND expires_at > ?", hashToken(token), purpose, time.Now().UTC()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.AccountToken{}, ErrAccountTokenInvalid
+		}
+		return models.AccountToken{}, err
+	}
+	return record, nil
+}
+
+func (s *AuthService) findActiveUserByEmail(ctx context.Context, email string) (models.AuthUser, bool, error) {
+	var user models.AuthUser
+	if err := s.db.WithContext(ctx).Where("email = ?", strings.TrimSpace(email)).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.AuthUser{}, false, nil
+		}
+		return models.AuthUser{}, false, err
+	}
+	if user.Status != "active" {
+		return models.AuthUser{}, false, nil
+	}
+	return user, true, nil
+}
+
+func (s *AuthService) accountLink(path, token string) string {
+	return fmt.Sprintf("%s/%s?token=%s", strings.TrimRight(s.cfg.PublicURL, "/"), path, url.QueryEscape(token))
+}
+
+// logMailError keeps a failed notification mail from failing the request
+func logMailError(err error, userID uuid.UUID, kind string) {
+	if err != nil {
+		log.S().Errorw("failed to send "+kind+" email", "userId", userID, "error", err)
+	}
+}
@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	APITokenKindPersonal = "personal"
+	APITokenKindService  = "service"
+
+	// APITokenPrefix starts every API token so clients and the gateway can
+	// tell them from JWTs
+	APITokenPrefix = "tf_"
+
+	SecurityEventAPITokenCreated = "api_token_created"
+	SecurityEventAPITokenRevoked = "api_token_revoked"
+
+	apiTokenSecretSize  = 32
+	apiTokenPrefixChars = 12
+	maxAPITokenName     = 100
+	// maxAPITokens caps tokens per user and keys per organization
+	maxAPITokens = 50
+	// apiTokenTouchInterval limits last-used writes to one per interval
+	apiTokenTouchInterval = time.Minute
+)
+
+var (
+	ErrAPITokenNotFound          = errors.New("api token not found")
+	ErrAPITokenInvalidRequest    = errors.New("invalid api token request")
+	ErrAPITokenLimit             = errors.New("too many api tokens, revoke unused ones first")
+	ErrAPITokenNotAllowed        = errors.New("api tokens cannot manage api tokens")
+	ErrOrganizationAdminRequired = errors.New("organization owner or admin access required")
+)
+
+// CreateAPITokenInput describes a new token. OrganizationID is required for
+// service keys and ignored for personal tokens.
+type CreateAPITokenInput struct {
+	Kind           string
+	Name           string
+	Scopes         []string
+	OrganizationID string
+	ExpiresAt      *time.Time
+}
+
+// CreateAPIToken stores a new token and returns it with its secret, which
+// is never available again
+func (s *AuthService) CreateAPIToken(ctx context.Context, actor authctx.User, input CreateAPITokenInput) (models.APIToken, string, error) {
+	actorID, err := apiTokenActor(actor)
+	if err != nil {
+		return models.APIToken{}, "", err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" || len(name) > maxAPITokenName {
+		return models.APIToken{}, "", fmt.Errorf("%w: name must be 1-%d characters", ErrAPITokenInvalidRequest, maxAPITokenName)
+	}
+	scopes, err := normalizeScopes(input.Kind, input.Scopes)
+	if err != nil {
+		return models.APIToken{}, "", err
+	}
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(time.Now()) {
+		return models.APIToken{}, "", fmt.Errorf("%w: expiry must be in the future", ErrAPITokenInvalidRequest)
+	}
+
+	token := models.APIToken{
+		Kind:   input.Kind,
+		Name:   name,
+		UserID: actorID,
+		Scopes: strings.Join(scopes, " "),
+	}
+	if input.ExpiresAt != nil {
+		expiresAt := input.ExpiresAt.UTC()
+		token.ExpiresAt = &expiresAt
+	}
+
+	scope := s.db.WithContext(ctx).Model(&models.APIToken{}).Where("revoked_at IS NULL")
+	switch input.Kind {
+	case APITokenKindPersonal:
+		scope = scope.Where("kind = ? AND user_id = ?", APITokenKindPersonal, actorID)
+	case APITokenKindService:
+		orgID, err := uuid.Parse(input.OrganizationID)
+		if err != nil {
+			return models.APIToken{}, "", fmt.Errorf("%w: service keys need an organization", ErrAPITokenInvalidRequest)
+		}
+		if err := s.requireOrganizationAdmin(ctx, actorID, orgID); err != nil {
+			return models.APIToken{}, "", err
+		}
+		token.OrganizationID = &orgID
+		scope = scope.Where("kind = ? AND organization_id = ?", APITokenKindService, orgID)
+	default:
+		return models.APIToken{}, "", fmt.Errorf("%w: kind must be %q or %q", ErrAPITokenInvalidRequest, APITokenKindPersonal, APITokenKindService)
+	}
+
+	var count int64
+	if err := scope.Count(&count).Error; err != nil {
+		return models.APIToken{}, "", err
+	}
+	if count >= maxAPITokens {
+		return models.APIToken{}, "", ErrAPITokenLimit
+	}
+
+	secret, err := generateAPIToken(input.Kind)
+	if err != nil {
+		return models.APIToken{}, "", err
+	}
+	token.Prefix = secret[:apiTokenPrefixChars]
+	token.TokenHash = hashToken(secret)
+
+	if err := s.db.WithContext(ctx).Create(&token).Error; err != nil {
+		return models.APIToken{}, "", err
+	}
+	if err := s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID:  actorID,
+		Type:    SecurityEventAPITokenCreated,
+		Details: fmt.Sprintf("%s token %s (%s) scopes %s", token.Kind, token.ID, token.Name, token.Scopes),
+	}); err != nil {
+		return models.APIToken{}, "", err
+	}
+	return token, secret, nil
+}
+
+// ListAPITokens returns the actor's personal tokens, or the service keys of
+// organizationID when it is set. Revoked and expired tokens are left out.
+func (s *AuthService) ListAPITokens(ctx context.Context, actor authctx.User, organizationID string) ([]models.APIToken, error) {
+	actorID, err := apiTokenActor(actor)
+	if err != nil {
+		return nil, err
+	}
+
+	query := s.db.WithContext(ctx).
+		Where("revoked_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", time.Now().UTC()).
+		Order("created_at DESC")
+	if organizationID == "" {
+		query = query.Where("kind = ? AND user_id = ?", APITokenKindPersonal, actorID)
+	} else {
+		orgID, err := uuid.Parse(organizationID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid organization id", ErrAPITokenInvalidRequest)
+		}
+		if err := s.requireOrganizationAdmin(ctx, actorID, orgID); err != nil {
+			return nil, err
+		}
+		query = query.Where("kind = ? AND organization_id = ?", APITokenKindService, orgID)
+	}
+
+	var tokens []models.APIToken
+	if err := query.Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken revokes a personal token of the actor, or a service key of
+// an organization the actor administers
+func (s *AuthService) RevokeAPIToken(ctx context.Context, actor authctx.User, tokenID uuid.UUID) error {
+	actorID, err := apiTokenActor(actor)
+	if err != nil {
+		return err
+	}
+
+	var token models.APIToken
+	if err := s.db.WithContext(ctx).
+		Where("id = ? AND revoked_at IS NULL", tokenID).
+		First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAPITokenNotFound
+		}
+		return err
+	}
+
+	if token.Kind == APITokenKindService && token.OrganizationID != nil {
+		if err := s.requireOrganizationAdmin(ctx, actorID, *token.OrganizationID); err != nil {
+			return err
+		}
+	} else if token.UserID != actorID {
+		// Someone else's token is reported as missing, not forbidden
+		return ErrAPITokenNotFound
+	}
+
+	now := time.Now().UTC()
+	if err := s.db.WithContext(ctx).
+		Model(&models.APIToken{}).
+		Where("id = ? AND revoked_at IS NULL", token.ID).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+	return s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID:  actorID,
+		Type:    SecurityEventAPITokenRevoked,
+		Details: fmt.Sprintf("%s token %s (%s)", token.Kind, token.ID, token.Name),
+	})
+}
+
+// validateAPIToken resolves an API token to the identity it acts as
+func (s *AuthService) validateAPIToken(ctx context.Context, secret string) (AccessTokenInfo, error) {
+	var token models.APIToken
+	if err := s.db.WithContext(ctx).
+		Where("token_hash = ?", hashToken(secret)).
+		First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return AccessTokenInfo{}, ErrTokenInvalid
+		}
+		return AccessTokenInfo{}, err
+	}
+
+	now := time.Now().UTC()
+	if token.RevokedAt != nil {
+		return AccessTokenInfo{}, ErrTokenRevoked
+	}
+	if token.ExpiresAt != nil && now.After(*token.ExpiresAt) {
+		return AccessTokenInfo{}, ErrTokenExpired
+	}
+
+	user, err := s.loadAuthUser(ctx, token.UserID)
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return AccessTokenInfo{}, ErrTokenInvalid
+		}
+		return AccessTokenInfo{}, err
+	}
+	if user.Status != "active" {
+		return AccessTokenInfo{}, ErrAccountDisabled
+	}
+
+	profile, err := s.fetchUserProfile(ctx, user)
+	if err != nil {
+		return AccessTokenInfo{}, err
+	}
+
+	s.touchAPIToken(ctx, token, now)
+
+	info := AccessTokenInfo{
+		Profile:    profile,
+		APITokenID: token.ID.String(),
+		Scopes:     strings.Fields(token.Scopes),
+	}
+	if token.ExpiresAt != nil {
+		info.ExpiresAt = *token.ExpiresAt
+	}
+	if token.OrganizationID != nil {
+		info.OrganizationID = token.OrganizationID.String()
+	}
+	return info, nil
+}
+
+// touchAPIToken records when and where a token was last used. Failures are
+// logged, they must not fail the request.
+func (s *AuthService) touchAPIToken(ctx context.Context, token models.APIToken, now time.Time) {
+	ip := authctx.IncomingClient(ctx).IP
+	if token.LastUsedAt != nil && now.Sub(*token.LastUsedAt) < apiTokenTouchInterval && token.LastUsedIP == ip {
+		return
+	}
+	if err := s.db.WithContext(ctx).
+		Model(&models.APIToken{}).
+		Where("id = ?", token.ID).
+		Updates(map[string]interface{}{
+			"last_used_at": now,
+			"last_used_ip": ip,
+		}).Error; err != nil {
+		log.S().Warnw("failed to record api token use", "tokenId", token.ID, "error", err)
+	}
+}
+
+// requireOrganizationAdmin checks userID's role in organizationID allows
+// org.update, which owners and admins have
+func (s *AuthService) requireOrganizationAdmin(ctx context.Context, userID, organizationID uuid.UUID) error {
+	if s.orgClient == nil {
+		return errors.New("organization service not configured")
+	}
+	resp, err := s.orgClient.CheckPermission(ctx, &organizationpb.CheckPermissionRequest{
+		OrganizationId: organizationID.String(),
+		UserId:         userID.String(),
+		Permission:     orgdomain.PermissionOrgUpdate,
+	})
+	if err != nil {
+		return fmt.Errorf("check organization permission: %w", err)
+	}
+	if !resp.GetAllowed() {
+		return ErrOrganizationAdminRequired
+	}
+	return nil
+}
+
+// apiTokenActor returns the acting user, refusing API tokens so a leaked
+// token cannot mint more
+func apiTokenActor(actor authctx.User) (uuid.UUID, error) {
+	if actor.IsAPIToken() {
+		return uuid.Nil, ErrAPITokenNotAllowed
+	}
+	return uuid.Parse(actor.ID)
+}
+
+// normalizeScopes validates and dedupes scopes. Service keys act for an
+// organization rather than a person, so user and notification scopes are
+// not available to them.
+func normalizeScopes(kind string, scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !authctx.ValidScope(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", ErrAPITokenInvalidRequest, scope)
+		}
+		if kind == APITokenKindService && !serviceKeyScope(scope) {
+			return nil, fmt.Errorf("%w: scope %q is not available to service keys", ErrAPITokenInvalidRequest, scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", ErrAPITokenInvalidRequest)
+	}
+	return normalized, nil
+}
+
+func serviceKeyScope(scope string) bool {
+	return strings.HasPrefix(scope, "tasks:") || strings.HasPrefix(scope, "organizations:")
+}
+
+// generateAPIToken returns tf_pat_… for personal tokens and tf_svc_… for
+// service keys
+func generateAPIToken(kind string) (string, error) {
+	buf := make([]byte, apiTokenSecretSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	tag := "pat_"
+	if kind == APITokenKindService {
+		tag = "svc_"
+	}
+	return APITokenPrefix + tag + base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -6,9 +6,18 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aliirah/task-flow/services/auth-service/internal/breach"
+	"github.com/aliirah/task-flow/services/auth-service/internal/event"
 	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/services/auth-service/internal/oidc"
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/jwks"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/mailer"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
 	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -19,9 +28,32 @@ import (
 )
 
 type Config struct {
-	JWTSecret       []byte
-	AccessTokenTTL  time.Duration
-	RefreshTokenTTL time.Duration
+	// LegacyJWTSecret only verifies HS256 tokens issued before asymmetric
+	// signing, new tokens are never signed with it.
+	LegacyJWTSecret  []byte
+	SigningAlgorithm string // RS256 or EdDSA
+	KeyRotation      time.Duration
+	KeyOverlap       time.Duration
+	Issuer           string
+	AccessTokenTTL   time.Duration
+	RefreshTokenTTL  time.Duration
+	// RefreshReuseGrace accepts a rotated refresh token again for this long,
+	// so clients refreshing from two tabs at once don't look like theft
+	RefreshReuseGrace time.Duration
+	// PublicURL is the web client base used in emailed links
+	PublicURL            string
+	PasswordResetTTL     time.Duration
+	EmailVerificationTTL time.Duration
+	// TOTPIssuer labels the account in authenticator apps
+	TOTPIssuer      string
+	MFAChallengeTTL time.Duration
+	Lockout         LockoutConfig
+	OIDCProviders   []oidc.ProviderConfig
+	PasswordPolicy  PasswordPolicy
+	// BcryptCost is applied to new hashes; logins rehash older ones
+	BcryptCost int
+	// BreachedPasswords rejects known breached passwords when set
+	BreachedPasswords breach.Checker
 }
 
 var (
@@ -30,40 +62,145 @@ var (
 	ErrAccountDisabled     = errors.New("account disabled")
 	ErrRefreshTokenExpired = errors.New("refresh token expired")
 	ErrRefreshTokenInvalid = errors.New("invalid refresh token")
+	ErrRefreshTokenReused  = errors.New("refresh token reuse detected")
 	ErrTokenInvalid        = errors.New("invalid token")
 	ErrTokenExpired        = errors.New("token expired")
+	ErrTokenRevoked        = errors.New("token revoked")
 	ErrUserNotFound        = errors.New("user not found")
 )
 
 type AuthService struct {
-	db         *gorm.DB
-	cfg        Config
-	userClient userpb.UserServiceClient
+	db          *gorm.DB
+	cfg         Config
+	userClient  userpb.UserServiceClient
+	orgClient   organizationpb.OrganizationServiceClient
+	keys        *keyManager
+	revocations event.RevocationPublisher
+	breached    breach.Checker
+	mailer      mailer.Mailer
+
+	oidcProviders map[string]*oidc.Provider
 }
 
-func NewAuthService(db *gorm.DB, cfg Config, userClient userpb.UserServiceClient) *AuthService {
-	if len(cfg.JWTSecret) == 0 {
-		cfg.JWTSecret = []byte("development-secret")
-	}
+func NewAuthService(db *gorm.DB, cfg Config, userClient userpb.UserServiceClient, orgClient organizationpb.OrganizationServiceClient, revocations event.RevocationPublisher, mail mailer.Mailer) *AuthService {
 	if cfg.AccessTokenTTL <= 0 {
 		cfg.AccessTokenTTL = 15 * time.Minute
 	}
 	if cfg.RefreshTokenTTL <= 0 {
 		cfg.RefreshTokenTTL = 24 * time.Hour
 	}
+	if cfg.RefreshReuseGrace <= 0 {
+		cfg.RefreshReuseGrace = 10 * time.Second
+	}
+	if cfg.SigningAlgorithm == "" {
+		cfg.SigningAlgorithm = jwks.AlgRS256
+	}
+	if cfg.KeyRotation <= 0 {
+		cfg.KeyRotation = 30 * 24 * time.Hour
+	}
+	// Retired keys must outlive every token they signed
+	if cfg.KeyOverlap < cfg.AccessTokenTTL {
+		cfg.KeyOverlap = cfg.AccessTokenTTL
+	}
+	if cfg.Issuer == "" {
+		cfg.Issuer = "task-flow"
+	}
+	if cfg.PasswordResetTTL <= 0 {
+		cfg.PasswordResetTTL = time.Hour
+	}
+	if cfg.EmailVerificationTTL <= 0 {
+		cfg.EmailVerificationTTL = 48 * time.Hour
+	}
+	if cfg.TOTPIssuer == "" {
+		cfg.TOTPIssuer = "Task Flow"
+	}
+	if cfg.MFAChallengeTTL <= 0 {
+		cfg.MFAChallengeTTL = 5 * time.Minute
+	}
+	cfg.Lockout = cfg.Lockout.withDefaults()
+	cfg.PasswordPolicy = cfg.PasswordPolicy.withDefaults()
+	if cfg.BcryptCost < bcrypt.MinCost || cfg.BcryptCost > bcrypt.MaxCost {
+		cfg.BcryptCost = bcrypt.DefaultCost
+	}
+	if mail == nil {
+		mail = mailer.NewLogMailer()
+	}
+	if revocations == nil {
+		revocations = event.NewRevocationPublisher(nil)
+	}
+	providers := make(map[string]*oidc.Provider, len(cfg.OIDCProviders))
+	for _, providerCfg := range cfg.OIDCProviders {
+		providers[providerCfg.Name] = oidc.NewProvider(providerCfg, nil)
+	}
 	return &AuthService{
-		db:         db,
-		cfg:        cfg,
-		userClient: userClient,
+		db:          db,
+		cfg:         cfg,
+		userClient:  userClient,
+		orgClient:   orgClient,
+		keys:        newKeyManager(db, cfg.SigningAlgorithm, cfg.KeyRotation, cfg.KeyOverlap),
+		revocations: revocations,
+		mailer:      mail,
+		breached:    cfg.BreachedPasswords,
+
+		oidcProviders: providers,
 	}
 }
 
+// InitSigningKeys loads the key set and creates the first key if needed
+func (s *AuthService) InitSigningKeys(ctx context.Context) error {
+	switch s.cfg.SigningAlgorithm {
+	case jwks.AlgRS256, jwks.AlgEdDSA:
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", s.cfg.SigningAlgorithm)
+	}
+	return s.keys.rotateIfDue(ctx)
+}
+
+// RunKeyRotation rotates the signing key when due and drops expired keys and
+// revocations every interval until ctx is done.
+func (s *AuthService) RunKeyRotation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.keys.purgeExpired(ctx); err != nil {
+			log.S().Errorw("failed to purge expired signing keys", "error", err)
+		}
+		if err := s.keys.rotateIfDue(ctx); err != nil {
+			log.S().Errorw("failed to rotate signing key", "error", err)
+		}
+		if err := s.purgeExpiredRevocations(ctx); err != nil {
+			log.S().Errorw("failed to purge expired token revocations", "error", err)
+		}
+		if err := s.purgeLoginThrottles(ctx); err != nil {
+			log.S().Errorw("failed to purge login throttles", "error", err)
+		}
+		if err := s.purgeOIDCStates(ctx); err != nil {
+			log.S().Errorw("failed to purge sso login states", "error", err)
+		}
+	}
+}
+
+// JWKS returns the public keys that verify access tokens
+func (s *AuthService) JWKS() (jwks.Set, error) {
+	return s.keys.jwks()
+}
+
 type SignUpInput struct {
 	Email     string
 	Password  string
 	FirstName string
 	LastName  string
 	UserType  string
+	// InvitationToken, from an organization invitation email, joins the
+	// organization on sign up
+	InvitationToken string
 }
 
 type LoginInput struct {
@@ -75,6 +212,7 @@ type TokenPair struct {
 	AccessToken  string
 	RefreshToken string
 	ExpiresAt    time.Time
+	SessionID    string // refresh token family
 }
 
 type UserProfile struct {
@@ -85,17 +223,41 @@ type UserProfile struct {
 	Roles     []string
 	Status    string
 	UserType  string
+
+	EmailVerified    bool
+	TwoFactorEnabled bool
 }
 
 type TokenBundle struct {
 	TokenPair
 	Profile UserProfile
+	// MFAChallenge replaces the tokens when the login needs a second factor
+	MFAChallenge *MFAChallenge
+}
+
+// AccessTokenInfo is what a valid access token resolves to
+type AccessTokenInfo struct {
+	Profile   UserProfile
+	ExpiresAt time.Time
+	SessionID string
+	// Set for API tokens
+	APITokenID     string
+	Scopes         []string
+	OrganizationID string
 }
 
+// jwtClaims carries enough of the profile for the gateway to build the
+// request identity without calling back into auth-service.
 type jwtClaims struct {
-	Email    string   `json:"email"`
-	Roles    []string `json:"roles"`
-	UserType string   `json:"user_type"`
+	Email     string   `json:"email"`
+	FirstName string   `json:"given_name,omitempty"`
+	LastName  string   `json:"family_name,omitempty"`
+	Roles     []string `json:"roles"`
+	Status    string   `json:"status,omitempty"`
+	UserType  string   `json:"user_type"`
+	SessionID string   `json:"sid,omitempty"`
+	// EmailVerified is as of issuing; it turns true on the next refresh
+	EmailVerified bool `json:"email_verified"`
 	jwt.RegisteredClaims
 }
 
@@ -111,14 +273,17 @@ func (s *AuthService) SignUp(ctx context.Context, input SignUpInput) (TokenBundl
 		input.UserType = "user"
 	}
 
-	hash, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err := s.validateNewPassword(ctx, models.AuthUser{}, input.Password, input.Email, input.FirstName, input.LastName); err != nil {
+		return TokenBundle{}, err
+	}
+	hash, err := s.hashPassword(input.Password)
 	if err != nil {
 		return TokenBundle{}, err
 	}
 
 	user := models.AuthUser{
 		Email:        input.Email,
-		PasswordHash: string(hash),
+		PasswordHash: hash,
 		Status:       "active",
 		UserType:     input.UserType,
 	}
@@ -133,19 +298,35 @@ func (s *AuthService) SignUp(ctx context.Context, input SignUpInput) (TokenBundl
 		return TokenBundle{}, err
 	}
 
-	tokenPair, err := s.issueTokens(ctx, user.ID, profile)
+	tokenPair, err := s.issueTokens(ctx, user.ID, profile, models.RefreshToken{})
 	if err != nil {
 		s.db.WithContext(ctx).Delete(&user)
 		return TokenBundle{}, err
 	}
 
+	if input.InvitationToken != "" && s.acceptSignUpInvitation(ctx, &user, input.InvitationToken) {
+		profile.EmailVerified = true
+	} else {
+		logMailError(s.sendEmailVerification(ctx, user), user.ID, "verification")
+	}
+
 	return TokenBundle{TokenPair: tokenPair, Profile: profile}, nil
 }
 
 func (s *AuthService) Login(ctx context.Context, input LoginInput) (TokenBundle, error) {
+	ip := authctx.IncomingClient(ctx).IP
+	if err := s.checkIPThrottle(ctx, ip); err != nil {
+		if errors.Is(err, ErrTooManyLoginAttempts) {
+			countLogin("throttled")
+		}
+		return TokenBundle{}, err
+	}
+
 	var user models.AuthUser
 	if err := s.db.WithContext(ctx).Where("email = ?", input.Email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
+			s.recordLoginFailure(ctx, nil, ip)
+			countLogin("invalid")
 			return TokenBundle{}, ErrInvalidCredentials
 		}
 		return TokenBundle{}, err
@@ -155,26 +336,114 @@ func (s *AuthService) Login(ctx context.Context, input LoginInput) (TokenBundle,
 		return TokenBundle{}, ErrAccountDisabled
 	}
 
+	// A locked account is refused before the password is checked, so
+	// guesses made during the lock tell the caller nothing
+	if accountLocked(user) {
+		s.recordLoginFailure(ctx, nil, ip)
+		countLogin("locked")
+		return TokenBundle{}, ErrAccountLocked
+	}
+
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(input.Password)); err != nil {
+		s.recordLoginFailure(ctx, &user, ip)
+		countLogin("invalid")
 		return TokenBundle{}, ErrInvalidCredentials
 	}
+	countLogin("success")
+	s.rehashIfNeeded(ctx, user, input.Password)
+
+	return s.afterPrimaryFactor(ctx, user)
+}
 
+// afterPrimaryFactor applies organization policy once the user proved who
+// they are with a password or SSO, asking for a second factor when needed.
+func (s *AuthService) afterPrimaryFactor(ctx context.Context, user models.AuthUser) (TokenBundle, error) {
+	// Skip the organization lookup when nothing it could require is missing
+	var policy loginPolicy
+	if user.EmailVerifiedAt == nil || user.TOTPEnabledAt == nil {
+		var err error
+		if policy, err = s.loginPolicy(ctx, user.ID); err != nil {
+			return TokenBundle{}, err
+		}
+	}
+	if policy.RequireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return TokenBundle{}, ErrEmailNotVerified
+	}
+	if user.TOTPEnabledAt != nil || policy.RequireTwoFactor {
+		return s.startMFAChallenge(ctx, user)
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+// completeLogin issues tokens once every login factor has been checked
+func (s *AuthService) completeLogin(ctx context.Context, user models.AuthUser) (TokenBundle, error) {
 	profile, err := s.fetchUserProfile(ctx, user)
 	if err != nil {
 		return TokenBundle{}, err
 	}
 
-	tokenPair, err := s.issueTokens(ctx, user.ID, profile)
+	tokenPair, err := s.issueTokens(ctx, user.ID, profile, models.RefreshToken{})
 	if err != nil {
 		return TokenBundle{}, err
 	}
 
+	// Failures are only forgotten once every factor passed, a correct
+	// password alone must not reset the count of wrong codes
+	s.resetLoginFailures(ctx, user)
 	now := time.Now().UTC()
 	_ = s.db.WithContext(ctx).Model(&user).Update("last_login_at", &now)
+	// Domains verified since the last login apply now
+	s.claimDomainMemberships(ctx, user)
 
 	return TokenBundle{TokenPair: tokenPair, Profile: profile}, nil
 }
 
+// loginPolicy is the strictest login policy across the user's organizations
+type loginPolicy struct {
+	RequireVerifiedEmail bool
+	RequireTwoFactor     bool
+}
+
+func (s *AuthService) loginPolicy(ctx context.Context, userID uuid.UUID) (loginPolicy, error) {
+	var policy loginPolicy
+	orgs, err := s.userOrganizations(ctx, userID)
+	if err != nil {
+		return policy, err
+	}
+	for _, org := range orgs {
+		policy.RequireVerifiedEmail = policy.RequireVerifiedEmail || org.GetRequireVerifiedEmail()
+		policy.RequireTwoFactor = policy.RequireTwoFactor || org.GetRequireTwoFactor()
+	}
+	return policy, nil
+}
+
+// userOrganizations loads the organizations the user is a member of, none
+// when the organization service is not configured
+func (s *AuthService) userOrganizations(ctx context.Context, userID uuid.UUID) ([]*organizationpb.Organization, error) {
+	if s.orgClient == nil {
+		return nil, nil
+	}
+
+	memberships, err := s.orgClient.ListUserMemberships(ctx, &organizationpb.ListUserMembershipsRequest{UserId: userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("list memberships: %w", err)
+	}
+	if len(memberships.GetMemberships()) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(memberships.GetMemberships()))
+	for _, membership := range memberships.GetMemberships() {
+		ids = append(ids, membership.GetOrganizationId())
+	}
+	orgs, err := s.orgClient.ListOrganizationsByIDs(ctx, &organizationpb.ListOrganizationsByIDsRequest{Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("list organizations: %w", err)
+	}
+	return orgs.GetItems(), nil
+}
+
 func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenBundle, error) {
 	if refreshToken == "" {
 		return TokenBundle{}, errors.New("missing refresh token")
@@ -183,7 +452,7 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenBu
 	hash := hashToken(refreshToken)
 	var stored models.RefreshToken
 	if err := s.db.WithContext(ctx).
-		Where("token_hash = ? AND revoked_at IS NULL", hash).
+		Where("token_hash = ?", hash).
 		First(&stored).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return TokenBundle{}, ErrRefreshTokenInvalid
@@ -191,6 +460,21 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenBu
 		return TokenBundle{}, err
 	}
 
+	// A token rotated moments ago gets another replacement in its family
+	graced := false
+	if stored.RevokedAt != nil {
+		if stored.ReplacedByID == nil {
+			return TokenBundle{}, ErrRefreshTokenInvalid
+		}
+		var err error
+		if graced, err = s.inRefreshGrace(ctx, stored); err != nil {
+			return TokenBundle{}, err
+		}
+		if !graced {
+			return TokenBundle{}, s.handleRefreshReuse(ctx, stored)
+		}
+	}
+
 	if time.Now().UTC().After(stored.ExpiresAt) {
 		s.revokeRefreshToken(ctx, stored.ID)
 		return TokenBundle{}, ErrRefreshTokenExpired
@@ -206,11 +490,26 @@ func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenBu
 		return TokenBundle{}, err
 	}
 
-	if err := s.revokeRefreshToken(ctx, stored.ID); err != nil {
-		return TokenBundle{}, err
+	// Claim the token before issuing its replacement; losing the race means
+	// another request already rotated it, which only counts as reuse once the
+	// grace window has passed.
+	next := models.RefreshToken{ID: uuid.New(), FamilyID: familyOf(stored), SessionStartedAt: stored.SessionStartedAt}
+	if next.SessionStartedAt == nil {
+		next.SessionStartedAt = &stored.IssuedAt
+	}
+	if !graced {
+		rotated, err := s.rotateRefreshToken(ctx, stored.ID, next.ID)
+		if err != nil {
+			return TokenBundle{}, err
+		}
+		if !rotated {
+			if err := s.lostRefreshRace(ctx, &stored); err != nil {
+				return TokenBundle{}, err
+			}
+		}
 	}
 
-	tokenPair, err := s.issueTokens(ctx, user.ID, profile)
+	tokenPair, err := s.issueTokens(ctx, user.ID, profile, next)
 	if err != nil {
 		return TokenBundle{}, err
 	}
@@ -223,58 +522,115 @@ func (s *AuthService) Logout(ctx context.Context, refreshToken string) error {
 		return errors.New("missing refresh token")
 	}
 	hash := hashToken(refreshToken)
-	return s.db.WithContext(ctx).
-		Model(&models.RefreshToken{}).
-		Where("token_hash = ?", hash).
-		Update("revoked_at", time.Now().UTC()).Error
+	var stored models.RefreshToken
+	if err := s.db.WithContext(ctx).
+		Where("token_hash = ? AND revoked_at IS NULL", hash).
+		First(&stored).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.revokeRefreshToken(ctx, stored.ID); err != nil {
+		return err
+	}
+	return s.revokeAccessToken(ctx, stored.UserID, stored.AccessTokenID, stored.IssuedAt, RevocationReasonLogout)
 }
 
-func (s *AuthService) Validate(ctx context.Context, accessToken string) (UserProfile, time.Time, error) {
+func (s *AuthService) Validate(ctx context.Context, accessToken string) (AccessTokenInfo, error) {
 	if accessToken == "" {
-		return UserProfile{}, time.Time{}, ErrTokenInvalid
+		return AccessTokenInfo{}, ErrTokenInvalid
+	}
+	if strings.HasPrefix(accessToken, APITokenPrefix) {
+		return s.validateAPIToken(ctx, accessToken)
 	}
 
 	claims := &jwtClaims{}
 	parsed, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			if len(s.cfg.LegacyJWTSecret) == 0 {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return s.cfg.LegacyJWTSecret, nil
+		}
+
+		kid, _ := t.Header["kid"].(string)
+		key, err := s.keys.verificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if t.Method.Alg() != key.alg {
 			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
 		}
-		return s.cfg.JWTSecret, nil
-	})
+		return key.public, nil
+	}, jwt.WithValidMethods([]string{jwks.AlgRS256, jwks.AlgEdDSA, jwt.SigningMethodHS256.Alg()}))
 	if err != nil || !parsed.Valid {
-		return UserProfile{}, time.Time{}, ErrTokenInvalid
+		return AccessTokenInfo{}, ErrTokenInvalid
+	}
+	if _, legacy := parsed.Method.(*jwt.SigningMethodHMAC); !legacy && claims.Issuer != s.cfg.Issuer {
+		return AccessTokenInfo{}, ErrTokenInvalid
 	}
 
 	userID, err := uuid.Parse(claims.Subject)
 	if err != nil {
-		return UserProfile{}, time.Time{}, ErrTokenInvalid
+		return AccessTokenInfo{}, ErrTokenInvalid
+	}
+
+	var issuedAt time.Time
+	if claims.IssuedAt != nil {
+		issuedAt = claims.IssuedAt.Time
+	}
+	revoked, err := s.isRevoked(ctx, userID, claims.ID, issuedAt)
+	if err != nil {
+		return AccessTokenInfo{}, err
+	}
+	if revoked {
+		return AccessTokenInfo{}, ErrTokenRevoked
 	}
 
 	user, err := s.loadAuthUser(ctx, userID)
 	if err != nil {
-		return UserProfile{}, time.Time{}, err
+		return AccessTokenInfo{}, err
 	}
 
 	profile, err := s.fetchUserProfile(ctx, user)
 	if err != nil {
-		return UserProfile{}, time.Time{}, err
+		return AccessTokenInfo{}, err
 	}
 
 	expiry := claims.ExpiresAt.Time
 	if time.Now().After(expiry) {
-		return UserProfile{}, time.Time{}, ErrTokenExpired
+		return AccessTokenInfo{}, ErrTokenExpired
 	}
 
-	return profile, expiry, nil
+	return AccessTokenInfo{Profile: profile, ExpiresAt: expiry, SessionID: claims.SessionID}, nil
 }
 
-func (s *AuthService) issueTokens(ctx context.Context, userID uuid.UUID, profile UserProfile) (TokenPair, error) {
+// issueTokens signs an access token and stores refresh as its refresh token.
+// A zero refresh starts a new token family.
+func (s *AuthService) issueTokens(ctx context.Context, userID uuid.UUID, profile UserProfile, refresh models.RefreshToken) (TokenPair, error) {
 	now := time.Now().UTC()
+	if refresh.ID == uuid.Nil {
+		refresh.ID = uuid.New()
+	}
+	if refresh.FamilyID == uuid.Nil {
+		refresh.FamilyID = refresh.ID
+	}
 	claims := &jwtClaims{
-		Email:    profile.Email,
-		Roles:    profile.Roles,
-		UserType: profile.UserType,
+		Email:     profile.Email,
+		FirstName: profile.FirstName,
+		LastName:  profile.LastName,
+		Roles:     profile.Roles,
+		Status:    profile.Status,
+		UserType:  profile.UserType,
+		SessionID: refresh.FamilyID.String(),
+
+		EmailVerified: profile.EmailVerified,
+
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    s.cfg.Issuer,
 			Subject:   userID.String(),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -282,19 +638,31 @@ func (s *AuthService) issueTokens(ctx context.Context, userID uuid.UUID, profile
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	accessToken, err := token.SignedString(s.cfg.JWTSecret)
+	key, err := s.keys.signingKey(ctx)
+	if err != nil {
+		return TokenPair{}, err
+	}
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.kid
+	accessToken, err := token.SignedString(key.private)
 	if err != nil {
 		return TokenPair{}, err
 	}
 
 	refreshToken := uuid.NewString()
-	refreshRecord := models.RefreshToken{
-		UserID:    userID,
-		TokenHash: hashToken(refreshToken),
-		ExpiresAt: now.Add(s.cfg.RefreshTokenTTL),
-	}
-	if err := s.db.WithContext(ctx).Create(&refreshRecord).Error; err != nil {
+	refresh.UserID = userID
+	refresh.TokenHash = hashToken(refreshToken)
+	refresh.AccessTokenID = claims.ID
+	refresh.IssuedAt = now
+	refresh.ExpiresAt = now.Add(s.cfg.RefreshTokenTTL)
+	if refresh.SessionStartedAt == nil {
+		refresh.SessionStartedAt = &now
+	}
+	client := authctx.IncomingClient(ctx)
+	refresh.UserAgent = client.UserAgent
+	refresh.IPAddress = client.IP
+	refresh.Device = deviceFromUserAgent(client.UserAgent)
+	if err := s.db.WithContext(ctx).Create(&refresh).Error; err != nil {
 		return TokenPair{}, err
 	}
 
@@ -302,6 +670,7 @@ func (s *AuthService) issueTokens(ctx context.Context, userID uuid.UUID, profile
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		ExpiresAt:    claims.ExpiresAt.Time,
+		SessionID:    refresh.FamilyID.String(),
 	}, nil
 }
 
@@ -341,42 +710,45 @@ func (s *AuthService) ensureUserProfile(ctx context.Context, user models.AuthUse
 }
 
 func (s *AuthService) fetchUserProfile(ctx context.Context, user models.AuthUser) (UserProfile, error) {
+	fallback := UserProfile{
+		ID:       user.ID.String(),
+		Email:    user.Email,
+		Status:   user.Status,
+		UserType: user.UserType,
+	}
 	if s.userClient == nil {
-		return UserProfile{
-			ID:       user.ID.String(),
-			Email:    user.Email,
-			Status:   user.Status,
-			UserType: user.UserType,
-		}, nil
+		return withAccountState(fallback, user), nil
 	}
 
 	profile, err := s.userClient.GetUser(ctx, &userpb.GetUserRequest{Id: user.ID.String()})
 	if err != nil {
 		// If profile missing, fall back to basic details.
 		if status.Code(err) == codes.NotFound {
-			return UserProfile{
-				ID:       user.ID.String(),
-				Email:    user.Email,
-				Status:   user.Status,
-				UserType: user.UserType,
-			}, nil
+			return withAccountState(fallback, user), nil
 		}
 		return UserProfile{}, mapUserServiceError(err)
 	}
 
-	return mapUserProfile(profile), nil
+	return withAccountState(mapUserProfile(profile), user), nil
+}
+
+// withAccountState copies the flags only auth-service knows about
+func withAccountState(profile UserProfile, user models.AuthUser) UserProfile {
+	profile.EmailVerified = user.EmailVerifiedAt != nil
+	profile.TwoFactorEnabled = user.TOTPEnabledAt != nil
+	return profile
 }
 
 func (s *AuthService) loadAuthUser(ctx context.Context, id uuid.UUID) (models.AuthUser, error) {
 	var user models.AuthUser
 	if err := s.db.WithContext(ctx).First(&user, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return models.AuthUser{}, errors.New("user not found")
+			return models.AuthUser{}, ErrUserNotFound
 		}
 		return models.AuthUser{}, err
 	}
 	if user.Status != "active" {
-		return models.AuthUser{}, errors.New("account disabled")
+		return models.AuthUser{}, ErrAccountDisabled
 	}
 	return user, nil
 }
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	log "github.com/aliirah/task-flow/shared/logging"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+)
+
+// acceptSignUpInvitation accepts the invitation a new account signed up
+// from. Following the emailed link proves the address, so the email is
+// marked verified and the other invitations it received are claimed too.
+// Failures are logged; the account stays and verifies by mail as usual.
+func (s *AuthService) acceptSignUpInvitation(ctx context.Context, user *models.AuthUser, token string) bool {
+	if s.orgClient == nil {
+		return false
+	}
+	if _, err := s.orgClient.AcceptInvitation(ctx, &organizationpb.RespondInvitationRequest{
+		Token:  token,
+		UserId: user.ID.String(),
+		Email:  user.Email,
+	}); err != nil {
+		log.S().Warnw("failed to accept sign up invitation", "userId", user.ID, "error", err)
+		return false
+	}
+
+	now := time.Now().UTC()
+	if err := s.db.WithContext(ctx).
+		Model(user).
+		Update("email_verified_at", now).Error; err != nil {
+		log.S().Errorw("failed to mark invited email verified", "userId", user.ID, "error", err)
+		return false
+	}
+	user.EmailVerifiedAt = &now
+	s.claimInvitations(ctx, *user)
+	return true
+}
+
+// claimInvitations joins the organizations a verified email was invited to,
+// then those whose verified domain matches it
+func (s *AuthService) claimInvitations(ctx context.Context, user models.AuthUser) {
+	if s.orgClient == nil || user.EmailVerifiedAt == nil {
+		return
+	}
+	resp, err := s.orgClient.ClaimInvitations(ctx, &organizationpb.ClaimInvitationsRequest{
+		UserId: user.ID.String(),
+		Email:  user.Email,
+	})
+	if err != nil {
+		log.S().Warnw("failed to claim organization invitations", "userId", user.ID, "error", err)
+		return
+	}
+	if n := len(resp.GetMemberships()); n > 0 {
+		log.S().Infow("claimed organization invitations", "userId", user.ID, "memberships", n)
+	}
+	s.claimDomainMemberships(ctx, user)
+}
+
+// claimDomainMemberships joins the organizations that verified the domain of
+// the user's email. Organizations that don't auto-join get an invitation
+// offer the user answers in the app.
+func (s *AuthService) claimDomainMemberships(ctx context.Context, user models.AuthUser) {
+	if s.orgClient == nil || user.EmailVerifiedAt == nil {
+		return
+	}
+	resp, err := s.orgClient.ClaimDomainMemberships(ctx, &organizationpb.ClaimInvitationsRequest{
+		UserId: user.ID.String(),
+		Email:  user.Email,
+	})
+	if err != nil {
+		log.S().Warnw("failed to claim organization domain memberships", "userId", user.ID, "error", err)
+		return
+	}
+	if joined, offered := len(resp.GetMemberships()), len(resp.GetOffers()); joined > 0 || offered > 0 {
+		log.S().Infow("claimed organization domain memberships", "userId", user.ID, "memberships", joined, "offers", offered)
+	}
+}
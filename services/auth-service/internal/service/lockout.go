@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/metrics"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	SecurityEventAccountLocked   = "account_locked"
+	SecurityEventAccountUnlocked = "account_unlocked"
+
+	// maxLockoutDoublings caps the shift so the backoff cannot overflow
+	maxLockoutDoublings = 20
+)
+
+var (
+	ErrAccountLocked        = errors.New("account locked after too many failed logins, try again later")
+	ErrTooManyLoginAttempts = errors.New("too many failed logins from this address, try again later")
+	ErrUnlockNotAllowed     = errors.New("only operators can unlock accounts")
+)
+
+// LockoutConfig controls login throttling. Once failures reach a threshold
+// each further failure doubles the lock, starting at Base and capped at Max.
+// Failures are forgotten after Window without one, counted from the end of
+// any lock so the backoff keeps growing across locks.
+type LockoutConfig struct {
+	Threshold   int // per account
+	IPThreshold int // per client address
+	Base        time.Duration
+	Max         time.Duration
+	Window      time.Duration
+	// Operators are the users who may unlock accounts. It comes from the
+	// deployment, as user types and roles can be changed by users themselves.
+	Operators []uuid.UUID
+}
+
+func (c LockoutConfig) withDefaults() LockoutConfig {
+	if c.Threshold <= 0 {
+		c.Threshold = 5
+	}
+	if c.IPThreshold <= 0 {
+		c.IPThreshold = 20
+	}
+	if c.Base <= 0 {
+		c.Base = time.Minute
+	}
+	if c.Max < c.Base {
+		c.Max = time.Hour
+	}
+	if c.Window <= 0 {
+		c.Window = 15 * time.Minute
+	}
+	return c
+}
+
+// operator reports whether the user may unlock accounts
+func (c LockoutConfig) operator(userID uuid.UUID) bool {
+	for _, id := range c.Operators {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// lockFor returns how long to lock after failures, zero below threshold
+func (c LockoutConfig) lockFor(failures, threshold int) time.Duration {
+	if failures < threshold {
+		return 0
+	}
+	doublings := failures - threshold
+	if doublings > maxLockoutDoublings {
+		doublings = maxLockoutDoublings
+	}
+	lock := c.Base << doublings
+	if lock > c.Max {
+		return c.Max
+	}
+	return lock
+}
+
+// UnlockAccount clears a lockout early. actorID must be an operator.
+func (s *AuthService) UnlockAccount(ctx context.Context, actorID, userID uuid.UUID) error {
+	if !s.cfg.Lockout.operator(actorID) {
+		return ErrUnlockNotAllowed
+	}
+	result := s.db.WithContext(ctx).
+		Model(&models.AuthUser{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"failed_login_attempts": 0,
+			"last_failed_login_at":  nil,
+			"locked_until":          nil,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID:  userID,
+		Type:    SecurityEventAccountUnlocked,
+		Details: fmt.Sprintf("unlocked by %s", actorID),
+	})
+}
+
+// checkIPThrottle refuses logins from an address that is locked out
+func (s *AuthService) checkIPThrottle(ctx context.Context, ip string) error {
+	if ip == "" {
+		return nil
+	}
+
+	var throttle models.LoginThrottle
+	err := s.db.WithContext(ctx).First(&throttle, "ip_address = ?", ip).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if throttle.LockedUntil != nil && time.Now().UTC().Before(*throttle.LockedUntil) {
+		return ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+// accountLocked reports whether the account is inside a lockout
+func accountLocked(user models.AuthUser) bool {
+	return user.LockedUntil != nil && time.Now().UTC().Before(*user.LockedUntil)
+}
+
+// recordLoginFailure counts a wrong password or second factor code against
+// the account and the client address. Errors are logged, they must not change the login answer.
+func (s *AuthService) recordLoginFailure(ctx context.Context, user *models.AuthUser, ip string) {
+	if user != nil {
+		if err := s.recordAccountFailure(ctx, user.ID, ip); err != nil {
+			log.S().Errorw("failed to record login failure", "userId", user.ID, "error", err)
+		}
+	}
+	if ip != "" {
+		if err := s.recordIPFailure(ctx, ip); err != nil {
+			log.S().Errorw("failed to record login failure", "ip", ip, "error", err)
+		}
+	}
+}
+
+func (s *AuthService) recordAccountFailure(ctx context.Context, userID uuid.UUID, ip string) error {
+	now := time.Now().UTC()
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user models.AuthUser
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&user, "id = ?", userID).Error; err != nil {
+			return err
+		}
+
+		failures := s.nextFailureCount(user.FailedLoginAttempts, user.LastFailedLoginAt, user.LockedUntil, now)
+		updates := map[string]interface{}{
+			"failed_login_attempts": failures,
+			"last_failed_login_at":  now,
+		}
+		lock := s.cfg.Lockout.lockFor(failures, s.cfg.Lockout.Threshold)
+		if lock > 0 {
+			updates["locked_until"] = now.Add(lock)
+		}
+		if err := tx.Model(&user).Updates(updates).Error; err != nil {
+			return err
+		}
+		if lock == 0 {
+			return nil
+		}
+
+		return tx.Create(&models.SecurityEvent{
+			UserID:  user.ID,
+			Type:    SecurityEventAccountLocked,
+			Details: fmt.Sprintf("%d failed logins, locked for %s, last from %s", failures, lock, ip),
+		}).Error
+	})
+}
+
+func (s *AuthService) recordIPFailure(ctx context.Context, ip string) error {
+	now := time.Now().UTC()
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.LoginThrottle{IPAddress: ip, LastFailureAt: now}).Error; err != nil {
+			return err
+		}
+
+		var throttle models.LoginThrottle
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&throttle, "ip_address = ?", ip).Error; err != nil {
+			return err
+		}
+
+		last := throttle.LastFailureAt
+		failures := s.nextFailureCount(throttle.Failures, &last, throttle.LockedUntil, now)
+		updates := map[string]interface{}{
+			"failures":        failures,
+			"last_failure_at": now,
+		}
+		if lock := s.cfg.Lockout.lockFor(failures, s.cfg.Lockout.IPThreshold); lock > 0 {
+			updates["locked_until"] = now.Add(lock)
+			log.S().Warnw("client address locked out of login", "ip", ip, "failures", failures, "lock", lock)
+		}
+		return tx.Model(&throttle).Updates(updates).Error
+	})
+}
+
+// resetLoginFailures clears the account counter after a completed login.
+// The address counter only decays, so one valid account cannot reset it.
+func (s *AuthService) resetLoginFailures(ctx context.Context, user models.AuthUser) {
+	if user.FailedLoginAttempts == 0 && user.LockedUntil == nil {
+		return
+	}
+	if err := s.db.WithContext(ctx).
+		Model(&models.AuthUser{}).
+		Where("id = ?", user.ID).
+		Updates(map[string]interface{}{
+			"failed_login_attempts": 0,
+			"last_failed_login_at":  nil,
+			"locked_until":          nil,
+		}).Error; err != nil {
+		log.S().Errorw("failed to reset login failures", "userId", user.ID, "error", err)
+	}
+}
+
+func (s *AuthService) nextFailureCount(failures int, last, lockedUntil *time.Time, now time.Time) int {
+	if last == nil {
+		return 1
+	}
+	since := *last
+	if lockedUntil != nil && lockedUntil.After(since) {
+		since = *lockedUntil
+	}
+	if now.Sub(since) > s.cfg.Lockout.Window {
+		return 1
+	}
+	return failures + 1
+}
+
+// purgeLoginThrottles drops address counters that can no longer matter
+func (s *AuthService) purgeLoginThrottles(ctx context.Context) error {
+	cutoff := time.Now().UTC().Add(-s.cfg.Lockout.Window)
+	return s.db.WithContext(ctx).
+		Where("last_failure_at < ? AND (locked_until IS NULL OR locked_until < ?)", cutoff, cutoff).
+		Delete(&models.LoginThrottle{}).Error
+}
+
+func countLogin(result string) {
+	metrics.LoginAttemptsTotal.WithLabelValues(result).Inc()
+}
@@ -0,0 +1,389 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	SecurityEventRecoveryCodeUsed = "recovery_code_used"
+
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	// recoveryAlphabet leaves out characters that are easy to misread
+	recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	// maxMFAAttempts wrong codes burn a challenge, the user has to log in again
+	maxMFAAttempts = 5
+)
+
+var (
+	ErrMFACodeInvalid      = errors.New("invalid authentication code")
+	ErrMFAChallengeInvalid = errors.New("invalid or expired mfa challenge")
+	ErrTwoFactorEnabled    = errors.New("two-factor authentication already enabled")
+	ErrTwoFactorNotEnabled = errors.New("two-factor authentication not enabled")
+	ErrTwoFactorRequired   = errors.New("two-factor authentication is required by an organization")
+	ErrTOTPNotEnrolled     = errors.New("totp enrollment not started")
+)
+
+// MFAChallenge is handed out by Login in place of tokens when a second
+// factor is needed. EnrollmentRequired means the user has no TOTP yet but an
+// organization requires it.
+type MFAChallenge struct {
+	Token              string
+	ExpiresAt          time.Time
+	EnrollmentRequired bool
+}
+
+type TOTPEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// EnrollTOTP starts enrollment with a fresh secret. TOTP is not enforced
+// until ConfirmTOTP accepts a code generated from it.
+func (s *AuthService) EnrollTOTP(ctx context.Context, userID uuid.UUID) (TOTPEnrollment, error) {
+	user, err := s.loadAuthUser(ctx, userID)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if user.TOTPEnabledAt != nil {
+		return TOTPEnrollment{}, ErrTwoFactorEnabled
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	if err := s.db.WithContext(ctx).
+		Model(&models.AuthUser{}).
+		Where("id = ? AND totp_enabled_at IS NULL", user.ID).
+		Updates(map[string]interface{}{
+			"totp_secret":       secret,
+			"totp_last_counter": 0,
+		}).Error; err != nil {
+		return TOTPEnrollment{}, err
+	}
+
+	return TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totpURI(s.cfg.TOTPIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables TOTP once code matches the enrolled secret and returns
+// the recovery codes. They are only ever shown here.
+func (s *AuthService) ConfirmTOTP(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.loadAuthUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabledAt != nil {
+		return nil, ErrTwoFactorEnabled
+	}
+	if user.TOTPSecret == "" {
+		return nil, ErrTOTPNotEnrolled
+	}
+
+	now := time.Now().UTC()
+	counter, ok := verifyTOTP(user.TOTPSecret, normalizeTOTPCode(code), now, user.TOTPLastCounter)
+	if !ok {
+		return nil, ErrMFACodeInvalid
+	}
+
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.AuthUser{}).
+			Where("id = ? AND totp_enabled_at IS NULL", user.ID).
+			Updates(map[string]interface{}{
+				"totp_enabled_at":   now,
+				"totp_last_counter": counter,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTwoFactorEnabled
+		}
+		return replaceRecoveryCodes(tx, user.ID, codes)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTOTP turns TOTP off after checking a current code. Members of an
+// organization that requires 2FA cannot disable it.
+func (s *AuthService) DisableTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	user, err := s.loadAuthUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.TOTPEnabledAt == nil {
+		return ErrTwoFactorNotEnabled
+	}
+
+	policy, err := s.loginPolicy(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if policy.RequireTwoFactor {
+		return ErrTwoFactorRequired
+	}
+
+	if err := s.verifySecondFactor(ctx, user, code); err != nil {
+		return err
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.AuthUser{}).
+			Where("id = ?", user.ID).
+			Updates(map[string]interface{}{
+				"totp_secret":       "",
+				"totp_enabled_at":   nil,
+				"totp_last_counter": 0,
+			}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", user.ID).Delete(&models.RecoveryCode{}).Error
+	})
+}
+
+// RegenerateRecoveryCodes replaces every recovery code, used or not
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	user, err := s.loadAuthUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabledAt == nil {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err := s.verifySecondFactor(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	codes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, user.ID, codes)
+	}); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// VerifyMFA completes a login challenge with a TOTP or recovery code
+func (s *AuthService) VerifyMFA(ctx context.Context, mfaToken, code string) (TokenBundle, error) {
+	challenge, err := s.lookupMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return TokenBundle{}, err
+	}
+	user, err := s.loadAuthUser(ctx, challenge.UserID)
+	if err != nil {
+		return TokenBundle{}, err
+	}
+	if user.TOTPEnabledAt == nil {
+		return TokenBundle{}, ErrTwoFactorNotEnabled
+	}
+	if accountLocked(user) {
+		return TokenBundle{}, ErrAccountLocked
+	}
+
+	if err := s.verifySecondFactor(ctx, user, code); err != nil {
+		if errors.Is(err, ErrMFACodeInvalid) {
+			s.recordMFAFailure(ctx, challenge, user)
+		}
+		return TokenBundle{}, err
+	}
+	return s.finishMFAChallenge(ctx, mfaToken)
+}
+
+// EnrollTOTPForChallenge starts enrollment for the owner of a login
+// challenge, for users an organization forces into 2FA.
+func (s *AuthService) EnrollTOTPForChallenge(ctx context.Context, mfaToken string) (TOTPEnrollment, error) {
+	challenge, err := s.lookupMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return TOTPEnrollment{}, err
+	}
+	return s.EnrollTOTP(ctx, challenge.UserID)
+}
+
+// ConfirmTOTPForChallenge confirms enrollment and completes the login
+func (s *AuthService) ConfirmTOTPForChallenge(ctx context.Context, mfaToken, code string) ([]string, TokenBundle, error) {
+	challenge, err := s.lookupMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, TokenBundle{}, err
+	}
+	user, err := s.loadAuthUser(ctx, challenge.UserID)
+	if err != nil {
+		return nil, TokenBundle{}, err
+	}
+	if accountLocked(user) {
+		return nil, TokenBundle{}, ErrAccountLocked
+	}
+
+	codes, err := s.ConfirmTOTP(ctx, challenge.UserID, code)
+	if err != nil {
+		if errors.Is(err, ErrMFACodeInvalid) {
+			s.recordMFAFailure(ctx, challenge, user)
+		}
+		return nil, TokenBundle{}, err
+	}
+
+	bundle, err := s.finishMFAChallenge(ctx, mfaToken)
+	if err != nil {
+		return nil, TokenBundle{}, err
+	}
+	return codes, bundle, nil
+}
+
+func (s *AuthService) startMFAChallenge(ctx context.Context, user models.AuthUser) (TokenBundle, error) {
+	token, err := s.issueAccountToken(ctx, user.ID, models.AccountTokenMFAChallenge, s.cfg.MFAChallengeTTL)
+	if err != nil {
+		return TokenBundle{}, err
+	}
+	return TokenBundle{MFAChallenge: &MFAChallenge{
+		Token:              token,
+		ExpiresAt:          time.Now().UTC().Add(s.cfg.MFAChallengeTTL),
+		EnrollmentRequired: user.TOTPEnabledAt == nil,
+	}}, nil
+}
+
+func (s *AuthService) finishMFAChallenge(ctx context.Context, mfaToken string) (TokenBundle, error) {
+	challenge, err := s.consumeAccountToken(ctx, mfaToken, models.AccountTokenMFAChallenge)
+	if err != nil {
+		if errors.Is(err, ErrAccountTokenInvalid) {
+			return TokenBundle{}, ErrMFAChallengeInvalid
+		}
+		return TokenBundle{}, err
+	}
+	user, err := s.loadAuthUser(ctx, challenge.UserID)
+	if err != nil {
+		return TokenBundle{}, err
+	}
+	return s.completeLogin(ctx, user)
+}
+
+func (s *AuthService) lookupMFAChallenge(ctx context.Context, mfaToken string) (models.AccountToken, error) {
+	challenge, err := s.lookupAccountToken(ctx, mfaToken, models.AccountTokenMFAChallenge)
+	if errors.Is(err, ErrAccountTokenInvalid) {
+		return models.AccountToken{}, ErrMFAChallengeInvalid
+	}
+	return challenge, err
+}
+
+// recordMFAFailure counts a wrong code against the challenge, burning it
+// after maxMFAAttempts, and as a failed login against the account. New
+// challenges bring new attempts, the account lockout is what stops a leaked
+// password from being used to brute force codes.
+func (s *AuthService) recordMFAFailure(ctx context.Context, challenge models.AccountToken, user models.AuthUser) {
+	s.recordLoginFailure(ctx, &user, authctx.IncomingClient(ctx).IP)
+	if err := s.db.WithContext(ctx).
+		Model(&models.AccountToken{}).
+		Where("id = ?", challenge.ID).
+		Update("attempts", gorm.Expr("attempts + 1")).Error; err != nil {
+		return
+	}
+	_ = s.db.WithContext(ctx).
+		Model(&models.AccountToken{}).
+		Where("id = ? AND attempts >= ? AND used_at IS NULL", challenge.ID, maxMFAAttempts).
+		Update("used_at", time.Now().UTC()).Error
+}
+
+// verifySecondFactor accepts a TOTP code or an unused recovery code. Each
+// is accepted once.
+func (s *AuthService) verifySecondFactor(ctx context.Context, user models.AuthUser, code string) error {
+	now := time.Now().UTC()
+	if counter, ok := verifyTOTP(user.TOTPSecret, normalizeTOTPCode(code), now, user.TOTPLastCounter); ok {
+		// The counter guard loses to a concurrent request with the same code
+		result := s.db.WithContext(ctx).
+			Model(&models.AuthUser{}).
+			Where("id = ? AND totp_last_counter < ?", user.ID, counter).
+			Update("totp_last_counter", counter)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrMFACodeInvalid
+		}
+		return nil
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != recoveryCodeLength {
+		return ErrMFACodeInvalid
+	}
+	result := s.db.WithContext(ctx).
+		Model(&models.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", user.ID, hashToken(normalized)).
+		Update("used_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrMFACodeInvalid
+	}
+
+	return s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID: user.ID,
+		Type:   SecurityEventRecoveryCodeUsed,
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID uuid.UUID, codes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	records := make([]models.RecoveryCode, 0, len(codes))
+	for _, code := range codes {
+		records = append(records, models.RecoveryCode{
+			UserID:   userID,
+			CodeHash: hashToken(normalizeRecoveryCode(code)),
+		})
+	}
+	return tx.Create(&records).Error
+}
+
+// generateRecoveryCodes returns codes formatted as xxxxx-xxxxx
+func generateRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, recoveryCodeLength)
+	for len(codes) < recoveryCodeCount {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		var b strings.Builder
+		for i, v := range buf {
+			if i == recoveryCodeLength/2 {
+				b.WriteByte('-')
+			}
+			// 256 is not a multiple of the alphabet size; the bias is
+			// negligible for codes this long
+			b.WriteByte(recoveryAlphabet[int(v)%len(recoveryAlphabet)])
+		}
+		codes = append(codes, b.String())
+	}
+	return codes, nil
+}
+
+func normalizeTOTPCode(code string) string {
+	return strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
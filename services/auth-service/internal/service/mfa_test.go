@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/db/gormdb"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testAuthService connects to the database in AUTH_TEST_DATABASE_URL and
+// skips the test when it is not set
+func testAuthService(t *testing.T, cfg Config) *AuthService {
+	t.Helper()
+	dsn := os.Getenv("AUTH_TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("AUTH_TEST_DATABASE_URL not set")
+	}
+	db, err := gormdb.Open(gormdb.Config{DSN: dsn})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	if err := models.AutoMigrate(db); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return NewAuthService(db, cfg, nil, nil, nil, nil)
+}
+
+func TestWrongMFACodesLockAccount(t *testing.T) {
+	ctx := context.Background()
+	svc := testAuthService(t, Config{BcryptCost: bcrypt.MinCost})
+
+	const password = "correct horse battery staple"
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	user := models.AuthUser{
+		Email:           "mfa-lockout-" + uuid.NewString() + "@example.com",
+		PasswordHash:    string(hash),
+		EmailVerifiedAt: &now,
+		TOTPSecret:      secret,
+		TOTPEnabledAt:   &now,
+	}
+	if err := svc.db.Create(&user).Error; err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	t.Cleanup(func() {
+		svc.db.Where("user_id = ?", user.ID).Delete(&models.AccountToken{})
+		svc.db.Where("user_id = ?", user.ID).Delete(&models.SecurityEvent{})
+		svc.db.Delete(&user)
+	})
+
+	wrong := wrongTOTPCode(t, secret)
+	// Every login starts a fresh challenge, the lockout has to stop the
+	// guessing across challenges
+	for i := 0; i <= svc.cfg.Lockout.Threshold; i++ {
+		bundle, err := svc.Login(ctx, LoginInput{Email: user.Email, Password: password})
+		if errors.Is(err, ErrAccountLocked) {
+			if i < svc.cfg.Lockout.Threshold {
+				t.Fatalf("locked after %d wrong codes, want %d", i, svc.cfg.Lockout.Threshold)
+			}
+			return
+		}
+		if err != nil {
+			t.Fatalf("login %d: %v", i, err)
+		}
+		if bundle.MFAChallenge == nil {
+			t.Fatalf("login %d: no mfa challenge", i)
+		}
+		if _, err := svc.VerifyMFA(ctx, bundle.MFAChallenge.Token, wrong); !errors.Is(err, ErrMFACodeInvalid) {
+			t.Fatalf("verify %d: got %v, want %v", i, err, ErrMFACodeInvalid)
+		}
+	}
+	t.Fatalf("account not locked after %d wrong codes", svc.cfg.Lockout.Threshold)
+}
+
+// wrongTOTPCode returns a code no step around now accepts
+func wrongTOTPCode(t *testing.T, secret string) string {
+	t.Helper()
+	for _, code := range []string{"000000", "111111", "222222", "333333"} {
+		if _, ok := verifyTOTP(secret, code, time.Now().UTC(), 0); !ok {
+			return code
+		}
+	}
+	t.Fatal("no wrong code found")
+	return ""
+}
@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/services/auth-service/internal/oidc"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"gorm.io/gorm"
+)
+
+const (
+	SecurityEventOIDCLinked = "oidc_linked"
+
+	oidcStateTTL = 10 * time.Minute
+)
+
+var (
+	ErrOIDCProviderNotFound = errors.New("unknown sso provider")
+	ErrOIDCStateInvalid     = errors.New("invalid or expired sso login")
+	ErrOIDCLoginFailed      = errors.New("sso login failed")
+	ErrOIDCEmailUnverified  = errors.New("the identity provider has not verified this email address")
+	ErrRedirectNotAllowed   = errors.New("redirect must point to the web client")
+)
+
+// OIDCProviders lists the configured identity providers by name
+func (s *AuthService) OIDCProviders() []oidc.ProviderConfig {
+	providers := make([]oidc.ProviderConfig, 0, len(s.oidcProviders))
+	for _, provider := range s.oidcProviders {
+		providers = append(providers, provider.Config())
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].Name < providers[j].Name })
+	return providers
+}
+
+// StartOIDCLogin returns the provider URL to send the browser to. redirectTo
+// is where the web client wants the result, it must be on PublicURL.
+func (s *AuthService) StartOIDCLogin(ctx context.Context, providerName, redirectTo string) (string, error) {
+	provider, ok := s.oidcProviders[providerName]
+	if !ok {
+		return "", ErrOIDCProviderNotFound
+	}
+	if err := s.checkRedirect(redirectTo); err != nil {
+		return "", err
+	}
+
+	state, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	verifier, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, state, nonce, oidc.PKCEChallenge(verifier))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrOIDCLoginFailed, err)
+	}
+
+	if err := s.db.WithContext(ctx).Create(&models.OIDCLoginState{
+		Provider:     providerName,
+		StateHash:    hashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		RedirectTo:   redirectTo,
+		ExpiresAt:    time.Now().UTC().Add(oidcStateTTL),
+	}).Error; err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
+
+// CompleteOIDCLogin handles the provider callback. It returns the login
+// result, which may be an MFA challenge, and the redirect from the start.
+func (s *AuthService) CompleteOIDCLogin(ctx context.Context, providerName, state, code string) (TokenBundle, string, error) {
+	provider, ok := s.oidcProviders[providerName]
+	if !ok {
+		return TokenBundle{}, "", ErrOIDCProviderNotFound
+	}
+
+	login, err := s.consumeOIDCState(ctx, providerName, state)
+	if err != nil {
+		return TokenBundle{}, "", err
+	}
+
+	rawIDToken, err := provider.Exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		log.S().Warnw("oidc code exchange failed", "provider", providerName, "error", err)
+		return TokenBundle{}, login.RedirectTo, ErrOIDCLoginFailed
+	}
+	claims, err := provider.VerifyIDToken(ctx, rawIDToken, login.Nonce)
+	if err != nil {
+		log.S().Warnw("oidc id token rejected", "provider", providerName, "error", err)
+		return TokenBundle{}, login.RedirectTo, ErrOIDCLoginFailed
+	}
+
+	user, err := s.resolveOIDCUser(ctx, providerName, claims)
+	if err != nil {
+		return TokenBundle{}, login.RedirectTo, err
+	}
+	if user.Status != "active" {
+		return TokenBundle{}, login.RedirectTo, ErrAccountDisabled
+	}
+
+	bundle, err := s.afterPrimaryFactor(ctx, user)
+	return bundle, login.RedirectTo, err
+}
+
+// resolveOIDCUser finds the user behind an identity: an existing link, then
+// an account with the same verified email, then a new account. An unverified
+// account may have been registered by someone else, so linking it resets its
+// credentials first.
+func (s *AuthService) resolveOIDCUser(ctx context.Context, providerName string, claims oidc.Claims) (models.AuthUser, error) {
+	now := time.Now().UTC()
+
+	var identity models.OIDCIdentity
+	err := s.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", providerName, claims.Subject).
+		First(&identity).Error
+	if err == nil {
+		_ = s.db.WithContext(ctx).Model(&identity).Update("last_login_at", now).Error
+		var user models.AuthUser
+		if err := s.db.WithContext(ctx).First(&user, "id = ?", identity.UserID).Error; err != nil {
+			return models.AuthUser{}, err
+		}
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return models.AuthUser{}, err
+	}
+
+	// Linking or creating trusts the email, so the provider must vouch for it
+	if claims.Email == "" || !claims.EmailVerified {
+		return models.AuthUser{}, ErrOIDCEmailUnverified
+	}
+
+	var user models.AuthUser
+	err = s.db.WithContext(ctx).Where("email = ?", claims.Email).First(&user).Error
+	switch {
+	case err == nil:
+		if user.EmailVerifiedAt == nil {
+			if user, err = s.claimUnverifiedAccount(ctx, user); err != nil {
+				return models.AuthUser{}, err
+			}
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		if user, err = s.provisionOIDCUser(ctx, claims); err != nil {
+			return models.AuthUser{}, err
+		}
+	default:
+		return models.AuthUser{}, err
+	}
+
+	if err := s.db.WithContext(ctx).Create(&models.OIDCIdentity{
+		UserID:      user.ID,
+		Provider:    providerName,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		LastLoginAt: &now,
+	}).Error; err != nil {
+		return models.AuthUser{}, err
+	}
+	if err := s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID:  user.ID,
+		Type:    SecurityEventOIDCLinked,
+		Details: fmt.Sprintf("%s subject %s", providerName, claims.Subject),
+	}); err != nil {
+		return models.AuthUser{}, err
+	}
+	return user, nil
+}
+
+// claimUnverifiedAccount hands an account whose email was never verified to
+// the owner the provider vouches for. Whoever registered it may not be that
+// owner, so the password, second factor, API tokens and sessions they set up
+// are all dropped.
+func (s *AuthService) claimUnverifiedAccount(ctx context.Context, user models.AuthUser) (models.AuthUser, error) {
+	hash, err := s.unusablePasswordHash()
+	if err != nil {
+		return models.AuthUser{}, err
+	}
+
+	now := time.Now().UTC()
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.AuthUser{}).
+			Where("id = ?", user.ID).
+			Updates(map[string]interface{}{
+				"password_hash":     hash,
+				"email_verified_at": now,
+				"totp_secret":       "",
+				"totp_enabled_at":   nil,
+				"totp_last_counter": 0,
+			}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&models.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.APIToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", user.ID).
+			Update("revoked_at", now).Error
+	})
+	if err != nil {
+		return models.AuthUser{}, err
+	}
+	if err := s.RevokeUserTokens(ctx, user.ID, RevocationReasonOIDCClaimed); err != nil {
+		return models.AuthUser{}, err
+	}
+
+	user.PasswordHash = hash
+	user.EmailVerifiedAt = &now
+	user.TOTPSecret = ""
+	user.TOTPEnabledAt = nil
+	user.TOTPLastCounter = 0
+	return user, nil
+}
+
+// unusablePasswordHash hashes a random secret nobody knows. Accounts holding
+// it can only log in through SSO until the user resets the password.
+func (s *AuthService) unusablePasswordHash() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return s.hashPassword(base64.RawURLEncoding.EncodeToString(secret))
+}
+
+// provisionOIDCUser creates an account for a first SSO login. It gets an
+// unusable password; the user can set one through a password reset.
+func (s *AuthService) provisionOIDCUser(ctx context.Context, claims oidc.Claims) (models.AuthUser, error) {
+	hash, err := s.unusablePasswordHash()
+	if err != nil {
+		return models.AuthUser{}, err
+	}
+
+	now := time.Now().UTC()
+	user := models.AuthUser{
+		Email:           claims.Email,
+		PasswordHash:    hash,
+		Status:          "active",
+		UserType:        "user",
+		EmailVerifiedAt: &now,
+	}
+	if err := s.db.WithContext(ctx).Create(&user).Error; err != nil {
+		return models.AuthUser{}, err
+	}
+
+	firstName, lastName := oidcNames(claims)
+	if _, err := s.ensureUserProfile(ctx, user, SignUpInput{
+		Email:     user.Email,
+		FirstName: firstName,
+		LastName:  lastName,
+		UserType:  user.UserType,
+	}); err != nil {
+		s.db.WithContext(ctx).Delete(&user)
+		return models.AuthUser{}, err
+	}
+	// The provider vouches for the email, so its invitations apply
+	s.claimInvitations(ctx, user)
+	return user, nil
+}
+
+func (s *AuthService) consumeOIDCState(ctx context.Context, providerName, state string) (models.OIDCLoginState, error) {
+	if state == "" {
+		return models.OIDCLoginState{}, ErrOIDCStateInvalid
+	}
+
+	var login models.OIDCLoginState
+	if err := s.db.WithContext(ctx).
+		Where("state_hash = ? AND provider = ?", hashToken(state), providerName).
+		First(&login).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.OIDCLoginState{}, ErrOIDCStateInvalid
+		}
+		return models.OIDCLoginState{}, err
+	}
+
+	now := time.Now().UTC()
+	result := s.db.WithContext(ctx).
+		Model(&models.OIDCLoginState{}).
+		Where("id = ? AND used_at IS NULL AND expires_at > ?", login.ID, now).
+		Update("used_at", now)
+	if result.Error != nil {
+		return models.OIDCLoginState{}, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.OIDCLoginState{}, ErrOIDCStateInvalid
+	}
+	return login, nil
+}
+
+// checkRedirect stops the callback from becoming an open redirect
+func (s *AuthService) checkRedirect(redirectTo string) error {
+	if redirectTo == "" {
+		return nil
+	}
+	target, err := url.Parse(redirectTo)
+	if err != nil {
+		return ErrRedirectNotAllowed
+	}
+	public, err := url.Parse(s.cfg.PublicURL)
+	if err != nil || target.Scheme != public.Scheme || target.Host != public.Host {
+		return ErrRedirectNotAllowed
+	}
+	return nil
+}
+
+func (s *AuthService) purgeOIDCStates(ctx context.Context) error {
+	return s.db.WithContext(ctx).
+		Where("expires_at <= ?", time.Now().UTC()).
+		Delete(&models.OIDCLoginState{}).Error
+}
+
+// oidcNames picks a first and last name, falling back to the full name and
+// then the email's local part
+func oidcNames(claims oidc.Claims) (string, string) {
+	first, last := strings.TrimSpace(claims.GivenName), strings.TrimSpace(claims.FamilyName)
+	if first == "" && claims.Name != "" {
+		parts := strings.Fields(claims.Name)
+		first = parts[0]
+		if last == "" && len(parts) > 1 {
+			last = strings.Join(parts[1:], " ")
+		}
+	}
+	if first == "" {
+		first = strings.SplitN(claims.Email, "@", 2)[0]
+	}
+	return first, last
+}
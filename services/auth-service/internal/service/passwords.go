@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	SecurityEventPasswordChanged = "password_changed"
+
+	// maxPasswordBytes is all bcrypt reads of a password
+	maxPasswordBytes = 72
+	// passwordHistoryKeep bounds stored history, the most any policy asks for
+	passwordHistoryKeep = 24
+	// minPersonalInfoLength skips name parts too short to matter
+	minPersonalInfoLength = 3
+)
+
+var ErrCurrentPasswordInvalid = errors.New("current password is incorrect")
+
+// PasswordPolicy is what a new password must satisfy. The deployment sets a
+// baseline and organizations can only make it stricter for their members.
+type PasswordPolicy struct {
+	MinLength  int
+	MinClasses int // of lower case, upper case, digit and symbol
+	// History is how many recent passwords, the current one included,
+	// cannot be reused
+	History int
+	// AllowPersonalInfo permits passwords containing the email or name
+	AllowPersonalInfo bool
+}
+
+func (p PasswordPolicy) withDefaults() PasswordPolicy {
+	if p.MinLength <= 0 {
+		p.MinLength = minPasswordLength
+	}
+	if p.MinLength > maxPasswordBytes {
+		p.MinLength = maxPasswordBytes
+	}
+	if p.MinClasses > 4 {
+		p.MinClasses = 4
+	}
+	if p.History > passwordHistoryKeep {
+		p.History = passwordHistoryKeep
+	}
+	return p
+}
+
+// check validates password against the policy, except for history which
+// needs the stored hashes. personal holds the user's email and names.
+func (p PasswordPolicy) check(password string, personal ...string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("%w: use at least %d characters", ErrInvalidPassword, p.MinLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return fmt.Errorf("%w: use at most %d bytes", ErrInvalidPassword, maxPasswordBytes)
+	}
+	if classes := characterClasses(password); classes < p.MinClasses {
+		return fmt.Errorf("%w: mix at least %d of lower case, upper case, digits and symbols", ErrInvalidPassword, p.MinClasses)
+	}
+	if !p.AllowPersonalInfo {
+		lower := strings.ToLower(password)
+		for _, value := range personalInfo(personal) {
+			if strings.Contains(lower, value) {
+				return fmt.Errorf("%w: do not use your name or email address", ErrInvalidPassword)
+			}
+		}
+	}
+	return nil
+}
+
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
+
+// personalInfo splits emails and names into the lower case parts a
+// password must not contain
+func personalInfo(values []string) []string {
+	parts := make([]string, 0, len(values)*2)
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if local, _, ok := strings.Cut(value, "@"); ok {
+			value = local
+		}
+		for _, part := range strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if len([]rune(part)) >= minPersonalInfoLength {
+				parts = append(parts, part)
+			}
+		}
+	}
+	return parts
+}
+
+// passwordPolicy is the deployment policy tightened by every organization
+// the user belongs to. A zero userID gets the deployment policy.
+func (s *AuthService) passwordPolicy(ctx context.Context, userID uuid.UUID) (PasswordPolicy, error) {
+	policy := s.cfg.PasswordPolicy
+	if userID == uuid.Nil {
+		return policy, nil
+	}
+
+	orgs, err := s.userOrganizations(ctx, userID)
+	if err != nil {
+		return policy, err
+	}
+	for _, org := range orgs {
+		policy.MinLength = max(policy.MinLength, int(org.GetPasswordMinLength()))
+		policy.MinClasses = max(policy.MinClasses, int(org.GetPasswordMinClasses()))
+		policy.History = max(policy.History, int(org.GetPasswordHistory()))
+	}
+	return policy.withDefaults(), nil
+}
+
+// validateNewPassword runs every policy check, history and the breached
+// password list included. user is zero for sign up.
+func (s *AuthService) validateNewPassword(ctx context.Context, user models.AuthUser, password string, personal ...string) error {
+	policy, err := s.passwordPolicy(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if err := policy.check(password, append(personal, user.Email)...); err != nil {
+		return err
+	}
+
+	if s.breached != nil {
+		breached, err := s.breached.Breached(password)
+		if err != nil {
+			// The list is a safety net, a broken copy must not block users
+			log.S().Errorw("breached password check failed", "error", err)
+		} else if breached {
+			return fmt.Errorf("%w: it appears in a list of breached passwords", ErrInvalidPassword)
+		}
+	}
+
+	if user.ID != uuid.Nil && policy.History > 0 {
+		reused, err := s.passwordReused(ctx, user, password, policy.History)
+		if err != nil {
+			return err
+		}
+		if reused {
+			return fmt.Errorf("%w: do not reuse one of your last %d passwords", ErrInvalidPassword, policy.History)
+		}
+	}
+	return nil
+}
+
+func (s *AuthService) passwordReused(ctx context.Context, user models.AuthUser, password string, history int) (bool, error) {
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) == nil {
+		return true, nil
+	}
+	if history <= 1 {
+		return false, nil
+	}
+
+	var previous []models.PasswordHistory
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ?", user.ID).
+		Order("created_at DESC").
+		Limit(history - 1).
+		Find(&previous).Error; err != nil {
+		return false, err
+	}
+	for _, entry := range previous {
+		if bcrypt.CompareHashAndPassword([]byte(entry.PasswordHash), []byte(password)) == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *AuthService) hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.cfg.BcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// storePassword replaces the user's password hash, moving the old one into
+// the history. updates are applied in the same write.
+func (s *AuthService) storePassword(ctx context.Context, user models.AuthUser, password string, updates map[string]interface{}) error {
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		return err
+	}
+	if updates == nil {
+		updates = map[string]interface{}{}
+	}
+	updates["password_hash"] = hash
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.AuthUser{}).Where("id = ?", user.ID).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&models.PasswordHistory{UserID: user.ID, PasswordHash: user.PasswordHash}).Error; err != nil {
+			return err
+		}
+		// Keep only the newest entries
+		return tx.Where("user_id = ? AND id NOT IN (?)", user.ID,
+			tx.Model(&models.PasswordHistory{}).
+				Select("id").
+				Where("user_id = ?", user.ID).
+				Order("created_at DESC").
+				Limit(passwordHistoryKeep),
+		).Delete(&models.PasswordHistory{}).Error
+	})
+}
+
+// ChangePassword replaces the password of a logged in user after checking
+// the current one. Every other session is logged out; without a known
+// current session all of them are.
+func (s *AuthService) ChangePassword(ctx context.Context, userID uuid.UUID, currentSessionID, currentPassword, newPassword string) error {
+	user, err := s.loadAuthUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// Wrong current passwords count as failed logins, so a stolen session
+	// cannot be used to guess the password
+	if accountLocked(user) {
+		return ErrAccountLocked
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(currentPassword)) != nil {
+		s.recordLoginFailure(ctx, &user, authctx.IncomingClient(ctx).IP)
+		return ErrCurrentPasswordInvalid
+	}
+
+	profile, err := s.fetchUserProfile(ctx, user)
+	if err != nil {
+		return err
+	}
+	if err := s.validateNewPassword(ctx, user, newPassword, profile.FirstName, profile.LastName); err != nil {
+		return err
+	}
+	if err := s.storePassword(ctx, user, newPassword, nil); err != nil {
+		return err
+	}
+	if err := s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID: user.ID,
+		Type:   SecurityEventPasswordChanged,
+	}); err != nil {
+		return err
+	}
+
+	if currentSessionID == "" {
+		return s.RevokeUserTokens(ctx, user.ID, RevocationReasonPasswordChanged)
+	}
+	_, err = s.RevokeOtherSessions(ctx, user.ID, currentSessionID)
+	return err
+}
+
+// rehashIfNeeded upgrades a hash made with another cost after a successful
+// login, the only time the plain password is at hand. Failures are logged.
+func (s *AuthService) rehashIfNeeded(ctx context.Context, user models.AuthUser, password string) {
+	cost, err := bcrypt.Cost([]byte(user.PasswordHash))
+	if err != nil || cost == s.cfg.BcryptCost {
+		return
+	}
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		log.S().Errorw("failed to rehash password", "userId", user.ID, "error", err)
+		return
+	}
+	// Only replace the hash that was checked, a concurrent change wins
+	if err := s.db.WithContext(ctx).
+		Model(&models.AuthUser{}).
+		Where("id = ? AND password_hash = ?", user.ID, user.PasswordHash).
+		Update("password_hash", hash).Error; err != nil {
+		log.S().Errorw("failed to rehash password", "userId", user.ID, "error", err)
+	}
+}
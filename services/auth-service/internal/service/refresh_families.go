@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+)
+
+const (
+	SecurityEventRefreshTokenReuse = "refresh_token_reuse"
+
+	RevocationReasonRefreshReuse = "refresh_token_reuse"
+)
+
+// rotateRefreshToken marks the token as replaced by nextID. It reports false
+// when the token was already revoked, i.e. someone else rotated it first.
+func (s *AuthService) rotateRefreshToken(ctx context.Context, id, nextID uuid.UUID) (bool, error) {
+	result := s.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now().UTC(),
+			"replaced_by_id": nextID,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// inRefreshGrace reports whether a rotated token is presented again within
+// RefreshReuseGrace of its rotation, as a concurrent refresh of the same
+// client does. Only the latest rotation qualifies: its replacement has to be
+// unused still.
+func (s *AuthService) inRefreshGrace(ctx context.Context, stored models.RefreshToken) (bool, error) {
+	if stored.RevokedAt == nil || stored.ReplacedByID == nil {
+		return false, nil
+	}
+	if time.Since(*stored.RevokedAt) > s.cfg.RefreshReuseGrace {
+		return false, nil
+	}
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", *stored.ReplacedByID).
+		Count(&count).Error
+	return count == 1, err
+}
+
+// lostRefreshRace handles a token another request rotated while this one
+// was refreshing it. Inside the grace window it is not reuse.
+func (s *AuthService) lostRefreshRace(ctx context.Context, stored *models.RefreshToken) error {
+	if err := s.db.WithContext(ctx).First(stored, "id = ?", stored.ID).Error; err != nil {
+		return err
+	}
+	graced, err := s.inRefreshGrace(ctx, *stored)
+	if err != nil {
+		return err
+	}
+	if graced {
+		return nil
+	}
+	if stored.ReplacedByID == nil {
+		return ErrRefreshTokenInvalid
+	}
+	return s.handleRefreshReuse(ctx, *stored)
+}
+
+// handleRefreshReuse treats a replay of a rotated refresh token as theft: the
+// whole family is revoked, forcing both the thief and the owner to log in
+// again, and a security event is recorded.
+func (s *AuthService) handleRefreshReuse(ctx context.Context, stored models.RefreshToken) error {
+	familyID := familyOf(stored)
+
+	log.S().Warnw("refresh token reuse detected", "userId", stored.UserID, "familyId", familyID, "tokenId", stored.ID)
+
+	if err := s.revokeRefreshFamily(ctx, stored.UserID, familyID, RevocationReasonRefreshReuse); err != nil {
+		return err
+	}
+	if err := s.recordSecurityEvent(ctx, models.SecurityEvent{
+		UserID:   stored.UserID,
+		Type:     SecurityEventRefreshTokenReuse,
+		FamilyID: &familyID,
+		Details:  fmt.Sprintf("rotated refresh token %s presented again", stored.ID),
+	}); err != nil {
+		return err
+	}
+	return ErrRefreshTokenReused
+}
+
+// revokeRefreshFamily revokes every refresh token in the family along with
+// the access tokens issued with them that have not expired yet.
+func (s *AuthService) revokeRefreshFamily(ctx context.Context, userID, familyID uuid.UUID, reason string) error {
+	now := time.Now().UTC()
+
+	var tokens []models.RefreshToken
+	if err := s.db.WithContext(ctx).
+		Where("(family_id = ? OR id = ?) AND (revoked_at IS NULL OR issued_at > ?)", familyID, familyID, now.Add(-s.cfg.AccessTokenTTL)).
+		Find(&tokens).Error; err != nil {
+		return err
+	}
+
+	if err := s.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("(family_id = ? OR id = ?) AND revoked_at IS NULL", familyID, familyID).
+		Update("revoked_at", now).Error; err != nil {
+		return err
+	}
+
+	for _, token := range tokens {
+		if err := s.revokeAccessToken(ctx, userID, token.AccessTokenID, token.IssuedAt, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AuthService) recordSecurityEvent(ctx context.Context, event models.SecurityEvent) error {
+	return s.db.WithContext(ctx).Create(&event).Error
+}
+
+// familyOf returns the token family, treating tokens issued before families
+// existed as the root of their own.
+func familyOf(token models.RefreshToken) uuid.UUID {
+	if token.FamilyID == uuid.Nil {
+		return token.ID
+	}
+	return token.FamilyID
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/google/uuid"
+)
+
+const RevocationReasonSessionRevoked = "session_revoked"
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session is one login, represented by the live token of a refresh family
+type Session struct {
+	ID           string
+	Device       string
+	UserAgent    string
+	IPAddress    string
+	CreatedAt    time.Time
+	LastActiveAt time.Time
+	ExpiresAt    time.Time
+	Current      bool
+}
+
+// ListSessions returns the user's active sessions, most recently used first.
+// currentSessionID flags the session making the request.
+func (s *AuthService) ListSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) ([]Session, error) {
+	var tokens []models.RefreshToken
+	if err := s.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now().UTC()).
+		Order("issued_at DESC").
+		Find(&tokens).Error; err != nil {
+		return nil, err
+	}
+
+	sessions := make([]Session, 0, len(tokens))
+	for _, token := range tokens {
+		session := Session{
+			ID:           familyOf(token).String(),
+			Device:       token.Device,
+			UserAgent:    token.UserAgent,
+			IPAddress:    token.IPAddress,
+			CreatedAt:    token.IssuedAt,
+			LastActiveAt: token.IssuedAt,
+			ExpiresAt:    token.ExpiresAt,
+		}
+		if token.SessionStartedAt != nil {
+			session.CreatedAt = *token.SessionStartedAt
+		}
+		session.Current = currentSessionID != "" && session.ID == currentSessionID
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// RevokeSession logs out one of the user's sessions, including its access
+// token.
+func (s *AuthService) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	familyID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return ErrSessionNotFound
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).
+		Model(&models.RefreshToken{}).
+		Where("user_id = ? AND (family_id = ? OR id = ?) AND revoked_at IS NULL", userID, familyID, familyID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrSessionNotFound
+	}
+
+	return s.revokeRefreshFamily(ctx, userID, familyID, RevocationReasonSessionRevoked)
+}
+
+// RevokeOtherSessions logs out every session except currentSessionID and
+// returns how many were revoked.
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) (int, error) {
+	sessions, err := s.ListSessions(ctx, userID, currentSessionID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.Current {
+			continue
+		}
+		if err := s.revokeRefreshFamily(ctx, userID, uuid.MustParse(session.ID), RevocationReasonSessionRevoked); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// deviceFromUserAgent builds a short "Browser on OS" label for display. It is
+// deliberately rough; the raw user agent is stored next to it.
+func deviceFromUserAgent(userAgent string) string {
+	if userAgent == "" {
+		return ""
+	}
+	ua := strings.ToLower(userAgent)
+
+	browser := "Unknown browser"
+	switch {
+	case strings.Contains(ua, "edg/"):
+		browser = "Edge"
+	case strings.Contains(ua, "opr/"), strings.Contains(ua, "opera"):
+		browser = "Opera"
+	case strings.Contains(ua, "firefox/"):
+		browser = "Firefox"
+	case strings.Contains(ua, "chrome/"), strings.Contains(ua, "crios/"):
+		browser = "Chrome"
+	case strings.Contains(ua, "safari/"):
+		browser = "Safari"
+	case strings.Contains(ua, "curl/"):
+		browser = "curl"
+	}
+
+	platform := ""
+	switch {
+	case strings.Contains(ua, "iphone"), strings.Contains(ua, "ipad"):
+		platform = "iOS"
+	case strings.Contains(ua, "android"):
+		platform = "Android"
+	case strings.Contains(ua, "windows"):
+		platform = "Windows"
+	case strings.Contains(ua, "mac os"), strings.Contains(ua, "macintosh"):
+		platform = "macOS"
+	case strings.Contains(ua, "linux"):
+		platform = "Linux"
+	}
+
+	if platform == "" {
+		return browser
+	}
+	return browser + " on " + platform
+}
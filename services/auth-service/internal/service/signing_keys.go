@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aliirah/task-flow/services/auth-service/internal/models"
+	"github.com/aliirah/task-flow/shared/jwks"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	rsaKeyBits = 2048
+	// keyRotationLock serialises rotation across auth-service replicas
+	keyRotationLock = 0x7466_6b65_7973
+	// unknownKidReloadInterval limits reloads triggered by tokens with an unknown kid
+	unknownKidReloadInterval = 30 * time.Second
+)
+
+var ErrUnknownSigningKey = errors.New("unknown signing key")
+
+type signingKey struct {
+	kid       string
+	alg       string
+	private   crypto.PrivateKey
+	public    crypto.PublicKey
+	createdAt time.Time
+	retired   bool
+}
+
+func (k *signingKey) method() jwt.SigningMethod {
+	if k.alg == jwks.AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// keyManager keeps the signing key set in memory. Keys live in the database
+// so every replica signs with the same key and publishes the same JWKS.
+type keyManager struct {
+	db       *gorm.DB
+	alg      string
+	rotation time.Duration
+	overlap  time.Duration
+
+	mu       sync.RWMutex
+	active   *signingKey
+	keys     map[string]*signingKey
+	loadedAt time.Time
+}
+
+func newKeyManager(db *gorm.DB, alg string, rotation, overlap time.Duration) *keyManager {
+	return &keyManager{
+		db:       db,
+		alg:      alg,
+		rotation: rotation,
+		overlap:  overlap,
+		keys:     make(map[string]*signingKey),
+	}
+}
+
+// load replaces the in-memory key set with the unexpired keys from the database
+func (m *keyManager) load(ctx context.Context) error {
+	var records []models.SigningKey
+	if err := m.db.WithContext(ctx).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now().UTC()).
+		Order("created_at DESC").
+		Find(&records).Error; err != nil {
+		return err
+	}
+
+	keys := make(map[string]*signingKey, len(records))
+	var active *signingKey
+	for _, record := range records {
+		key, err := decodeSigningKey(record)
+		if err != nil {
+			log.S().Errorw("skipping unreadable signing key", "error", err, "kid", record.Kid)
+			continue
+		}
+		keys[key.kid] = key
+		if active == nil && !key.retired {
+			active = key
+		}
+	}
+
+	m.mu.Lock()
+	m.keys = keys
+	m.active = active
+	m.loadedAt = time.Now()
+	m.mu.Unlock()
+	return nil
+}
+
+// rotateIfDue creates a new signing key when there is none, the current one
+// is older than the rotation interval or the configured algorithm changed.
+// The previous key is retired but stays valid for the overlap period.
+func (m *keyManager) rotateIfDue(ctx context.Context) error {
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", keyRotationLock).Error; err != nil {
+			return err
+		}
+
+		var current models.SigningKey
+		err := tx.Where("retired_at IS NULL").Order("created_at DESC").First(&current).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		now := time.Now().UTC()
+		if err == nil && current.Algorithm == m.alg && now.Before(current.CreatedAt.Add(m.rotation)) {
+			return nil
+		}
+
+		record, err := generateSigningKey(m.alg)
+		if err != nil {
+			return err
+		}
+
+		expiresAt := now.Add(m.overlap)
+		if err := tx.Model(&models.SigningKey{}).
+			Where("retired_at IS NULL").
+			Updates(map[string]interface{}{"retired_at": now, "expires_at": expiresAt}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(record).Error; err != nil {
+			return err
+		}
+		log.S().Infow("rotated token signing key", "kid", record.Kid, "algorithm", record.Algorithm)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("rotate signing key: %w", err)
+	}
+	return m.load(ctx)
+}
+
+// signingKey returns the key new tokens are signed with
+func (m *keyManager) signingKey(ctx context.Context) (*signingKey, error) {
+	m.mu.RLock()
+	active := m.active
+	m.mu.RUnlock()
+	if active != nil {
+		return active, nil
+	}
+
+	if err := m.rotateIfDue(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.active == nil {
+		return nil, ErrUnknownSigningKey
+	}
+	return m.active, nil
+}
+
+// verificationKey looks a key up by kid, reloading once in a while so keys
+// created by another replica are picked up.
+func (m *keyManager) verificationKey(ctx context.Context, kid string) (*signingKey, error) {
+	m.mu.RLock()
+	key, ok := m.keys[kid]
+	stale := time.Since(m.loadedAt) > unknownKidReloadInterval
+	m.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+	if !stale {
+		return nil, ErrUnknownSigningKey
+	}
+
+	if err := m.load(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if key, ok := m.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, ErrUnknownSigningKey
+}
+
+// jwks returns the public half of every unexpired key, newest first
+func (m *keyManager) jwks() (jwks.Set, error) {
+	m.mu.RLock()
+	keys := make([]*signingKey, 0, len(m.keys))
+	for _, key := range m.keys {
+		keys = append(keys, key)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].createdAt.After(keys[j].createdAt) })
+
+	set := jwks.Set{Keys: make([]jwks.Key, 0, len(keys))}
+	for _, key := range keys {
+		jwk, err := jwks.NewKey(key.kid, key.alg, key.public)
+		if err != nil {
+			return jwks.Set{}, err
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
+// purgeExpired removes retired keys whose overlap period is over
+func (m *keyManager) purgeExpired(ctx context.Context) error {
+	return m.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at <= ?", time.Now().UTC()).
+		Delete(&models.SigningKey{}).Error
+}
+
+func generateSigningKey(alg string) (*models.SigningKey, error) {
+	var (
+		private crypto.PrivateKey
+		public  crypto.PublicKey
+	)
+	switch alg {
+	case jwks.AlgEdDSA:
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		private, public = priv, pub
+	case jwks.AlgRS256:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		private, public = priv, &priv.PublicKey
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", alg)
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return nil, err
+	}
+
+	kid := make([]byte, 8)
+	if _, err := rand.Read(kid); err != nil {
+		return nil, err
+	}
+
+	return &models.SigningKey{
+		Kid:        hex.EncodeToString(kid),
+		Algorithm:  alg,
+		PrivateKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}),
+		PublicKey:  pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}),
+		CreatedAt:  time.Now().UTC(),
+	}, nil
+}
+
+func decodeSigningKey(record models.SigningKey) (*signingKey, error) {
+	privateBlock, _ := pem.Decode(record.PrivateKey)
+	if privateBlock == nil {
+		return nil, errors.New("invalid private key pem")
+	}
+	private, err := x509.ParsePKCS8PrivateKey(privateBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	publicBlock, _ := pem.Decode(record.PublicKey)
+	if publicBlock == nil {
+		return nil, errors.New("invalid public key pem")
+	}
+	public, err := x509.ParsePKIXPublicKey(publicBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingKey{
+		kid:       record.Kid,
+		alg:       record.Algorithm,
+		private:   private,
+		public:    public,
+		createdAt: record.CreatedAt,
+		retired:   record.RetiredAt != nil,
+	}, nil
+}
@@ -139,6 +139,8 @@ func (c *NotificationConsumer) buildNotification(event *contracts.NotificationEv
 		return c.buildCommentDeletedNotification(notification, event)
 	case contracts.NotificationEventCommentMentioned:
 		return c.buildCommentMentionedNotification(notification, event)
+	case contracts.NotificationEventOrganizationInvited:
+		return c.buildOrganizationInvitedNotification(notification, event)
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", event.EventType)
 	}
@@ -358,6 +360,37 @@ func (c *NotificationConsumer) buildCommentMentionedNotification(n *models.Notif
 	return n, nil
 }
 
+func (c *NotificationConsumer) buildOrganizationInvitedNotification(n *models.Notification, event *contracts.NotificationEvent) (*models.Notification, error) {
+	data, ok := event.Data.(map[string]interface{})
+	if !ok {
+		dataBytes, _ := json.Marshal(event.Data)
+		if err := json.Unmarshal(dataBytes, &data); err != nil {
+			return nil, fmt.Errorf("invalid invitation data: %w", err)
+		}
+	}
+
+	invitationID, _ := data["invitationId"].(string)
+	organizationName, _ := data["organizationName"].(string)
+	role, _ := data["role"].(string)
+	inviter, _ := data["inviter"].(map[string]interface{})
+	inviterName := "Someone"
+	if inviter != nil {
+		firstName, _ := inviter["firstName"].(string)
+		lastName, _ := inviter["lastName"].(string)
+		inviterName = fmt.Sprintf("%s %s", firstName, lastName)
+	}
+
+	entityID, _ := uuid.Parse(invitationID)
+	n.Type = models.NotificationTypeOrganizationInvited
+	n.EntityType = "invitation"
+	n.EntityID = entityID
+	n.Title = "Organization invitation"
+	n.Message = fmt.Sprintf("%s invited you to join %s as %s", inviterName, organizationName, role)
+	n.URL = "/dashboard/invitations"
+
+	return n, nil
+}
+
 // publishNotificationCreated publishes a notification.created event for WebSocket distribution
 func (c *NotificationConsumer) publishNotificationCreated(n *models.Notification) error {
 	// Create WebSocket distribution message
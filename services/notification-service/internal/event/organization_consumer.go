@@ -0,0 +1,57 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aliirah/task-flow/services/notification-service/internal/service"
+	"github.com/aliirah/task-flow/shared/contracts"
+	"github.com/aliirah/task-flow/shared/messaging"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// OrganizationConsumer removes the notifications of deleted organizations
+type OrganizationConsumer struct {
+	rmq     *messaging.RabbitMQ
+	service service.NotificationService
+}
+
+func NewOrganizationConsumer(rmq *messaging.RabbitMQ, svc service.NotificationService) *OrganizationConsumer {
+	return &OrganizationConsumer{
+		rmq:     rmq,
+		service: svc,
+	}
+}
+
+func (c *OrganizationConsumer) Listen() error {
+	if c.rmq == nil || c.rmq.Channel == nil {
+		return fmt.Errorf("rabbitmq connection not initialized")
+	}
+	return c.rmq.ConsumeMessages(messaging.OrganizationNotificationEventsQueue, c.handleMessage)
+}
+
+func (c *OrganizationConsumer) handleMessage(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal organization event: %w", err)
+	}
+	if amqpMsg.EventType != contracts.OrganizationEventDeleted {
+		return nil
+	}
+
+	organizationID, err := uuid.Parse(amqpMsg.OrganizationID)
+	if err != nil {
+		log.Printf("Skipping organization event with invalid id %q", amqpMsg.OrganizationID)
+		return nil
+	}
+
+	deleted, err := c.service.DeleteOrganizationNotifications(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	log.Printf("Deleted %d notifications of organization %s", deleted, organizationID)
+	return nil
+}
@@ -12,13 +12,14 @@ import (
 type NotificationType string
 
 const (
-	NotificationTypeTaskCreated      NotificationType = "task_created"
-	NotificationTypeTaskUpdated      NotificationType = "task_updated"
-	NotificationTypeTaskDeleted      NotificationType = "task_deleted"
-	NotificationTypeCommentCreated   NotificationType = "comment_created"
-	NotificationTypeCommentUpdated   NotificationType = "comment_updated"
-	NotificationTypeCommentDeleted   NotificationType = "comment_deleted"
-	NotificationTypeCommentMentioned NotificationType = "comment_mentioned"
+	NotificationTypeTaskCreated         NotificationType = "task_created"
+	NotificationTypeTaskUpdated         NotificationType = "task_updated"
+	NotificationTypeTaskDeleted         NotificationType = "task_deleted"
+	NotificationTypeCommentCreated      NotificationType = "comment_created"
+	NotificationTypeCommentUpdated      NotificationType = "comment_updated"
+	NotificationTypeCommentDeleted      NotificationType = "comment_deleted"
+	NotificationTypeCommentMentioned    NotificationType = "comment_mentioned"
+	NotificationTypeOrganizationInvited NotificationType = "organization_invited"
 )
 
 // Notification represents a user notification
@@ -28,7 +29,7 @@ type Notification struct {
 	OrganizationID uuid.UUID        `gorm:"type:uuid;not null;index"`
 	TriggerUserID  uuid.UUID        `gorm:"type:uuid;not null"` // Who triggered the action
 	Type           NotificationType `gorm:"type:varchar(50);not null;index"`
-	EntityType     string           `gorm:"type:varchar(50);not null"` // task, comment
+	EntityType     string           `gorm:"type:varchar(50);not null"` // task, comment, invitation
 	EntityID       uuid.UUID        `gorm:"type:uuid;not null"`
 	Title          string           `gorm:"type:varchar(255);not null"`
 	Message        string           `gorm:"type:text"`
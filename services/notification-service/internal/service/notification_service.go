@@ -23,6 +23,7 @@ type NotificationService interface {
 	MarkAllAsRead(ctx context.Context, userID uuid.UUID) (int64, error)
 	DeleteNotification(ctx context.Context, id, userID uuid.UUID) error
 	GetUnreadCount(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteOrganizationNotifications(ctx context.Context, organizationID uuid.UUID) (int64, error)
 }
 
 type notificationService struct {
@@ -116,3 +117,11 @@ func (s *notificationService) GetUnreadCount(ctx context.Context, userID uuid.UU
 
 	return count, err
 }
+
+func (s *notificationService) DeleteOrganizationNotifications(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	result := s.db.WithContext(ctx).
+		Where("organization_id = ?", organizationID).
+		Delete(&models.Notification{})
+
+	return result.RowsAffected, result.Error
+}
@@ -84,6 +84,11 @@ func run() error {
 		}
 	}()
 
+	// Remove notifications of deleted organizations
+	if err := event.NewOrganizationConsumer(rabbitMQ, svc).Listen(); err != nil {
+		log.Fatalf("Failed to start organization consumer: %v", err)
+	}
+
 	// Handle graceful shutdown
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
@@ -0,0 +1,191 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/contracts"
+	"github.com/aliirah/task-flow/shared/messaging"
+)
+
+// OrganizationEventPublisher describes the behaviour required to broadcast
+// organization and membership events.
+type OrganizationEventPublisher interface {
+	OrganizationCreated(ctx context.Context, org *models.Organization, triggeredByID string) error
+	OrganizationUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error
+	OrganizationDeleted(ctx context.Context, org *models.Organization) error
+	MemberAdded(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error
+	MemberRemoved(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error
+	MemberRoleChanged(ctx context.Context, member *models.OrganizationMember, previousRole, triggeredByID string) error
+	MemberStatusChanged(ctx context.Context, member *models.OrganizationMember, previousStatus, triggeredByID string) error
+	SettingsUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error
+}
+
+// NewOrganizationPublisher builds a RabbitMQ-backed OrganizationEventPublisher
+func NewOrganizationPublisher(mq *messaging.RabbitMQ) OrganizationEventPublisher {
+	if mq == nil {
+		return noopOrganizationPublisher{}
+	}
+	return &organizationPublisher{mq: mq}
+}
+
+type organizationPublisher struct {
+	mq *messaging.RabbitMQ
+}
+
+type noopOrganizationPublisher struct{}
+
+func (noopOrganizationPublisher) OrganizationCreated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) OrganizationUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) OrganizationDeleted(ctx context.Context, org *models.Organization) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) MemberAdded(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) MemberRemoved(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) MemberRoleChanged(ctx context.Context, member *models.OrganizationMember, previousRole, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) MemberStatusChanged(ctx context.Context, member *models.OrganizationMember, previousStatus, triggeredByID string) error {
+	return nil
+}
+
+func (noopOrganizationPublisher) SettingsUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	return nil
+}
+
+func (p *organizationPublisher) OrganizationCreated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	if org == nil {
+		return nil
+	}
+	return p.publish(ctx, org.ID.String(), contracts.OrganizationEventCreated, organizationEvent(org, triggeredByID))
+}
+
+func (p *organizationPublisher) OrganizationUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	if org == nil {
+		return nil
+	}
+	return p.publish(ctx, org.ID.String(), contracts.OrganizationEventUpdated, organizationEvent(org, triggeredByID))
+}
+
+func (p *organizationPublisher) OrganizationDeleted(ctx context.Context, org *models.Organization) error {
+	if org == nil {
+		return nil
+	}
+
+	eventData := contracts.OrganizationDeletedEvent{
+		OrganizationID: org.ID.String(),
+		Name:           org.Name,
+		OwnerID:        org.OwnerID.String(),
+		DeletedAt:      time.Now().UTC().Format(time.RFC3339),
+	}
+	if org.DeletionRequestedBy != nil {
+		eventData.RequestedByID = org.DeletionRequestedBy.String()
+	}
+	if org.DeletionRequestedAt != nil {
+		eventData.RequestedAt = org.DeletionRequestedAt.Format(time.RFC3339)
+	}
+
+	return p.publish(ctx, org.ID.String(), contracts.OrganizationEventDeleted, eventData)
+}
+
+func (p *organizationPublisher) MemberAdded(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error {
+	if member == nil {
+		return nil
+	}
+	return p.publish(ctx, member.OrganizationID.String(), contracts.OrganizationEventMemberAdded, memberEvent(member, "", triggeredByID))
+}
+
+func (p *organizationPublisher) MemberRemoved(ctx context.Context, member *models.OrganizationMember, triggeredByID string) error {
+	if member == nil {
+		return nil
+	}
+	return p.publish(ctx, member.OrganizationID.String(), contracts.OrganizationEventMemberRemoved, memberEvent(member, "", triggeredByID))
+}
+
+func (p *organizationPublisher) MemberRoleChanged(ctx context.Context, member *models.OrganizationMember, previousRole, triggeredByID string) error {
+	if member == nil {
+		return nil
+	}
+	return p.publish(ctx, member.OrganizationID.String(), contracts.OrganizationEventMemberRoleChanged, memberEvent(member, previousRole, triggeredByID))
+}
+
+func (p *organizationPublisher) MemberStatusChanged(ctx context.Context, member *models.OrganizationMember, previousStatus, triggeredByID string) error {
+	if member == nil {
+		return nil
+	}
+	eventData := memberEvent(member, "", triggeredByID)
+	eventData.PreviousStatus = previousStatus
+	return p.publish(ctx, member.OrganizationID.String(), contracts.OrganizationEventMemberStatusChanged, eventData)
+}
+
+func (p *organizationPublisher) SettingsUpdated(ctx context.Context, org *models.Organization, triggeredByID string) error {
+	if org == nil {
+		return nil
+	}
+	return p.publish(ctx, org.ID.String(), contracts.OrganizationEventSettingsUpdated, contracts.OrganizationSettingsUpdatedEvent{
+		OrganizationID: org.ID.String(),
+		Version:        org.SettingsVersion,
+		TriggeredByID:  triggeredByID,
+		UpdatedAt:      org.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+func (p *organizationPublisher) publish(ctx context.Context, orgID, eventType string, eventData any) error {
+	if p == nil || p.mq == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("marshal %s event: %w", eventType, err)
+	}
+
+	msg := contracts.AmqpMessage{
+		OrganizationID: orgID,
+		EventType:      eventType,
+		Data:           data,
+	}
+
+	return p.mq.PublishMessage(ctx, "organization."+orgID, msg)
+}
+
+func organizationEvent(org *models.Organization, triggeredByID string) contracts.OrganizationEvent {
+	return contracts.OrganizationEvent{
+		OrganizationID: org.ID.String(),
+		Name:           org.Name,
+		Description:    org.Description,
+		OwnerID:        org.OwnerID.String(),
+		TriggeredByID:  triggeredByID,
+		CreatedAt:      org.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      org.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func memberEvent(member *models.OrganizationMember, previousRole, triggeredByID string) contracts.OrganizationMemberEvent {
+	return contracts.OrganizationMemberEvent{
+		OrganizationID: member.OrganizationID.String(),
+		UserID:         member.UserID.String(),
+		Role:           member.Role,
+		PreviousRole:   previousRole,
+		Status:         member.Status,
+		TriggeredByID:  triggeredByID,
+		OccurredAt:     time.Now().UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,79 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// TaskUsageRecorder keeps the task counter of an organization
+type TaskUsageRecorder interface {
+	RecordTaskUsage(ctx context.Context, organizationID, taskID uuid.UUID, counted bool) error
+	MoveTaskUsage(ctx context.Context, organizationID, taskID uuid.UUID) error
+}
+
+// UsageConsumer counts the tasks of organizations from task events. Archived
+// tasks do not count, so purging them later changes nothing. Updates carry
+// the task's organization, so a task moved to another one is counted there.
+type UsageConsumer struct {
+	rmq      *messaging.RabbitMQ
+	recorder TaskUsageRecorder
+}
+
+func NewUsageConsumer(rmq *messaging.RabbitMQ, recorder TaskUsageRecorder) *UsageConsumer {
+	return &UsageConsumer{
+		rmq:      rmq,
+		recorder: recorder,
+	}
+}
+
+func (c *UsageConsumer) Listen() error {
+	if c.rmq == nil || c.rmq.Channel == nil {
+		return fmt.Errorf("rabbitmq connection not initialized")
+	}
+	return c.rmq.ConsumeMessages(messaging.TaskUsageEventsQueue, c.handleMessage)
+}
+
+func (c *UsageConsumer) handleMessage(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal task event: %w", err)
+	}
+
+	var counted bool
+	switch amqpMsg.EventType {
+	case contracts.TaskEventCreated, contracts.TaskEventRestored:
+		counted = true
+	case contracts.TaskEventArchived, contracts.TaskEventUpdated:
+	default:
+		return nil
+	}
+
+	organizationID, err := uuid.Parse(amqpMsg.OrganizationID)
+	if err != nil {
+		log.S().Warnw("skipping task event with invalid organization id", "organizationId", amqpMsg.OrganizationID)
+		return nil
+	}
+	// Every task event carries the task id
+	var event struct {
+		TaskID string `json:"taskId"`
+	}
+	if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+		return fmt.Errorf("failed to unmarshal task event data: %w", err)
+	}
+	taskID, err := uuid.Parse(event.TaskID)
+	if err != nil {
+		log.S().Warnw("skipping task event with invalid task id", "taskId", event.TaskID)
+		return nil
+	}
+	if amqpMsg.EventType == contracts.TaskEventUpdated {
+		return c.recorder.MoveTaskUsage(ctx, organizationID, taskID)
+	}
+	return c.recorder.RecordTaskUsage(ctx, organizationID, taskID, counted)
+}
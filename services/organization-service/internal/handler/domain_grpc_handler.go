@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/services/organization-service/internal/service"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *OrganizationHandler) AddDomain(ctx context.Context, req *organizationpb.AddDomainRequest) (*organizationpb.OrganizationDomain, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	record, err := h.svc.AddDomain(ctx, service.AddDomainInput{
+		OrganizationID: orgID,
+		Domain:         req.GetDomain(),
+		DefaultRole:    req.GetDefaultRole(),
+		AutoJoin:       req.GetAutoJoin(),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoDomain(record), nil
+}
+
+func (h *OrganizationHandler) ListDomains(ctx context.Context, req *organizationpb.ListDomainsRequest) (*organizationpb.ListDomainsResponse, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	records, err := h.svc.ListDomains(ctx, orgID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	items := make([]*organizationpb.OrganizationDomain, 0, len(records))
+	for i := range records {
+		items = append(items, toProtoDomain(&records[i]))
+	}
+	return &organizationpb.ListDomainsResponse{Items: items}, nil
+}
+
+func (h *OrganizationHandler) UpdateDomain(ctx context.Context, req *organizationpb.UpdateDomainRequest) (*organizationpb.OrganizationDomain, error) {
+	orgID, id, err := parseDomainRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var input service.UpdateDomainInput
+	if req.GetDefaultRole() != nil {
+		role := req.GetDefaultRole().GetValue()
+		input.DefaultRole = &role
+	}
+	if req.GetAutoJoin() != nil {
+		autoJoin := req.GetAutoJoin().GetValue()
+		input.AutoJoin = &autoJoin
+	}
+
+	record, err := h.svc.UpdateDomain(ctx, orgID, id, input)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoDomain(record), nil
+}
+
+func (h *OrganizationHandler) VerifyDomain(ctx context.Context, req *organizationpb.DomainRequest) (*organizationpb.OrganizationDomain, error) {
+	orgID, id, err := parseDomainRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	record, err := h.svc.VerifyDomain(ctx, orgID, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoDomain(record), nil
+}
+
+func (h *OrganizationHandler) RemoveDomain(ctx context.Context, req *organizationpb.DomainRequest) (*emptypb.Empty, error) {
+	orgID, id, err := parseDomainRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.RemoveDomain(ctx, orgID, id); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OrganizationHandler) ClaimDomainMemberships(ctx context.Context, req *organizationpb.ClaimInvitationsRequest) (*organizationpb.ClaimDomainMembershipsResponse, error) {
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	memberships, offers, err := h.svc.ClaimDomainMemberships(ctx, userID, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &organizationpb.ClaimDomainMembershipsResponse{
+		Memberships: make([]*organizationpb.OrganizationMember, 0, len(memberships)),
+		Offers:      make([]*organizationpb.Invitation, 0, len(offers)),
+	}
+	for i := range memberships {
+		resp.Memberships = append(resp.Memberships, toProtoMember(&memberships[i]))
+	}
+	for i := range offers {
+		resp.Offers = append(resp.Offers, toProtoInvitation(&offers[i]))
+	}
+	return resp, nil
+}
+
+func parseDomainRequest(rawOrgID, rawID string) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := parseUUID(rawOrgID)
+	if err != nil || orgID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	id, err := parseUUID(rawID)
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid domain id")
+	}
+	return orgID, id, nil
+}
+
+func toProtoDomain(record *models.OrganizationDomain) *organizationpb.OrganizationDomain {
+	resp := &organizationpb.OrganizationDomain{
+		Id:                 record.ID.String(),
+		OrganizationId:     record.OrganizationID.String(),
+		Domain:             record.Domain,
+		DefaultRole:        record.DefaultRole,
+		AutoJoin:           record.AutoJoin,
+		VerificationRecord: service.DomainVerificationRecord(record.Domain),
+		VerificationValue:  service.DomainVerificationValue(record.VerificationToken),
+		CreatedAt:          timestamppb.New(record.CreatedAt),
+	}
+	if record.VerifiedAt != nil {
+		resp.VerifiedAt = timestamppb.New(*record.VerifiedAt)
+	}
+	return resp
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/services/organization-service/internal/service"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *OrganizationHandler) CreateInvitation(ctx context.Context, req *organizationpb.CreateInvitationRequest) (*organizationpb.Invitation, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	invitation, err := h.svc.CreateInvitation(ctx, service.CreateInvitationInput{
+		OrganizationID: orgID,
+		Email:          req.GetEmail(),
+		Role:           req.GetRole(),
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoInvitation(invitation), nil
+}
+
+func (h *OrganizationHandler) ListInvitations(ctx context.Context, req *organizationpb.ListInvitationsRequest) (*organizationpb.ListInvitationsResponse, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	invitations, err := h.svc.ListInvitations(ctx, orgID, req.GetStatus())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoInvitations(invitations), nil
+}
+
+func (h *OrganizationHandler) ResendInvitation(ctx context.Context, req *organizationpb.InvitationRequest) (*organizationpb.Invitation, error) {
+	orgID, id, err := parseInvitationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	invitation, err := h.svc.ResendInvitation(ctx, orgID, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoInvitation(invitation), nil
+}
+
+func (h *OrganizationHandler) RevokeInvitation(ctx context.Context, req *organizationpb.InvitationRequest) (*emptypb.Empty, error) {
+	orgID, id, err := parseInvitationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.RevokeInvitation(ctx, orgID, id); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OrganizationHandler) ListUserInvitations(ctx context.Context, req *organizationpb.ListUserInvitationsRequest) (*organizationpb.ListInvitationsResponse, error) {
+	invitations, err := h.svc.ListUserInvitations(ctx, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoInvitations(invitations), nil
+}
+
+func (h *OrganizationHandler) AcceptInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) (*organizationpb.OrganizationMember, error) {
+	input, err := parseRespondInvitationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	member, err := h.svc.AcceptInvitation(ctx, input)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoMember(member), nil
+}
+
+func (h *OrganizationHandler) DeclineInvitation(ctx context.Context, req *organizationpb.RespondInvitationRequest) (*emptypb.Empty, error) {
+	input, err := parseRespondInvitationRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.DeclineInvitation(ctx, input); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OrganizationHandler) ClaimInvitations(ctx context.Context, req *organizationpb.ClaimInvitationsRequest) (*organizationpb.ClaimInvitationsResponse, error) {
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	memberships, err := h.svc.ClaimInvitations(ctx, userID, req.GetEmail())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	items := make([]*organizationpb.OrganizationMember, 0, len(memberships))
+	for _, member := range memberships {
+		items = append(items, toProtoMember(&member))
+	}
+	return &organizationpb.ClaimInvitationsResponse{Memberships: items}, nil
+}
+
+func parseInvitationRequest(req *organizationpb.InvitationRequest) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid invitation id")
+	}
+	return orgID, id, nil
+}
+
+func parseRespondInvitationRequest(req *organizationpb.RespondInvitationRequest) (service.RespondInvitationInput, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil {
+		return service.RespondInvitationInput{}, status.Error(codes.InvalidArgument, "invalid invitation id")
+	}
+	if id == uuid.Nil && req.GetToken() == "" {
+		return service.RespondInvitationInput{}, status.Error(codes.InvalidArgument, "invitation id or token is required")
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return service.RespondInvitationInput{}, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return service.RespondInvitationInput{
+		ID:     id,
+		Token:  req.GetToken(),
+		UserID: userID,
+		Email:  req.GetEmail(),
+	}, nil
+}
+
+func toProtoInvitations(invitations []models.Invitation) *organizationpb.ListInvitationsResponse {
+	items := make([]*organizationpb.Invitation, 0, len(invitations))
+	for i := range invitations {
+		items = append(items, toProtoInvitation(&invitations[i]))
+	}
+	return &organizationpb.ListInvitationsResponse{Items: items}
+}
+
+func toProtoInvitation(invitation *models.Invitation) *organizationpb.Invitation {
+	resp := &organizationpb.Invitation{
+		Id:               invitation.ID.String(),
+		OrganizationId:   invitation.OrganizationID.String(),
+		OrganizationName: invitation.Organization.Name,
+		Email:            invitation.Email,
+		Role:             invitation.Role,
+		Status:           invitation.EffectiveStatus(time.Now().UTC()),
+		Domain:           invitation.Domain,
+		ExpiresAt:        timestamppb.New(invitation.ExpiresAt),
+		CreatedAt:        timestamppb.New(invitation.CreatedAt),
+	}
+	if invitation.InviterID != uuid.Nil {
+		resp.InviterId = invitation.InviterID.String()
+	}
+	if invitation.RespondedAt != nil {
+		resp.RespondedAt = timestamppb.New(*invitation.RespondedAt)
+	}
+	return resp
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *OrganizationHandler) SuspendMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	return h.changeMemberStatus(ctx, req, h.svc.SuspendMember)
+}
+
+func (h *OrganizationHandler) ReactivateMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	return h.changeMemberStatus(ctx, req, h.svc.ReactivateMember)
+}
+
+func (h *OrganizationHandler) ApproveMember(ctx context.Context, req *organizationpb.MemberStatusRequest) (*organizationpb.OrganizationMember, error) {
+	return h.changeMemberStatus(ctx, req, h.svc.ApproveMember)
+}
+
+func (h *OrganizationHandler) LeaveOrganization(ctx context.Context, req *organizationpb.LeaveOrganizationRequest) (*emptypb.Empty, error) {
+	orgID, userID, err := parseMembershipRequest(req.GetOrganizationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.LeaveOrganization(ctx, orgID, userID); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+type memberStatusChange func(ctx context.Context, organizationID, userID uuid.UUID) (*models.OrganizationMember, error)
+
+func (h *OrganizationHandler) changeMemberStatus(ctx context.Context, req *organizationpb.MemberStatusRequest, change memberStatusChange) (*organizationpb.OrganizationMember, error) {
+	orgID, userID, err := parseMembershipRequest(req.GetOrganizationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	member, err := change(ctx, orgID, userID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoMember(member), nil
+}
+
+func parseMembershipRequest(rawOrgID, rawUserID string) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := parseUUID(rawOrgID)
+	if err != nil || orgID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	userID, err := parseUUID(rawUserID)
+	if err != nil || userID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return orgID, userID, nil
+}
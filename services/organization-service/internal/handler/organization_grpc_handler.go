@@ -2,16 +2,19 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/aliirah/task-flow/services/organization-service/internal/models"
 	"github.com/aliirah/task-flow/services/organization-service/internal/service"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type OrganizationHandler struct {
@@ -122,28 +125,71 @@ func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, req *organ
 		descPtr = &desc
 	}
 
+	var requireVerifiedPtr *bool
+	if req.GetRequireVerifiedEmail() != nil {
+		requireVerified := req.GetRequireVerifiedEmail().GetValue()
+		requireVerifiedPtr = &requireVerified
+	}
+
+	var requireTwoFactorPtr *bool
+	if req.GetRequireTwoFactor() != nil {
+		requireTwoFactor := req.GetRequireTwoFactor().GetValue()
+		requireTwoFactorPtr = &requireTwoFactor
+	}
+
 	org, err := h.svc.UpdateOrganization(ctx, id, service.UpdateOrganizationInput{
-		Name:        namePtr,
-		Description: descPtr,
+		Name:                 namePtr,
+		Description:          descPtr,
+		RequireVerifiedEmail: requireVerifiedPtr,
+		RequireTwoFactor:     requireTwoFactorPtr,
+		PasswordMinLength:    int32Ptr(req.GetPasswordMinLength()),
+		PasswordMinClasses:   int32Ptr(req.GetPasswordMinClasses()),
+		PasswordHistory:      int32Ptr(req.GetPasswordHistory()),
 	})
 	if err != nil {
-		if err == service.ErrOrganizationNotFound {
-			return nil, status.Error(codes.NotFound, err.Error())
-		}
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapError(err)
 	}
 	return toProtoOrganization(org), nil
 }
 
-func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) (*emptypb.Empty, error) {
+func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, req *organizationpb.DeleteOrganizationRequest) (*organizationpb.Organization, error) {
 	id, err := parseUUID(req.GetId())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
 	}
-	if err := h.svc.DeleteOrganization(ctx, id); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	org, err := h.svc.DeleteOrganization(ctx, id, req.GetConfirmName())
+	if err != nil {
+		return nil, mapError(err)
 	}
-	return &emptypb.Empty{}, nil
+	return toProtoOrganization(org), nil
+}
+
+func (h *OrganizationHandler) CancelOrganizationDeletion(ctx context.Context, req *organizationpb.CancelOrganizationDeletionRequest) (*organizationpb.Organization, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	org, err := h.svc.CancelOrganizationDeletion(ctx, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoOrganization(org), nil
+}
+
+func (h *OrganizationHandler) TransferOwnership(ctx context.Context, req *organizationpb.TransferOwnershipRequest) (*organizationpb.Organization, error) {
+	id, err := parseUUID(req.GetOrganizationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	newOwnerID, err := parseUUID(req.GetNewOwnerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	org, err := h.svc.TransferOwnership(ctx, id, newOwnerID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoOrganization(org), nil
 }
 
 func (h *OrganizationHandler) AddMember(ctx context.Context, req *organizationpb.AddMemberRequest) (*organizationpb.OrganizationMember, error) {
@@ -161,7 +207,7 @@ func (h *OrganizationHandler) AddMember(ctx context.Context, req *organizationpb
 		Role:           req.GetRole(),
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapError(err)
 	}
 	return toProtoMember(member), nil
 }
@@ -176,7 +222,7 @@ func (h *OrganizationHandler) RemoveMember(ctx context.Context, req *organizatio
 		return nil, status.Error(codes.InvalidArgument, "invalid user id")
 	}
 	if err := h.svc.RemoveMember(ctx, orgID, userID); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, mapError(err)
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -222,6 +268,105 @@ func (h *OrganizationHandler) ListUserMemberships(ctx context.Context, req *orga
 	return &organizationpb.ListUserMembershipsResponse{Memberships: items}, nil
 }
 
+func (h *OrganizationHandler) UpdateMemberRole(ctx context.Context, req *organizationpb.UpdateMemberRoleRequest) (*organizationpb.OrganizationMember, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	member, err := h.svc.UpdateMemberRole(ctx, orgID, userID, req.GetRole())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoMember(member), nil
+}
+
+func (h *OrganizationHandler) CheckPermission(ctx context.Context, req *organizationpb.CheckPermissionRequest) (*organizationpb.CheckPermissionResponse, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	member, allowed, err := h.svc.CheckPermission(ctx, orgID, userID, req.GetPermission())
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	resp := &organizationpb.CheckPermissionResponse{Allowed: allowed}
+	if member != nil {
+		resp.Member = member.Status == orgdomain.MemberStatusActive
+		resp.Role = member.Role
+		resp.Status = member.Status
+	}
+	return resp, nil
+}
+
+func mapError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrOrganizationNotFound),
+		errors.Is(err, service.ErrMemberNotFound),
+		errors.Is(err, service.ErrInvitationNotFound),
+		errors.Is(err, service.ErrTeamNotFound),
+		errors.Is(err, service.ErrTeamMemberNotFound),
+		errors.Is(err, service.ErrDomainNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidPasswordPolicy),
+		errors.Is(err, service.ErrInvalidRole),
+		errors.Is(err, service.ErrInvalidPermission),
+		errors.Is(err, service.ErrInvalidInvitationEmail),
+		errors.Is(err, service.ErrInvalidInvitationStatus),
+		errors.Is(err, service.ErrDeletionConfirmation),
+		errors.Is(err, service.ErrInvalidNewOwner),
+		errors.Is(err, service.ErrInvalidTeamName),
+		errors.Is(err, service.ErrTeamMemberNotInOrg),
+		errors.Is(err, service.ErrInvalidSettings),
+		errors.Is(err, service.ErrInvalidDomain),
+		errors.Is(err, service.ErrInvalidPlan):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPermissionDenied),
+		errors.Is(err, service.ErrAdminRoleForbidden),
+		errors.Is(err, service.ErrInvitationEmailMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrAlreadyMember),
+		errors.Is(err, service.ErrInvitationExists),
+		errors.Is(err, service.ErrTeamExists),
+		errors.Is(err, service.ErrDomainExists),
+		errors.Is(err, service.ErrDomainClaimed):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrOwnerRoleReserved),
+		errors.Is(err, service.ErrOwnerMembership),
+		errors.Is(err, service.ErrInvitationExpired),
+		errors.Is(err, service.ErrInvitationNotPending),
+		errors.Is(err, service.ErrEmailUnverified),
+		errors.Is(err, service.ErrOrganizationPendingDeletion),
+		errors.Is(err, service.ErrDeletionNotScheduled),
+		errors.Is(err, service.ErrInvalidMemberStatus),
+		errors.Is(err, service.ErrOwnerCannotLeave),
+		errors.Is(err, service.ErrDomainNotVerified),
+		errors.Is(err, service.ErrMemberLimitReached):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrSettingsVersionConflict):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// int32Ptr converts an optional wrapper, nil leaves the field unchanged
+func int32Ptr(value *wrapperspb.Int32Value) *int {
+	if value == nil {
+		return nil
+	}
+	v := int(value.GetValue())
+	return &v
+}
+
 func parseUUID(value string) (uuid.UUID, error) {
 	if value == "" {
 		return uuid.Nil, nil
@@ -230,14 +375,28 @@ func parseUUID(value string) (uuid.UUID, error) {
 }
 
 func toProtoOrganization(org *models.Organization) *organizationpb.Organization {
-	return &organizationpb.Organization{
+	resp := &organizationpb.Organization{
 		Id:          org.ID.String(),
 		Name:        org.Name,
 		Description: org.Description,
 		OwnerId:     org.OwnerID.String(),
 		CreatedAt:   timestamppb.New(org.CreatedAt),
 		UpdatedAt:   timestamppb.New(org.UpdatedAt),
+		Plan:        org.Plan,
+
+		RequireVerifiedEmail: org.RequireVerifiedEmail,
+		RequireTwoFactor:     org.RequireTwoFactor,
+		PasswordMinLength:    int32(org.PasswordMinLength),
+		PasswordMinClasses:   int32(org.PasswordMinClasses),
+		PasswordHistory:      int32(org.PasswordHistory),
+	}
+	if org.DeletionRequestedAt != nil {
+		resp.DeletionRequestedAt = timestamppb.New(*org.DeletionRequestedAt)
+	}
+	if org.DeleteAfter != nil {
+		resp.DeleteAfter = timestamppb.New(*org.DeleteAfter)
 	}
+	return resp
 }
 
 func toProtoMember(member *models.OrganizationMember) *organizationpb.OrganizationMember {
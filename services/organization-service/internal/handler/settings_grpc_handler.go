@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/orgsettings"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *OrganizationHandler) GetOrganizationSettings(ctx context.Context, req *organizationpb.GetOrganizationSettingsRequest) (*organizationpb.OrganizationSettings, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	org, err := h.svc.GetSettings(ctx, orgID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoSettings(org), nil
+}
+
+func (h *OrganizationHandler) UpdateOrganizationSettings(ctx context.Context, req *organizationpb.UpdateOrganizationSettingsRequest) (*organizationpb.OrganizationSettings, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	if req.GetSettings() == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings are required")
+	}
+	org, err := h.svc.UpdateSettings(ctx, orgID, orgsettings.FromProto(req.GetSettings()), req.GetExpectedVersion())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoSettings(org), nil
+}
+
+func toProtoSettings(org *models.Organization) *organizationpb.OrganizationSettings {
+	return orgsettings.ToProto(org.ID.String(), org.SettingsVersion, org.EffectiveSettings(), org.UpdatedAt)
+}
@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/services/organization-service/internal/service"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *OrganizationHandler) CreateTeam(ctx context.Context, req *organizationpb.CreateTeamRequest) (*organizationpb.Team, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	leadID, err := parseUUID(req.GetLeadId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid lead id")
+	}
+	memberIDs := make([]uuid.UUID, 0, len(req.GetMemberIds()))
+	for _, raw := range req.GetMemberIds() {
+		memberID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid member id")
+		}
+		memberIDs = append(memberIDs, memberID)
+	}
+
+	team, err := h.svc.CreateTeam(ctx, service.CreateTeamInput{
+		OrganizationID: orgID,
+		Name:           req.GetName(),
+		Description:    req.GetDescription(),
+		LeadID:         leadID,
+		MemberIDs:      memberIDs,
+	})
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoTeam(team), nil
+}
+
+func (h *OrganizationHandler) GetTeam(ctx context.Context, req *organizationpb.TeamRequest) (*organizationpb.Team, error) {
+	orgID, id, err := parseTeamRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	team, err := h.svc.GetTeam(ctx, orgID, id)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoTeam(team), nil
+}
+
+func (h *OrganizationHandler) ListTeams(ctx context.Context, req *organizationpb.ListTeamsRequest) (*organizationpb.ListTeamsResponse, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	teams, err := h.svc.ListTeams(ctx, orgID, userID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	items := make([]*organizationpb.Team, 0, len(teams))
+	for i := range teams {
+		items = append(items, toProtoTeam(&teams[i]))
+	}
+	return &organizationpb.ListTeamsResponse{Items: items}, nil
+}
+
+func (h *OrganizationHandler) UpdateTeam(ctx context.Context, req *organizationpb.UpdateTeamRequest) (*organizationpb.Team, error) {
+	orgID, id, err := parseTeamRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	var input service.UpdateTeamInput
+	if req.GetName() != nil {
+		name := req.GetName().GetValue()
+		input.Name = &name
+	}
+	if req.GetDescription() != nil {
+		description := req.GetDescription().GetValue()
+		input.Description = &description
+	}
+	if req.GetLeadId() != nil {
+		leadID, err := parseUUID(req.GetLeadId().GetValue())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid lead id")
+		}
+		input.LeadID = &leadID
+	}
+
+	team, err := h.svc.UpdateTeam(ctx, orgID, id, input)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoTeam(team), nil
+}
+
+func (h *OrganizationHandler) DeleteTeam(ctx context.Context, req *organizationpb.TeamRequest) (*emptypb.Empty, error) {
+	orgID, id, err := parseTeamRequest(req.GetOrganizationId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.svc.DeleteTeam(ctx, orgID, id); err != nil {
+		return nil, mapError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *OrganizationHandler) AddTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error) {
+	orgID, teamID, userID, err := parseTeamMemberRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	team, err := h.svc.AddTeamMember(ctx, orgID, teamID, userID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoTeam(team), nil
+}
+
+func (h *OrganizationHandler) RemoveTeamMember(ctx context.Context, req *organizationpb.TeamMemberRequest) (*organizationpb.Team, error) {
+	orgID, teamID, userID, err := parseTeamMemberRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	team, err := h.svc.RemoveTeamMember(ctx, orgID, teamID, userID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoTeam(team), nil
+}
+
+func parseTeamRequest(rawOrgID, rawID string) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := parseUUID(rawOrgID)
+	if err != nil || orgID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	id, err := parseUUID(rawID)
+	if err != nil || id == uuid.Nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid team id")
+	}
+	return orgID, id, nil
+}
+
+func parseTeamMemberRequest(req *organizationpb.TeamMemberRequest) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	orgID, teamID, err := parseTeamRequest(req.GetOrganizationId(), req.GetTeamId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	return orgID, teamID, userID, nil
+}
+
+func toProtoTeam(team *models.Team) *organizationpb.Team {
+	resp := &organizationpb.Team{
+		Id:             team.ID.String(),
+		OrganizationId: team.OrganizationID.String(),
+		Name:           team.Name,
+		Description:    team.Description,
+		CreatedAt:      timestamppb.New(team.CreatedAt),
+		UpdatedAt:      timestamppb.New(team.UpdatedAt),
+	}
+	if team.LeadID != nil {
+		resp.LeadId = team.LeadID.String()
+	}
+	for _, memberID := range team.MemberIDs() {
+		resp.MemberIds = append(resp.MemberIds, memberID.String())
+	}
+	return resp
+}
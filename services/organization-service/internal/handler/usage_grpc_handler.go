@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/service"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *OrganizationHandler) GetOrganizationUsage(ctx context.Context, req *organizationpb.GetOrganizationUsageRequest) (*organizationpb.OrganizationUsage, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	usage, err := h.svc.GetUsage(ctx, orgID)
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoUsage(usage), nil
+}
+
+func (h *OrganizationHandler) SetOrganizationPlan(ctx context.Context, req *organizationpb.SetOrganizationPlanRequest) (*organizationpb.OrganizationUsage, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+	usage, err := h.svc.SetPlan(ctx, orgID, req.GetPlan())
+	if err != nil {
+		return nil, mapError(err)
+	}
+	return toProtoUsage(usage), nil
+}
+
+func toProtoUsage(usage *service.Usage) *organizationpb.OrganizationUsage {
+	return &organizationpb.OrganizationUsage{
+		OrganizationId: usage.OrganizationID.String(),
+		Plan:           usage.Plan,
+		Limits: &organizationpb.OrganizationLimits{
+			MaxMembers:           usage.Limits.MaxMembers,
+			MaxTasks:             usage.Limits.MaxTasks,
+			MaxStorageBytes:      usage.Limits.MaxStorageBytes,
+			ApiRequestsPerMinute: usage.Limits.APIRequestsPerMinute,
+		},
+		Members:      usage.Members,
+		Tasks:        usage.Tasks,
+		StorageBytes: usage.StorageBytes,
+		UpdatedAt:    timestamppb.New(usage.UpdatedAt),
+	}
+}
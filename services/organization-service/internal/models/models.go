@@ -1,8 +1,12 @@
 package models
 
 import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -13,8 +17,72 @@ type Organization struct {
 	Description string
 	OwnerID     uuid.UUID            `gorm:"type:uuid;index"`
 	Members     []OrganizationMember `gorm:"constraint:OnDelete:CASCADE"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// RequireVerifiedEmail blocks login for members without a verified email
+	RequireVerifiedEmail bool `gorm:"not null;default:false"`
+	// RequireTwoFactor makes members enroll in and use TOTP to log in
+	RequireTwoFactor bool `gorm:"not null;default:false"`
+	// Password rules for members on top of the deployment policy; zero
+	// values defer to it
+	PasswordMinLength  int `gorm:"not null;default:0"`
+	PasswordMinClasses int `gorm:"not null;default:0"`
+	PasswordHistory    int `gorm:"not null;default:0"`
+	// Settings is the organization's policy document. Its
+	// security.requireTwoFactor mirrors RequireTwoFactor, which stays the
+	// source of truth.
+	Settings OrganizationSettings `gorm:"type:jsonb"`
+	// SettingsVersion is bumped on every settings change so readers can tell
+	// a stale copy
+	SettingsVersion int64 `gorm:"not null;default:0"`
+	// Plan sets the organization's limits
+	Plan string `gorm:"not null;default:free"`
+	// Set while a deletion is pending. The organization and its data in
+	// other services are purged after DeleteAfter unless it is cancelled.
+	DeletionRequestedAt *time.Time
+	DeletionRequestedBy *uuid.UUID `gorm:"type:uuid"`
+	DeleteAfter         *time.Time `gorm:"index"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// EffectiveSettings returns the organization's settings upgraded to the
+// current schema with defaults filled in
+func (o *Organization) EffectiveSettings() orgdomain.Settings {
+	settings := orgdomain.Settings(o.Settings)
+	settings.Normalize()
+	settings.Security.RequireTwoFactor = o.RequireTwoFactor
+	return settings
+}
+
+// OrganizationSettings is stored as a JSON document on the organization row
+type OrganizationSettings orgdomain.Settings
+
+func (s OrganizationSettings) Value() (driver.Value, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (s *OrganizationSettings) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*s = OrganizationSettings{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for OrganizationSettings: %T", value)
+	}
+	return json.Unmarshal(data, s)
+}
+
+// PendingDeletion reports whether a deletion has been requested
+func (o *Organization) PendingDeletion() bool {
+	return o.DeleteAfter != nil
 }
 
 func (o *Organization) BeforeCreate(tx *gorm.DB) error {
@@ -33,6 +101,15 @@ type OrganizationMember struct {
 	CreatedAt      time.Time
 }
 
+// MemberRemoval remembers that an admin removed the user, so a verified domain
+// doesn't add them back. Adding the user again clears it.
+type MemberRemoval struct {
+	OrganizationID uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	UserID         uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	RemovedBy      *uuid.UUID `gorm:"type:uuid"`
+	RemovedAt      time.Time
+}
+
 func (m *OrganizationMember) BeforeCreate(tx *gorm.DB) error {
 	if m.ID == uuid.Nil {
 		m.ID = uuid.New()
@@ -49,6 +126,151 @@ func (m *OrganizationMember) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// Invitation statuses. Pending invitations past ExpiresAt are reported as
+// expired without being rewritten.
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+	InvitationStatusRevoked  = "revoked"
+	InvitationStatusExpired  = "expired"
+)
+
+// Invitation asks someone, by email, to join an organization. Only the hash
+// of the emailed token is stored.
+type Invitation struct {
+	ID             uuid.UUID    `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID    `gorm:"type:uuid;not null;index"`
+	Organization   Organization `gorm:"constraint:OnDelete:CASCADE"`
+	Email          string       `gorm:"not null;index"`
+	Role           string       `gorm:"not null;default:member"`
+	InviterID      uuid.UUID    `gorm:"type:uuid"`
+	TokenHash      string       `gorm:"not null;uniqueIndex"`
+	Status         string       `gorm:"not null;default:pending;index"`
+	ExpiresAt      time.Time    `gorm:"not null"`
+	RespondedAt    *time.Time
+	// AcceptedBy is the user who accepted, set once the invitation is
+	// accepted
+	AcceptedBy *uuid.UUID `gorm:"type:uuid"`
+	// Domain is set on invitations offered because the user's verified email
+	// matches a domain of the organization
+	Domain    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (i *Invitation) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	if i.Status == "" {
+		i.Status = InvitationStatusPending
+	}
+	return nil
+}
+
+// EffectiveStatus reports a pending invitation past its expiry as expired
+func (i *Invitation) EffectiveStatus(now time.Time) string {
+	if i.Status == InvitationStatusPending && !now.Before(i.ExpiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.Status
+}
+
+// Team groups members of an organization. Names are unique per organization.
+type Team struct {
+	ID             uuid.UUID    `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_team_name"`
+	Organization   Organization `gorm:"constraint:OnDelete:CASCADE"`
+	Name           string       `gorm:"not null;uniqueIndex:idx_team_name"`
+	Description    string
+	// LeadID is a member of the team who can manage its membership
+	LeadID    *uuid.UUID   `gorm:"type:uuid"`
+	Members   []TeamMember `gorm:"constraint:OnDelete:CASCADE"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (t *Team) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
+
+// MemberIDs lists the ids of the loaded team members
+func (t *Team) MemberIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t.Members))
+	for _, member := range t.Members {
+		ids = append(ids, member.UserID)
+	}
+	return ids
+}
+
+type TeamMember struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	TeamID    uuid.UUID `gorm:"type:uuid;not null;index:idx_team_membership,unique"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index:idx_team_membership,unique;index"`
+	CreatedAt time.Time
+}
+
+func (m *TeamMember) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// OrganizationDomain is an email domain of an organization. Once verified
+// through a DNS record, users with a verified email on the domain join the
+// organization, or are offered to, with DefaultRole. A domain can only be
+// verified by one organization.
+type OrganizationDomain struct {
+	ID             uuid.UUID    `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_org_domain"`
+	Organization   Organization `gorm:"constraint:OnDelete:CASCADE"`
+	Domain         string       `gorm:"not null;uniqueIndex:idx_org_domain;uniqueIndex:idx_verified_domain,where:verified_at IS NOT NULL"`
+	DefaultRole    string       `gorm:"not null;default:member"`
+	// AutoJoin adds matching users right away, otherwise they get an
+	// invitation to accept
+	AutoJoin          bool
+	VerificationToken string `gorm:"not null"`
+	VerifiedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (d *OrganizationDomain) BeforeCreate(tx *gorm.DB) error {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return nil
+}
+
+// Verified reports whether the organization proved it owns the domain
+func (d *OrganizationDomain) Verified() bool {
+	return d.VerifiedAt != nil
+}
+
+// OrganizationUsage holds what an organization uses from other services,
+// counted from their domain events
+type OrganizationUsage struct {
+	OrganizationID uuid.UUID    `gorm:"type:uuid;primaryKey"`
+	Organization   Organization `gorm:"constraint:OnDelete:CASCADE"`
+	// Tasks counts tasks that are not archived
+	Tasks        int64 `gorm:"not null;default:0"`
+	StorageBytes int64 `gorm:"not null;default:0"`
+	UpdatedAt    time.Time
+}
+
+// CountedTask is a task included in its organization's task counter. Task
+// events arrive at least once, tracking the tasks keeps redeliveries from
+// counting twice.
+type CountedTask struct {
+	TaskID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index"`
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&Organization{}, &OrganizationMember{})
+	return db.AutoMigrate(&Organization{}, &OrganizationMember{}, &MemberRemoval{}, &Invitation{}, &Team{}, &TeamMember{}, &OrganizationDomain{}, &OrganizationUsage{}, &CountedTask{})
 }
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrDomainNotFound    = errors.New("organization domain not found")
+	ErrInvalidDomain     = errors.New("invalid email domain")
+	ErrDomainExists      = errors.New("the domain was already added to this organization")
+	ErrDomainClaimed     = errors.New("the domain is verified by another organization")
+	ErrDomainNotVerified = errors.New("the domain verification record was not found")
+)
+
+const (
+	// domainRecordPrefix is prepended to a domain to name its verification
+	// TXT record
+	domainRecordPrefix = "_task-flow."
+	// domainRecordValuePrefix starts the value of the verification record
+	domainRecordValuePrefix = "task-flow-verification="
+	maxDomainLength         = 253
+)
+
+// DomainResolver looks up the DNS TXT records proving domain ownership
+type DomainResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// SetDomainResolver replaces the DNS resolver used to verify domains
+func (s *Service) SetDomainResolver(resolver DomainResolver) {
+	if resolver != nil {
+		s.domainResolver = resolver
+	}
+}
+
+type AddDomainInput struct {
+	OrganizationID uuid.UUID
+	Domain         string
+	DefaultRole    string
+	AutoJoin       bool
+}
+
+// AddDomain registers an email domain for the organization. It does nothing
+// until verified.
+func (s *Service) AddDomain(ctx context.Context, input AddDomainInput) (*models.OrganizationDomain, error) {
+	domain, err := normalizeDomain(input.Domain)
+	if err != nil {
+		return nil, err
+	}
+	role, err := normalizeRole(strings.TrimSpace(input.DefaultRole))
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.authorize(ctx, input.OrganizationID, orgdomain.PermissionOrgUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeRoleChange(actor, "", role); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.OrganizationDomain{}).
+		Where("organization_id = ? AND domain = ?", org.ID, domain).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, ErrDomainExists
+	}
+
+	token, err := newDomainVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+	record := &models.OrganizationDomain{
+		OrganizationID:    org.ID,
+		Domain:            domain,
+		DefaultRole:       role,
+		AutoJoin:          input.AutoJoin,
+		VerificationToken: token,
+	}
+	if err := s.db.WithContext(ctx).Create(record).Error; err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ListDomains lists the organization's domains, verified or not
+func (s *Service) ListDomains(ctx context.Context, organizationID uuid.UUID) ([]models.OrganizationDomain, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate); err != nil {
+		return nil, err
+	}
+	var domains []models.OrganizationDomain
+	if err := s.db.WithContext(ctx).
+		Where("organization_id = ?", organizationID).
+		Order("domain ASC").
+		Find(&domains).Error; err != nil {
+		return nil, err
+	}
+	return domains, nil
+}
+
+type UpdateDomainInput struct {
+	DefaultRole *string
+	AutoJoin    *bool
+}
+
+// UpdateDomain changes how users of a domain join
+func (s *Service) UpdateDomain(ctx context.Context, organizationID, id uuid.UUID, input UpdateDomainInput) (*models.OrganizationDomain, error) {
+	actor, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate)
+	if err != nil {
+		return nil, err
+	}
+	record, err := s.getDomain(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if input.DefaultRole != nil {
+		role, err := normalizeRole(strings.TrimSpace(*input.DefaultRole))
+		if err != nil {
+			return nil, err
+		}
+		if err := authorizeRoleChange(actor, record.DefaultRole, role); err != nil {
+			return nil, err
+		}
+		updates["default_role"] = role
+	}
+	if input.AutoJoin != nil {
+		updates["auto_join"] = *input.AutoJoin
+	}
+	if len(updates) == 0 {
+		return record, nil
+	}
+	if err := s.db.WithContext(ctx).Model(record).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	return s.getDomain(ctx, organizationID, id)
+}
+
+// VerifyDomain checks the domain publishes the verification record. Verifying
+// a domain another organization already verified fails.
+func (s *Service) VerifyDomain(ctx context.Context, organizationID, id uuid.UUID) (*models.OrganizationDomain, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate); err != nil {
+		return nil, err
+	}
+	record, err := s.getDomain(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if record.Verified() {
+		return record, nil
+	}
+	if err := s.ensureDomainUnclaimed(ctx, record); err != nil {
+		return nil, err
+	}
+
+	values, err := s.domainResolver.LookupTXT(ctx, DomainVerificationRecord(record.Domain))
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return nil, ErrDomainNotVerified
+		}
+		return nil, fmt.Errorf("failed to look up domain verification record: %w", err)
+	}
+	expected := DomainVerificationValue(record.VerificationToken)
+	found := false
+	for _, value := range values {
+		if strings.TrimSpace(value) == expected {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, ErrDomainNotVerified
+	}
+
+	// The unique index on verified domains settles two organizations
+	// verifying at once
+	now := time.Now().UTC()
+	if err := s.db.WithContext(ctx).Model(record).Update("verified_at", now).Error; err != nil {
+		return nil, err
+	}
+	record.VerifiedAt = &now
+
+	log.S().Infow("organization domain verified", "organizationId", organizationID, "domain", record.Domain)
+	return record, nil
+}
+
+// RemoveDomain deletes a domain. Members who joined through it stay.
+func (s *Service) RemoveDomain(ctx context.Context, organizationID, id uuid.UUID) error {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate); err != nil {
+		return err
+	}
+	record, err := s.getDomain(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Delete(record).Error
+}
+
+// ClaimDomainMemberships joins the user to every organization with a
+// verified domain matching email, or offers an invitation when the domain
+// doesn't auto-join. Users who already have a membership, including those who
+// left, or were removed by an admin are skipped, and an organization offers a
+// user at most once. An organization the user can't join, such as one at its
+// member limit, doesn't stop the others. Callers must have verified the user
+// owns the email.
+func (s *Service) ClaimDomainMemberships(ctx context.Context, userID uuid.UUID, email string) ([]models.OrganizationMember, []models.Invitation, error) {
+	if caller, ok := authctx.IncomingUser(ctx); ok && caller.ID != userID.String() {
+		return nil, nil, ErrPermissionDenied
+	}
+	email, err := normalizeInvitationEmail(email)
+	if err != nil {
+		return nil, nil, err
+	}
+	domain := email[strings.LastIndex(email, "@")+1:]
+
+	var records []models.OrganizationDomain
+	if err := s.db.WithContext(ctx).Preload("Organization").
+		Where("domain = ? AND verified_at IS NOT NULL", domain).
+		Where("organization_id NOT IN (?)", s.db.WithContext(ctx).Model(&models.MemberRemoval{}).
+			Select("organization_id").
+			Where("user_id = ?", userID)).
+		Find(&records).Error; err != nil {
+		return nil, nil, err
+	}
+
+	var memberships []models.OrganizationMember
+	var offers []models.Invitation
+	for i := range records {
+		record := &records[i]
+		if record.Organization.PendingDeletion() {
+			continue
+		}
+		if _, err := s.GetMember(ctx, record.OrganizationID, userID); err == nil {
+			continue
+		} else if !errors.Is(err, ErrMemberNotFound) {
+			return nil, nil, err
+		}
+
+		if record.AutoJoin {
+			member, err := s.joinByDomain(ctx, record, userID)
+			if err != nil {
+				log.S().Warnw("failed to join organization by domain", "organizationId", record.OrganizationID, "userId", userID, "error", err)
+				continue
+			}
+			memberships = append(memberships, *member)
+			continue
+		}
+		offer, err := s.offerByDomain(ctx, record, email)
+		if err != nil {
+			return nil, nil, err
+		}
+		if offer != nil {
+			offers = append(offers, *offer)
+		}
+	}
+	return memberships, offers, nil
+}
+
+// joinByDomain adds the user with the domain's default role. Organizations
+// requiring approval keep the member pending.
+func (s *Service) joinByDomain(ctx context.Context, record *models.OrganizationDomain, userID uuid.UUID) (*models.OrganizationMember, error) {
+	status := orgdomain.MemberStatusActive
+	if record.Organization.EffectiveSettings().Membership.RequireApproval {
+		status = orgdomain.MemberStatusPending
+	}
+	member := models.OrganizationMember{
+		OrganizationID: record.OrganizationID,
+		UserID:         userID,
+		Role:           record.DefaultRole,
+		Status:         status,
+	}
+	if err := joinMember(s.db.WithContext(ctx), &member); err != nil {
+		return nil, err
+	}
+
+	s.publish("member_added", member.OrganizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberAdded(ctx, &member, userID.String())
+	})
+	return &member, nil
+}
+
+// offerByDomain creates the invitation offering the user to join, nil when
+// the organization offered before
+func (s *Service) offerByDomain(ctx context.Context, record *models.OrganizationDomain, email string) (*models.Invitation, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.Invitation{}).
+		Where("organization_id = ? AND email = ? AND domain <> ''", record.OrganizationID, email).
+		Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, nil
+	}
+
+	// Offers are answered in the app, the token is never sent
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &models.Invitation{
+		OrganizationID: record.OrganizationID,
+		Email:          email,
+		Role:           record.DefaultRole,
+		TokenHash:      hashInvitationToken(token),
+		Status:         models.InvitationStatusPending,
+		ExpiresAt:      time.Now().UTC().Add(s.invitationTTL()),
+		Domain:         record.Domain,
+	}
+	if err := s.db.WithContext(ctx).Create(invitation).Error; err != nil {
+		return nil, err
+	}
+	invitation.Organization = record.Organization
+	return invitation, nil
+}
+
+// ensureDomainUnclaimed checks no other organization verified the domain
+func (s *Service) ensureDomainUnclaimed(ctx context.Context, record *models.OrganizationDomain) error {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.OrganizationDomain{}).
+		Where("domain = ? AND verified_at IS NOT NULL AND id <> ?", record.Domain, record.ID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrDomainClaimed
+	}
+	return nil
+}
+
+func (s *Service) getDomain(ctx context.Context, organizationID, id uuid.UUID) (*models.OrganizationDomain, error) {
+	var record models.OrganizationDomain
+	if err := s.db.WithContext(ctx).
+		First(&record, "id = ? AND organization_id = ?", id, organizationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrDomainNotFound
+		}
+		return nil, err
+	}
+	return &record, nil
+}
+
+// DomainVerificationRecord is the name of the TXT record proving domain
+// ownership
+func DomainVerificationRecord(domain string) string {
+	return domainRecordPrefix + domain
+}
+
+// DomainVerificationValue is the value the verification record must hold
+func DomainVerificationValue(token string) string {
+	return domainRecordValuePrefix + token
+}
+
+// normalizeDomain lowercases a domain, dropping a leading @ so "@acme.com"
+// and "acme.com" are the same
+func normalizeDomain(domain string) (string, error) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "@")
+	domain = strings.TrimSuffix(domain, ".")
+	if domain == "" || len(domain) > maxDomainLength || !strings.Contains(domain, ".") {
+		return "", ErrInvalidDomain
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "", ErrInvalidDomain
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return "", ErrInvalidDomain
+			}
+		}
+	}
+	return domain, nil
+}
+
+func newDomainVerificationToken() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/shared/authctx"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+)
+
+// publish hands an organization event to the publisher. The change is already
+// committed, so a failed publish is logged rather than returned.
+func (s *Service) publish(eventType string, organizationID uuid.UUID, fn func(event.OrganizationEventPublisher) error) {
+	if s.publisher == nil {
+		return
+	}
+	if err := fn(s.publisher); err != nil {
+		log.S().Errorw("failed to publish organization event", "event", eventType, "organizationId", organizationID, "error", err)
+	}
+}
+
+// triggeredBy returns the id of the calling user, empty for internal calls
+func triggeredBy(ctx context.Context) string {
+	if caller, ok := authctx.IncomingUser(ctx); ok {
+		return caller.ID
+	}
+	return ""
+}
@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/mailer"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationExpired       = errors.New("invitation has expired")
+	ErrInvitationNotPending    = errors.New("invitation is no longer pending")
+	ErrInvitationExists        = errors.New("a pending invitation already exists for this email")
+	ErrInvitationEmailMismatch = errors.New("invitation was sent to a different email address")
+	ErrEmailUnverified         = errors.New("verify your email address before answering its invitations")
+	ErrInvalidInvitationEmail  = errors.New("invalid invitation email")
+	ErrInvalidInvitationStatus = errors.New("invalid invitation status")
+	ErrAlreadyMember           = errors.New("user is already a member of this organization")
+)
+
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// InvitationNotifier publishes the in-app notification for invitations sent to
+// existing users
+type InvitationNotifier interface {
+	PublishOrganizationInvited(ctx context.Context, organizationID, triggerUserID string, recipients []string, data *contracts.InvitationNotificationData) error
+}
+
+// InvitationConfig wires the delivery of invitations. Existing users are
+// notified in the app; other emails get a link to sign up with. Without Users
+// every invitation is emailed.
+type InvitationConfig struct {
+	TTL       time.Duration
+	PublicURL string
+	Users     userpb.UserServiceClient
+	Notifier  InvitationNotifier
+	Mailer    mailer.Mailer
+}
+
+// SetInvitationConfig configures how invitations are delivered
+func (s *Service) SetInvitationConfig(cfg InvitationConfig) {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultInvitationTTL
+	}
+	s.invitations = cfg
+}
+
+type CreateInvitationInput struct {
+	OrganizationID uuid.UUID
+	Email          string
+	Role           string
+}
+
+// CreateInvitation invites an email to the organization with a role
+func (s *Service) CreateInvitation(ctx context.Context, input CreateInvitationInput) (*models.Invitation, error) {
+	email, err := normalizeInvitationEmail(input.Email)
+	if err != nil {
+		return nil, err
+	}
+	role, err := normalizeRole(strings.TrimSpace(input.Role))
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.authorize(ctx, input.OrganizationID, orgdomain.PermissionMemberManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeRoleChange(actor, "", role); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+
+	invitee, err := s.findUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if invitee != nil {
+		member, err := s.GetMember(ctx, org.ID, uuid.MustParse(invitee.GetId()))
+		if err == nil && member.Status != orgdomain.MemberStatusLeft {
+			return nil, ErrAlreadyMember
+		} else if err != nil && !errors.Is(err, ErrMemberNotFound) {
+			return nil, err
+		}
+	}
+
+	var pending int64
+	if err := s.db.WithContext(ctx).Model(&models.Invitation{}).
+		Where("organization_id = ? AND email = ? AND status = ? AND expires_at > ?",
+			org.ID, email, models.InvitationStatusPending, time.Now().UTC()).
+		Count(&pending).Error; err != nil {
+		return nil, err
+	}
+	if pending > 0 {
+		return nil, ErrInvitationExists
+	}
+
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &models.Invitation{
+		OrganizationID: org.ID,
+		Email:          email,
+		Role:           role,
+		TokenHash:      hashInvitationToken(token),
+		Status:         models.InvitationStatusPending,
+		ExpiresAt:      time.Now().UTC().Add(s.invitationTTL()),
+	}
+	if caller, ok := authctx.IncomingUser(ctx); ok {
+		if inviterID, err := uuid.Parse(caller.ID); err == nil {
+			invitation.InviterID = inviterID
+		}
+	}
+	if err := s.db.WithContext(ctx).Create(invitation).Error; err != nil {
+		return nil, err
+	}
+	invitation.Organization = *org
+
+	s.deliverInvitation(ctx, invitation, token, invitee)
+	return invitation, nil
+}
+
+// ListInvitations lists an organization's invitations with status, pending
+// ones by default
+func (s *Service) ListInvitations(ctx context.Context, organizationID uuid.UUID, status string) ([]models.Invitation, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	query := s.db.WithContext(ctx).
+		Preload("Organization").
+		Where("organization_id = ?", organizationID).
+		Order("created_at DESC")
+	switch status {
+	case "", models.InvitationStatusPending:
+		query = query.Where("status = ? AND expires_at > ?", models.InvitationStatusPending, now)
+	case models.InvitationStatusExpired:
+		query = query.Where("status = ? AND expires_at <= ?", models.InvitationStatusPending, now)
+	case models.InvitationStatusAccepted, models.InvitationStatusDeclined, models.InvitationStatusRevoked:
+		query = query.Where("status = ?", status)
+	default:
+		return nil, ErrInvalidInvitationStatus
+	}
+
+	var invitations []models.Invitation
+	if err := query.Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// ResendInvitation issues a new token and expiry for a pending or expired
+// invitation and delivers it again. Earlier links stop working.
+func (s *Service) ResendInvitation(ctx context.Context, organizationID, id uuid.UUID) (*models.Invitation, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage); err != nil {
+		return nil, err
+	}
+	invitation, err := s.getInvitation(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.Status != models.InvitationStatusPending {
+		return nil, ErrInvitationNotPending
+	}
+
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+	updates := map[string]interface{}{
+		"token_hash": hashInvitationToken(token),
+		"expires_at": time.Now().UTC().Add(s.invitationTTL()),
+	}
+	if err := s.db.WithContext(ctx).Model(invitation).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	invitee, err := s.findUserByEmail(ctx, invitation.Email)
+	if err != nil {
+		return nil, err
+	}
+	s.deliverInvitation(ctx, invitation, token, invitee)
+	return invitation, nil
+}
+
+// RevokeInvitation withdraws a pending invitation
+func (s *Service) RevokeInvitation(ctx context.Context, organizationID, id uuid.UUID) error {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage); err != nil {
+		return err
+	}
+	invitation, err := s.getInvitation(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+	if invitation.Status != models.InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	return s.db.WithContext(ctx).Model(invitation).Update("status", models.InvitationStatusRevoked).Error
+}
+
+// ListUserInvitations lists the open invitations sent to an email. Users
+// must have verified it first.
+func (s *Service) ListUserInvitations(ctx context.Context, email string) ([]models.Invitation, error) {
+	if err := requireVerifiedEmail(ctx); err != nil {
+		return nil, err
+	}
+	email, err := normalizeInvitationEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	var invitations []models.Invitation
+	if err := s.db.WithContext(ctx).
+		Preload("Organization").
+		Where("email = ? AND status = ? AND expires_at > ?", email, models.InvitationStatusPending, time.Now().UTC()).
+		Order("created_at DESC").
+		Find(&invitations).Error; err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// RespondInvitationInput identifies an invitation by ID or by the emailed
+// token, on behalf of the user with Email
+type RespondInvitationInput struct {
+	ID     uuid.UUID
+	Token  string
+	UserID uuid.UUID
+	Email  string
+}
+
+// AcceptInvitation adds the user to the organization with the invited role
+func (s *Service) AcceptInvitation(ctx context.Context, input RespondInvitationInput) (*models.OrganizationMember, error) {
+	invitation, err := s.findRespondableInvitation(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return s.acceptInvitation(ctx, invitation, input.UserID)
+}
+
+// DeclineInvitation turns an invitation down
+func (s *Service) DeclineInvitation(ctx context.Context, input RespondInvitationInput) error {
+	invitation, err := s.findRespondableInvitation(ctx, input)
+	if err != nil {
+		return err
+	}
+	return s.respondInvitation(s.db.WithContext(ctx), invitation, models.InvitationStatusDeclined, nil)
+}
+
+// ClaimInvitations accepts every open invitation for email on behalf of the
+// user. Callers must have verified the user owns the email.
+func (s *Service) ClaimInvitations(ctx context.Context, userID uuid.UUID, email string) ([]models.OrganizationMember, error) {
+	invitations, err := s.ListUserInvitations(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships := make([]models.OrganizationMember, 0, len(invitations))
+	seen := make(map[uuid.UUID]struct{}, len(invitations))
+	for i := range invitations {
+		// Domain offers wait for the user to accept them
+		if invitations[i].Domain != "" {
+			continue
+		}
+		member, err := s.acceptInvitation(ctx, &invitations[i], userID)
+		if err != nil {
+			if errors.Is(err, ErrInvitationNotPending) || errors.Is(err, ErrOrganizationPendingDeletion) {
+				continue
+			}
+			return nil, err
+		}
+		if _, ok := seen[member.OrganizationID]; ok {
+			continue
+		}
+		seen[member.OrganizationID] = struct{}{}
+		memberships = append(memberships, *member)
+	}
+	return memberships, nil
+}
+
+func (s *Service) acceptInvitation(ctx context.Context, invitation *models.Invitation, userID uuid.UUID) (*models.OrganizationMember, error) {
+	if invitation.Organization.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+	status := orgdomain.MemberStatusActive
+	if invitation.Organization.EffectiveSettings().Membership.RequireApproval {
+		status = orgdomain.MemberStatusPending
+	}
+	member := models.OrganizationMember{
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           invitation.Role,
+		Status:         status,
+	}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.respondInvitation(tx, invitation, models.InvitationStatusAccepted, &userID); err != nil {
+			return err
+		}
+		// An existing membership keeps its role and status
+		return joinMember(tx, &member)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish("member_added", member.OrganizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberAdded(ctx, &member, userID.String())
+	})
+	return &member, nil
+}
+
+// respondInvitation moves a pending invitation to status, failing if another
+// request answered it first
+func (s *Service) respondInvitation(tx *gorm.DB, invitation *models.Invitation, status string, acceptedBy *uuid.UUID) error {
+	now := time.Now().UTC()
+	result := tx.Model(&models.Invitation{}).
+		Where("id = ? AND status = ? AND expires_at > ?", invitation.ID, models.InvitationStatusPending, now).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"responded_at": now,
+			"accepted_by":  acceptedBy,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvitationNotPending
+	}
+	invitation.Status = status
+	invitation.RespondedAt = &now
+	invitation.AcceptedBy = acceptedBy
+	return nil
+}
+
+// findRespondableInvitation loads the invitation the user is answering. An
+// invitation looked up by ID for another email is reported as not found so
+// IDs cannot be probed. Only the emailed token works before the user
+// verified their email.
+func (s *Service) findRespondableInvitation(ctx context.Context, input RespondInvitationInput) (*models.Invitation, error) {
+	email, err := normalizeInvitationEmail(input.Email)
+	if err != nil {
+		return nil, err
+	}
+	if input.UserID == uuid.Nil {
+		return nil, ErrInvitationNotFound
+	}
+
+	query := s.db.WithContext(ctx).Preload("Organization")
+	switch {
+	case input.Token != "":
+		query = query.Where("token_hash = ?", hashInvitationToken(input.Token))
+	case input.ID != uuid.Nil:
+		if err := requireVerifiedEmail(ctx); err != nil {
+			return nil, err
+		}
+		query = query.Where("id = ? AND email = ?", input.ID, email)
+	default:
+		return nil, ErrInvitationNotFound
+	}
+
+	var invitation models.Invitation
+	if err := query.First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	if invitation.Email != email {
+		return nil, ErrInvitationEmailMismatch
+	}
+	switch invitation.EffectiveStatus(time.Now().UTC()) {
+	case models.InvitationStatusPending:
+		return &invitation, nil
+	case models.InvitationStatusExpired:
+		return nil, ErrInvitationExpired
+	default:
+		return nil, ErrInvitationNotPending
+	}
+}
+
+// requireVerifiedEmail refuses users who have not verified their email yet.
+// Internal callers only pass verified emails.
+func requireVerifiedEmail(ctx context.Context) error {
+	if caller, ok := authctx.IncomingUser(ctx); ok && !caller.EmailVerified {
+		return ErrEmailUnverified
+	}
+	return nil
+}
+
+func (s *Service) getInvitation(ctx context.Context, organizationID, id uuid.UUID) (*models.Invitation, error) {
+	var invitation models.Invitation
+	if err := s.db.WithContext(ctx).
+		Preload("Organization").
+		First(&invitation, "id = ? AND organization_id = ?", id, organizationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// findUserByEmail returns the account registered with email, or nil when
+// there is none or user-service isn't configured
+func (s *Service) findUserByEmail(ctx context.Context, email string) (*userpb.User, error) {
+	if s.invitations.Users == nil {
+		return nil, nil
+	}
+	resp, err := s.invitations.Users.ListUsers(ctx, &userpb.ListUsersRequest{Query: email, Limit: 20})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up invited user: %w", err)
+	}
+	for _, user := range resp.GetItems() {
+		if !strings.EqualFold(user.GetEmail(), email) {
+			continue
+		}
+		if _, err := uuid.Parse(user.GetId()); err != nil {
+			continue
+		}
+		return user, nil
+	}
+	return nil, nil
+}
+
+// deliverInvitation notifies an existing user in the app and emails anyone
+// else a sign up link. Failures are logged so the invitation still stands and
+// can be resent.
+func (s *Service) deliverInvitation(ctx context.Context, invitation *models.Invitation, token string, invitee *userpb.User) {
+	inviterName := "Someone"
+	var inviter *contracts.TaskUser
+	if caller, ok := authctx.IncomingUser(ctx); ok {
+		inviter = &contracts.TaskUser{
+			ID:        caller.ID,
+			FirstName: caller.FirstName,
+			LastName:  caller.LastName,
+			Email:     caller.Email,
+		}
+		if name := strings.TrimSpace(caller.FirstName + " " + caller.LastName); name != "" {
+			inviterName = name
+		}
+	}
+
+	if invitee != nil && s.invitations.Notifier != nil {
+		err := s.invitations.Notifier.PublishOrganizationInvited(ctx,
+			invitation.OrganizationID.String(),
+			invitation.InviterID.String(),
+			[]string{invitee.GetId()},
+			&contracts.InvitationNotificationData{
+				InvitationID:     invitation.ID.String(),
+				OrganizationID:   invitation.OrganizationID.String(),
+				OrganizationName: invitation.Organization.Name,
+				Role:             invitation.Role,
+				ExpiresAt:        invitation.ExpiresAt.Format(time.RFC3339),
+				Inviter:          inviter,
+			})
+		if err != nil {
+			log.S().Errorw("failed to publish invitation notification", "invitationId", invitation.ID, "error", err)
+		}
+		return
+	}
+
+	if s.invitations.Mailer == nil {
+		log.S().Warnw("no mailer configured, invitation not delivered", "invitationId", invitation.ID)
+		return
+	}
+	err := s.invitations.Mailer.Send(ctx, mailer.Message{
+		To:      invitation.Email,
+		Subject: fmt.Sprintf("You're invited to join %s on Task Flow", invitation.Organization.Name),
+		Body: fmt.Sprintf("%s invited you to join %s as %s.\n\n"+
+			"Accept the invitation here:\n%s\n\n"+
+			"The invitation expires on %s. If you weren't expecting it, ignore this email.\n",
+			inviterName, invitation.Organization.Name, invitation.Role,
+			s.invitationLink(token), invitation.ExpiresAt.Format("2 Jan 2006 15:04 MST")),
+	})
+	if err != nil {
+		log.S().Errorw("failed to send invitation email", "invitationId", invitation.ID, "error", err)
+	}
+}
+
+func (s *Service) invitationLink(token string) string {
+	return fmt.Sprintf("%s/invitations/accept?token=%s", strings.TrimRight(s.invitations.PublicURL, "/"), url.QueryEscape(token))
+}
+
+func (s *Service) invitationTTL() time.Duration {
+	if s.invitations.TTL <= 0 {
+		return defaultInvitationTTL
+	}
+	return s.invitations.TTL
+}
+
+func normalizeInvitationEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidInvitationEmail
+	}
+	return email, nil
+}
+
+func newInvitationToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrOrganizationPendingDeletion = errors.New("organization is scheduled for deletion")
+	ErrDeletionNotScheduled        = errors.New("organization is not scheduled for deletion")
+	ErrDeletionConfirmation        = errors.New("type the organization name to confirm deletion")
+	ErrInvalidNewOwner             = errors.New("the new owner must be an active member other than the current owner")
+)
+
+const defaultDeletionGrace = 7 * 24 * time.Hour
+
+// SetDeletionGracePeriod overrides how long a deleted organization can be
+// restored. Zero purges organizations on the next purger run.
+func (s *Service) SetDeletionGracePeriod(grace time.Duration) {
+	if grace >= 0 {
+		s.deletionGrace = grace
+	}
+}
+
+// DeleteOrganization schedules the organization for deletion. confirmName
+// must repeat the organization name. Until the grace period ends members keep
+// their access and the owner can cancel; afterwards the purger removes it.
+func (s *Service) DeleteOrganization(ctx context.Context, id uuid.UUID, confirmName string) (*models.Organization, error) {
+	org, err := s.GetOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, id, orgdomain.PermissionOrgDelete); err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return org, nil
+	}
+	if strings.TrimSpace(confirmName) != org.Name {
+		return nil, ErrDeletionConfirmation
+	}
+
+	now := time.Now().UTC()
+	deleteAfter := now.Add(s.deletionGrace)
+	updates := map[string]interface{}{
+		"deletion_requested_at": now,
+		"delete_after":          deleteAfter,
+	}
+	if caller, ok := authctx.IncomingUser(ctx); ok {
+		if callerID, err := uuid.Parse(caller.ID); err == nil {
+			updates["deletion_requested_by"] = callerID
+			org.DeletionRequestedBy = &callerID
+		}
+	}
+	if err := s.db.WithContext(ctx).Model(org).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	org.DeletionRequestedAt = &now
+	org.DeleteAfter = &deleteAfter
+
+	log.S().Infow("organization scheduled for deletion", "organizationId", org.ID, "deleteAfter", deleteAfter)
+	return org, nil
+}
+
+// CancelOrganizationDeletion keeps an organization whose deletion is pending
+func (s *Service) CancelOrganizationDeletion(ctx context.Context, id uuid.UUID) (*models.Organization, error) {
+	org, err := s.GetOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, id, orgdomain.PermissionOrgDelete); err != nil {
+		return nil, err
+	}
+	if !org.PendingDeletion() {
+		return nil, ErrDeletionNotScheduled
+	}
+
+	if err := s.db.WithContext(ctx).Model(org).Updates(map[string]interface{}{
+		"deletion_requested_at": nil,
+		"deletion_requested_by": nil,
+		"delete_after":          nil,
+	}).Error; err != nil {
+		return nil, err
+	}
+	org.DeletionRequestedAt = nil
+	org.DeletionRequestedBy = nil
+	org.DeleteAfter = nil
+	return org, nil
+}
+
+// TransferOwnership hands the organization to another active member. The
+// previous owner stays on as an admin.
+func (s *Service) TransferOwnership(ctx context.Context, id, newOwnerID uuid.UUID) (*models.Organization, error) {
+	org, err := s.GetOrganization(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// org.delete is only granted to the owner
+	if _, err := s.authorize(ctx, id, orgdomain.PermissionOrgDelete); err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+	if newOwnerID == uuid.Nil || newOwnerID == org.OwnerID {
+		return nil, ErrInvalidNewOwner
+	}
+	newOwner, err := s.GetMember(ctx, id, newOwnerID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil, ErrInvalidNewOwner
+		}
+		return nil, err
+	}
+	if newOwner.Status != orgdomain.MemberStatusActive {
+		return nil, ErrInvalidNewOwner
+	}
+	previousOwner, err := s.GetMember(ctx, id, org.OwnerID)
+	if err != nil && !errors.Is(err, ErrMemberNotFound) {
+		return nil, err
+	}
+	newOwnerRole := newOwner.Role
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.OrganizationMember{}).
+			Where("organization_id = ? AND role = ?", id, orgdomain.RoleOwner).
+			Update("role", orgdomain.RoleAdmin).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(newOwner).Update("role", orgdomain.RoleOwner).Error; err != nil {
+			return err
+		}
+		return tx.Model(org).Update("owner_id", newOwnerID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	org.OwnerID = newOwnerID
+	newOwner.Role = orgdomain.RoleOwner
+
+	actorID := triggeredBy(ctx)
+	s.publish("updated", org.ID, func(p event.OrganizationEventPublisher) error {
+		return p.OrganizationUpdated(ctx, org, actorID)
+	})
+	if previousOwner != nil {
+		previousOwner.Role = orgdomain.RoleAdmin
+		s.publish("member_role_changed", org.ID, func(p event.OrganizationEventPublisher) error {
+			return p.MemberRoleChanged(ctx, previousOwner, orgdomain.RoleOwner, actorID)
+		})
+	}
+	s.publish("member_role_changed", org.ID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberRoleChanged(ctx, newOwner, newOwnerRole, actorID)
+	})
+
+	log.S().Infow("organization ownership transferred", "organizationId", org.ID, "ownerId", newOwnerID)
+	return org, nil
+}
+
+// PurgeDeletedOrganizations removes organizations whose deletion grace period
+// is over. The deleted event goes out first so other services purge their
+// data; if it can't be published the organization is kept for the next run.
+func (s *Service) PurgeDeletedOrganizations(ctx context.Context) (int, error) {
+	var orgs []models.Organization
+	if err := s.db.WithContext(ctx).
+		Where("delete_after IS NOT NULL AND delete_after <= ?", time.Now().UTC()).
+		Order("delete_after ASC").
+		Find(&orgs).Error; err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for i := range orgs {
+		org := &orgs[i]
+		if err := s.publisher.OrganizationDeleted(ctx, org); err != nil {
+			log.S().Errorw("failed to publish organization deleted event", "organizationId", org.ID, "error", err)
+			continue
+		}
+
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Delete(&models.Invitation{}, "organization_id = ?", org.ID).Error; err != nil {
+				return err
+			}
+			teamIDs := tx.Model(&models.Team{}).Select("id").Where("organization_id = ?", org.ID)
+			if err := tx.Where("team_id IN (?)", teamIDs).Delete(&models.TeamMember{}).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&models.Team{}, "organization_id = ?", org.ID).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&models.OrganizationMember{}, "organization_id = ?", org.ID).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&models.MemberRemoval{}, "organization_id = ?", org.ID).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(&models.CountedTask{}, "organization_id = ?", org.ID).Error; err != nil {
+				return err
+			}
+			return tx.Delete(&models.Organization{}, "id = ?", org.ID).Error
+		})
+		if err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// RunDeletionPurger purges organizations past their grace period every
+// interval until ctx is done
+func (s *Service) RunDeletionPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.PurgeDeletedOrganizations(ctx)
+		if err != nil {
+			log.S().Errorw("failed to purge deleted organizations", "error", err)
+		} else if purged > 0 {
+			log.S().Infow("purged deleted organizations", "count", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
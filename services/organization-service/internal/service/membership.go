@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidMemberStatus = errors.New("the member's status does not allow this change")
+	ErrOwnerCannotLeave    = errors.New("the organization owner cannot leave, transfer ownership first")
+)
+
+// SuspendMember takes access away from an active or pending member without
+// removing the membership
+func (s *Service) SuspendMember(ctx context.Context, organizationID, userID uuid.UUID) (*models.OrganizationMember, error) {
+	return s.changeMemberStatus(ctx, organizationID, userID, orgdomain.MemberStatusSuspended,
+		orgdomain.MemberStatusActive, orgdomain.MemberStatusPending)
+}
+
+// ReactivateMember gives a suspended member access again
+func (s *Service) ReactivateMember(ctx context.Context, organizationID, userID uuid.UUID) (*models.OrganizationMember, error) {
+	return s.changeMemberStatus(ctx, organizationID, userID, orgdomain.MemberStatusActive,
+		orgdomain.MemberStatusSuspended)
+}
+
+// ApproveMember activates a member waiting for approval
+func (s *Service) ApproveMember(ctx context.Context, organizationID, userID uuid.UUID) (*models.OrganizationMember, error) {
+	return s.changeMemberStatus(ctx, organizationID, userID, orgdomain.MemberStatusActive,
+		orgdomain.MemberStatusPending)
+}
+
+// LeaveOrganization lets a member leave on their own. The membership is kept
+// as left so an invitation or admin can bring them back.
+func (s *Service) LeaveOrganization(ctx context.Context, organizationID, userID uuid.UUID) error {
+	if caller, ok := authctx.IncomingUser(ctx); ok && caller.ID != userID.String() {
+		return ErrPermissionDenied
+	}
+	member, err := s.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+	if member.Status == orgdomain.MemberStatusLeft {
+		return ErrMemberNotFound
+	}
+	if member.Role == orgdomain.RoleOwner {
+		return ErrOwnerCannotLeave
+	}
+
+	previousStatus := member.Status
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := removeFromTeams(tx, organizationID, userID); err != nil {
+			return err
+		}
+		return tx.Model(member).Update("status", orgdomain.MemberStatusLeft).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish("member_status_changed", organizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberStatusChanged(ctx, member, previousStatus, userID.String())
+	})
+	return nil
+}
+
+// changeMemberStatus moves a member to status when its current status is one
+// of from. Like removals, only the owner changes the status of admins.
+func (s *Service) changeMemberStatus(ctx context.Context, organizationID, userID uuid.UUID, status string, from ...string) (*models.OrganizationMember, error) {
+	actor, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Status == orgdomain.MemberStatusLeft {
+		return nil, ErrMemberNotFound
+	}
+	if err := authorizeRoleChange(actor, member.Role, ""); err != nil {
+		return nil, err
+	}
+	if actor != nil && actor.UserID == userID {
+		return nil, ErrPermissionDenied
+	}
+	if !containsStatus(from, member.Status) {
+		return nil, ErrInvalidMemberStatus
+	}
+
+	previousStatus := member.Status
+	if err := s.db.WithContext(ctx).Model(member).Update("status", status).Error; err != nil {
+		return nil, err
+	}
+
+	actorID := triggeredBy(ctx)
+	s.publish("member_status_changed", organizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberStatusChanged(ctx, member, previousStatus, actorID)
+	})
+	return member, nil
+}
+
+// joinMember creates a membership, or brings back a member who left with the
+// new role and status. Other existing memberships are returned unchanged, so
+// joining never lifts a suspension. Members who are added or come back count
+// against the plan's member limit, and a removal on record is cleared. It runs
+// in a transaction of its own, or a savepoint when db already is one.
+func joinMember(db *gorm.DB, member *models.OrganizationMember) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		var existing models.OrganizationMember
+		err := tx.Where("organization_id = ? AND user_id = ?", member.OrganizationID, member.UserID).
+			First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := checkMemberLimit(tx, member.OrganizationID); err != nil {
+				return err
+			}
+			if err := tx.Where("organization_id = ? AND user_id = ?", member.OrganizationID, member.UserID).
+				Delete(&models.MemberRemoval{}).Error; err != nil {
+				return err
+			}
+			return tx.Create(member).Error
+		}
+		if err != nil {
+			return err
+		}
+		if existing.Status == orgdomain.MemberStatusLeft {
+			if err := checkMemberLimit(tx, member.OrganizationID); err != nil {
+				return err
+			}
+			if err := tx.Model(&existing).Updates(map[string]interface{}{
+				"role":   member.Role,
+				"status": member.Status,
+			}).Error; err != nil {
+				return err
+			}
+			existing.Role = member.Role
+			existing.Status = member.Status
+		}
+		*member = existing
+		return nil
+	})
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, candidate := range statuses {
+		if candidate == status {
+			return true
+		}
+	}
+	return false
+}
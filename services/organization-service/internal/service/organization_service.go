@@ -3,23 +3,47 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
 	"strings"
+	"time"
 
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
 	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
-	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrOrganizationNotFound  = errors.New("organization not found")
+	ErrInvalidPasswordPolicy = errors.New("invalid password policy")
+)
+
+// Upper bounds for organization password rules. 72 bytes is all bcrypt reads.
+const (
+	maxPasswordMinLength = 72
+	maxPasswordHistory   = 24
 )
 
 type Service struct {
-	db *gorm.DB
+	db          *gorm.DB
+	publisher   event.OrganizationEventPublisher
+	invitations InvitationConfig
+	// deletionGrace is how long a deleted organization can be restored
+	deletionGrace time.Duration
+	// domainResolver looks up domain verification records
+	domainResolver DomainResolver
 }
 
-func New(db *gorm.DB) *Service {
-	return &Service{db: db}
+func New(db *gorm.DB, publisher event.OrganizationEventPublisher) *Service {
+	return &Service{
+		db:             db,
+		publisher:      publisher,
+		deletionGrace:  defaultDeletionGrace,
+		domainResolver: net.DefaultResolver,
+	}
 }
 
 type CreateOrganizationInput struct {
@@ -43,12 +67,16 @@ func (s *Service) CreateOrganization(ctx context.Context, input CreateOrganizati
 		member := models.OrganizationMember{
 			OrganizationID: org.ID,
 			UserID:         input.OwnerID,
-			Role:           "owner",
-			Status:         "active",
+			Role:           orgdomain.RoleOwner,
+			Status:         orgdomain.MemberStatusActive,
 		}
 		_ = s.db.WithContext(ctx).Where("organization_id = ? AND user_id = ?", org.ID, input.OwnerID).FirstOrCreate(&member).Error
 	}
 
+	actorID := triggeredBy(ctx)
+	s.publish("created", org.ID, func(p event.OrganizationEventPublisher) error {
+		return p.OrganizationCreated(ctx, org, actorID)
+	})
 	return org, nil
 }
 
@@ -92,8 +120,13 @@ func (s *Service) ListOrganizations(ctx context.Context, params ListOrganization
 }
 
 type UpdateOrganizationInput struct {
-	Name        *string
-	Description *string
+	Name                 *string
+	Description          *string
+	RequireVerifiedEmail *bool
+	RequireTwoFactor     *bool
+	PasswordMinLength    *int
+	PasswordMinClasses   *int
+	PasswordHistory      *int
 }
 
 func (s *Service) UpdateOrganization(ctx context.Context, id uuid.UUID, input UpdateOrganizationInput) (*models.Organization, error) {
@@ -101,6 +134,12 @@ func (s *Service) UpdateOrganization(ctx context.Context, id uuid.UUID, input Up
 	if err != nil {
 		return nil, err
 	}
+	if _, err := s.authorize(ctx, id, orgdomain.PermissionOrgUpdate); err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
 
 	updates := map[string]interface{}{}
 	if input.Name != nil {
@@ -109,28 +148,55 @@ func (s *Service) UpdateOrganization(ctx context.Context, id uuid.UUID, input Up
 	if input.Description != nil {
 		updates["description"] = strings.TrimSpace(*input.Description)
 	}
+	if input.RequireVerifiedEmail != nil {
+		updates["require_verified_email"] = *input.RequireVerifiedEmail
+	}
+	// Two-factor is part of the settings document as well
+	settingsChanged := input.RequireTwoFactor != nil && *input.RequireTwoFactor != org.RequireTwoFactor
+	if input.RequireTwoFactor != nil {
+		updates["require_two_factor"] = *input.RequireTwoFactor
+	}
+	if settingsChanged {
+		updates["settings_version"] = gorm.Expr("settings_version + 1")
+	}
+	if input.PasswordMinLength != nil {
+		if *input.PasswordMinLength < 0 || *input.PasswordMinLength > maxPasswordMinLength {
+			return nil, fmt.Errorf("%w: minimum length must be 0-%d", ErrInvalidPasswordPolicy, maxPasswordMinLength)
+		}
+		updates["password_min_length"] = *input.PasswordMinLength
+	}
+	if input.PasswordMinClasses != nil {
+		if *input.PasswordMinClasses < 0 || *input.PasswordMinClasses > 4 {
+			return nil, fmt.Errorf("%w: character classes must be 0-4", ErrInvalidPasswordPolicy)
+		}
+		updates["password_min_classes"] = *input.PasswordMinClasses
+	}
+	if input.PasswordHistory != nil {
+		if *input.PasswordHistory < 0 || *input.PasswordHistory > maxPasswordHistory {
+			return nil, fmt.Errorf("%w: history must be 0-%d", ErrInvalidPasswordPolicy, maxPasswordHistory)
+		}
+		updates["password_history"] = *input.PasswordHistory
+	}
 
 	if len(updates) > 0 {
 		if err := s.db.WithContext(ctx).Model(org).Updates(updates).Error; err != nil {
 			return nil, err
 		}
+		if err := s.db.WithContext(ctx).First(org, "id = ?", org.ID).Error; err != nil {
+			return nil, err
+		}
+		actorID := triggeredBy(ctx)
+		s.publish("updated", org.ID, func(p event.OrganizationEventPublisher) error {
+			return p.OrganizationUpdated(ctx, org, actorID)
+		})
+		if settingsChanged {
+			s.publishSettingsUpdated(ctx, org)
+		}
 	}
 
 	return org, nil
 }
 
-func (s *Service) DeleteOrganization(ctx context.Context, id uuid.UUID) error {
-	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Delete(&models.OrganizationMember{}, "organization_id = ?", id).Error; err != nil {
-			return err
-		}
-		if err := tx.Delete(&models.Organization{}, "id = ?", id).Error; err != nil {
-			return err
-		}
-		return nil
-	})
-}
-
 type AddMemberInput struct {
 	OrganizationID uuid.UUID
 	UserID         uuid.UUID
@@ -138,27 +204,123 @@ type AddMemberInput struct {
 }
 
 func (s *Service) AddMember(ctx context.Context, input AddMemberInput) (*models.OrganizationMember, error) {
+	role, err := normalizeRole(strings.TrimSpace(input.Role))
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.authorize(ctx, input.OrganizationID, orgdomain.PermissionMemberManage)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeRoleChange(actor, "", role); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+
 	member := models.OrganizationMember{
 		OrganizationID: input.OrganizationID,
 		UserID:         input.UserID,
-		Role:           strings.TrimSpace(input.Role),
-		Status:         "active",
+		Role:           role,
+		Status:         orgdomain.MemberStatusActive,
 	}
 
-	err := s.db.WithContext(ctx).FirstOrCreate(&member, models.OrganizationMember{
-		OrganizationID: input.OrganizationID,
-		UserID:         input.UserID,
-	}).Error
-	if err != nil {
+	if err := joinMember(s.db.WithContext(ctx), &member); err != nil {
 		return nil, err
 	}
 
+	actorID := triggeredBy(ctx)
+	s.publish("member_added", member.OrganizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberAdded(ctx, &member, actorID)
+	})
 	return &member, nil
 }
 
+// RemoveMember deletes a membership and records the removal, so the user
+// isn't added back through a verified domain
 func (s *Service) RemoveMember(ctx context.Context, organizationID, userID uuid.UUID) error {
-	return s.db.WithContext(ctx).
-		Delete(&models.OrganizationMember{}, "organization_id = ? AND user_id = ?", organizationID, userID).Error
+	actor, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage)
+	if err != nil {
+		return err
+	}
+	member, err := s.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil
+		}
+		return err
+	}
+	if err := authorizeRoleChange(actor, member.Role, ""); err != nil {
+		return err
+	}
+
+	actorID := triggeredBy(ctx)
+	removal := models.MemberRemoval{
+		OrganizationID: organizationID,
+		UserID:         userID,
+		RemovedAt:      time.Now().UTC(),
+	}
+	if actor != nil {
+		removal.RemovedBy = &actor.UserID
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := removeFromTeams(tx, organizationID, userID); err != nil {
+			return err
+		}
+		if err := tx.Delete(member).Error; err != nil {
+			return err
+		}
+		return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&removal).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publish("member_removed", organizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberRemoved(ctx, member, actorID)
+	})
+	return nil
+}
+
+// UpdateMemberRole changes the role of an existing member
+func (s *Service) UpdateMemberRole(ctx context.Context, organizationID, userID uuid.UUID, role string) (*models.OrganizationMember, error) {
+	role, err := normalizeRole(strings.TrimSpace(role))
+	if err != nil {
+		return nil, err
+	}
+	actor, err := s.authorize(ctx, organizationID, orgdomain.PermissionMemberManage)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Status == orgdomain.MemberStatusLeft {
+		return nil, ErrMemberNotFound
+	}
+	if member.Role == role {
+		return member, nil
+	}
+	if err := authorizeRoleChange(actor, member.Role, role); err != nil {
+		return nil, err
+	}
+
+	previousRole := member.Role
+	if err := s.db.WithContext(ctx).Model(member).Update("role", role).Error; err != nil {
+		return nil, err
+	}
+
+	actorID := triggeredBy(ctx)
+	s.publish("member_role_changed", organizationID, func(p event.OrganizationEventPublisher) error {
+		return p.MemberRoleChanged(ctx, member, previousRole, actorID)
+	})
+	return member, nil
 }
 
 type ListMembersParams struct {
@@ -178,7 +340,7 @@ func (s *Service) ListMembers(ctx context.Context, params ListMembersParams) ([]
 
 	var members []models.OrganizationMember
 	if err := s.db.WithContext(ctx).
-		Where("organization_id = ?", params.OrganizationID).
+		Where("organization_id = ? AND status <> ?", params.OrganizationID, orgdomain.MemberStatusLeft).
 		Order("created_at DESC").
 		Offset(offset).
 		Limit(params.Limit).
@@ -191,7 +353,7 @@ func (s *Service) ListMembers(ctx context.Context, params ListMembersParams) ([]
 func (s *Service) ListUserMemberships(ctx context.Context, userID uuid.UUID) ([]models.OrganizationMember, error) {
 	var memberships []models.OrganizationMember
 	if err := s.db.WithContext(ctx).
-		Where("user_id = ?", userID).
+		Where("user_id = ? AND status <> ?", userID, orgdomain.MemberStatusLeft).
 		Order("created_at DESC").
 		Find(&memberships).Error; err != nil {
 		return nil, err
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrMemberNotFound     = errors.New("organization member not found")
+	ErrInvalidRole        = errors.New("invalid member role")
+	ErrInvalidPermission  = errors.New("invalid permission")
+	ErrPermissionDenied   = errors.New("you do not have permission to do this in the organization")
+	ErrOwnerRoleReserved  = errors.New("the owner role can only be held by the organization owner")
+	ErrOwnerMembership    = errors.New("the organization owner cannot be removed or change role")
+	ErrAdminRoleForbidden = errors.New("only the owner can add, remove or change admins")
+)
+
+// rolePermissions maps each role to what it may do. Roles are cumulative from
+// guest up, except org.delete which stays with the owner.
+var rolePermissions = map[string]map[string]struct{}{
+	orgdomain.RoleOwner: permissionSet(
+		orgdomain.PermissionOrgRead,
+		orgdomain.PermissionOrgUpdate,
+		orgdomain.PermissionOrgDelete,
+		orgdomain.PermissionMemberRead,
+		orgdomain.PermissionMemberManage,
+		orgdomain.PermissionTeamManage,
+		orgdomain.PermissionTaskRead,
+		orgdomain.PermissionTaskReadShared,
+		orgdomain.PermissionTaskCreate,
+		orgdomain.PermissionTaskUpdate,
+		orgdomain.PermissionTaskDelete,
+		orgdomain.PermissionCommentCreate,
+	),
+	orgdomain.RoleAdmin: permissionSet(
+		orgdomain.PermissionOrgRead,
+		orgdomain.PermissionOrgUpdate,
+		orgdomain.PermissionMemberRead,
+		orgdomain.PermissionMemberManage,
+		orgdomain.PermissionTeamManage,
+		orgdomain.PermissionTaskRead,
+		orgdomain.PermissionTaskReadShared,
+		orgdomain.PermissionTaskCreate,
+		orgdomain.PermissionTaskUpdate,
+		orgdomain.PermissionTaskDelete,
+		orgdomain.PermissionCommentCreate,
+	),
+	orgdomain.RoleMember: permissionSet(
+		orgdomain.PermissionOrgRead,
+		orgdomain.PermissionMemberRead,
+		orgdomain.PermissionTaskRead,
+		orgdomain.PermissionTaskReadShared,
+		orgdomain.PermissionTaskCreate,
+		orgdomain.PermissionTaskUpdate,
+		orgdomain.PermissionCommentCreate,
+	),
+	// Guests only read the tasks shared with them
+	orgdomain.RoleGuest: permissionSet(
+		orgdomain.PermissionOrgRead,
+		orgdomain.PermissionTaskReadShared,
+		orgdomain.PermissionCommentCreate,
+	),
+}
+
+func permissionSet(permissions ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(permissions))
+	for _, permission := range permissions {
+		set[permission] = struct{}{}
+	}
+	return set
+}
+
+// RoleHasPermission reports whether role grants permission. An empty
+// permission only asks for membership and is granted to every role.
+func RoleHasPermission(role, permission string) bool {
+	permissions, ok := rolePermissions[role]
+	if !ok {
+		return false
+	}
+	if permission == "" {
+		return true
+	}
+	_, ok = permissions[permission]
+	return ok
+}
+
+func (s *Service) GetMember(ctx context.Context, organizationID, userID uuid.UUID) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	if err := s.db.WithContext(ctx).
+		First(&member, "organization_id = ? AND user_id = ?", organizationID, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMemberNotFound
+		}
+		return nil, err
+	}
+	return &member, nil
+}
+
+// CheckPermission reports whether the user may act with permission in the
+// organization. A nil member means the user never joined; members who are
+// not active are returned but granted nothing.
+func (s *Service) CheckPermission(ctx context.Context, organizationID, userID uuid.UUID, permission string) (*models.OrganizationMember, bool, error) {
+	if _, ok := orgdomain.PermissionSet[permission]; !ok && permission != "" {
+		return nil, false, ErrInvalidPermission
+	}
+
+	member, err := s.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, ErrMemberNotFound) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	if member.Status != orgdomain.MemberStatusActive {
+		return member, false, nil
+	}
+	return member, RoleHasPermission(member.Role, permission), nil
+}
+
+// authorize checks the calling user, when the request carries one, holds
+// permission. Calls from other services without a user are trusted.
+func (s *Service) authorize(ctx context.Context, organizationID uuid.UUID, permission string) (*models.OrganizationMember, error) {
+	caller, ok := authctx.IncomingUser(ctx)
+	if !ok || caller.ID == "" {
+		return nil, nil
+	}
+	callerID, err := uuid.Parse(caller.ID)
+	if err != nil {
+		return nil, ErrPermissionDenied
+	}
+	member, allowed, err := s.CheckPermission(ctx, organizationID, callerID, permission)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, ErrPermissionDenied
+	}
+	return member, nil
+}
+
+// authorizeRoleChange guards moving a member from role from to role to, where
+// an empty from is a new member and an empty to a removal. The owner role is
+// not assignable and only the owner manages admins.
+func authorizeRoleChange(actor *models.OrganizationMember, from, to string) error {
+	if from == orgdomain.RoleOwner {
+		return ErrOwnerMembership
+	}
+	if to == orgdomain.RoleOwner {
+		return ErrOwnerRoleReserved
+	}
+	if actor == nil || actor.Role == orgdomain.RoleOwner {
+		return nil
+	}
+	if from == orgdomain.RoleAdmin || to == orgdomain.RoleAdmin {
+		return ErrAdminRoleForbidden
+	}
+	return nil
+}
+
+func normalizeRole(role string) (string, error) {
+	if role == "" {
+		return orgdomain.RoleMember, nil
+	}
+	if _, ok := orgdomain.RoleSet[role]; !ok {
+		return "", ErrInvalidRole
+	}
+	return role, nil
+}
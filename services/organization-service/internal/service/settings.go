@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidSettings         = errors.New("invalid organization settings")
+	ErrSettingsVersionConflict = errors.New("organization settings changed since they were read")
+)
+
+// GetSettings returns the organization with its settings. Members with
+// org.read and internal callers can read them.
+func (s *Service) GetSettings(ctx context.Context, organizationID uuid.UUID) (*models.Organization, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgRead); err != nil {
+		return nil, err
+	}
+	return s.GetOrganization(ctx, organizationID)
+}
+
+// UpdateSettings replaces the organization's settings document. A non-zero
+// expectedVersion makes the update fail when someone changed the settings
+// in between.
+func (s *Service) UpdateSettings(ctx context.Context, organizationID uuid.UUID, settings orgdomain.Settings, expectedVersion int64) (*models.Organization, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+
+	settings.Normalize()
+	if err := settings.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSettings, err)
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Organization{}).Where("id = ?", org.ID)
+		if expectedVersion != 0 {
+			query = query.Where("settings_version = ?", expectedVersion)
+		}
+		result := query.Updates(map[string]interface{}{
+			"settings":           models.OrganizationSettings(settings),
+			"require_two_factor": settings.Security.RequireTwoFactor,
+			"settings_version":   gorm.Expr("settings_version + 1"),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSettingsVersionConflict
+		}
+		return tx.First(org, "id = ?", org.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishSettingsUpdated(ctx, org)
+	return org, nil
+}
+
+func (s *Service) publishSettingsUpdated(ctx context.Context, org *models.Organization) {
+	actorID := triggeredBy(ctx)
+	s.publish("settings_updated", org.ID, func(p event.OrganizationEventPublisher) error {
+		return p.SettingsUpdated(ctx, org, actorID)
+	})
+}
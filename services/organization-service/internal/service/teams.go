@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTeamNotFound       = errors.New("team not found")
+	ErrTeamExists         = errors.New("a team with this name already exists")
+	ErrInvalidTeamName    = errors.New("team name must be 1-100 characters")
+	ErrTeamMemberNotInOrg = errors.New("team members must be active members of the organization, not guests")
+	ErrTeamMemberNotFound = errors.New("user is not a member of this team")
+)
+
+const (
+	maxTeamNameLength = 100
+	// Seeing teams and who is in them is part of seeing the member list
+	teamReadPermission = orgdomain.PermissionMemberRead
+)
+
+type CreateTeamInput struct {
+	OrganizationID uuid.UUID
+	Name           string
+	Description    string
+	LeadID         uuid.UUID
+	MemberIDs      []uuid.UUID
+}
+
+// CreateTeam adds a team to the organization. The lead, when set, is added to
+// the team.
+func (s *Service) CreateTeam(ctx context.Context, input CreateTeamInput) (*models.Team, error) {
+	if _, err := s.authorize(ctx, input.OrganizationID, orgdomain.PermissionTeamManage); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org.PendingDeletion() {
+		return nil, ErrOrganizationPendingDeletion
+	}
+	name, err := normalizeTeamName(input.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureTeamNameFree(ctx, org.ID, name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	memberIDs := input.MemberIDs
+	if input.LeadID != uuid.Nil {
+		memberIDs = append(memberIDs, input.LeadID)
+	}
+	memberIDs = uniqueUUIDs(memberIDs)
+	if err := s.requireActiveMembers(ctx, org.ID, memberIDs); err != nil {
+		return nil, err
+	}
+
+	team := &models.Team{
+		OrganizationID: org.ID,
+		Name:           name,
+		Description:    strings.TrimSpace(input.Description),
+	}
+	if input.LeadID != uuid.Nil {
+		leadID := input.LeadID
+		team.LeadID = &leadID
+	}
+	for _, userID := range memberIDs {
+		team.Members = append(team.Members, models.TeamMember{UserID: userID})
+	}
+	if err := s.db.WithContext(ctx).Create(team).Error; err != nil {
+		return nil, err
+	}
+	return team, nil
+}
+
+// GetTeam returns a team of the organization with its members
+func (s *Service) GetTeam(ctx context.Context, organizationID, id uuid.UUID) (*models.Team, error) {
+	if _, err := s.authorize(ctx, organizationID, teamReadPermission); err != nil {
+		return nil, err
+	}
+	return s.getTeam(ctx, organizationID, id)
+}
+
+// ListTeams lists the organization's teams, limited to the teams of userID
+// when it is set
+func (s *Service) ListTeams(ctx context.Context, organizationID, userID uuid.UUID) ([]models.Team, error) {
+	if _, err := s.authorize(ctx, organizationID, teamReadPermission); err != nil {
+		return nil, err
+	}
+
+	query := s.db.WithContext(ctx).Preload("Members").Where("organization_id = ?", organizationID)
+	if userID != uuid.Nil {
+		query = query.Where("id IN (?)", s.db.Model(&models.TeamMember{}).Select("team_id").Where("user_id = ?", userID))
+	}
+
+	var teams []models.Team
+	if err := query.Order("name ASC").Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+type UpdateTeamInput struct {
+	Name        *string
+	Description *string
+	// LeadID replaces the lead, uuid.Nil removes it
+	LeadID *uuid.UUID
+}
+
+// UpdateTeam changes a team's name, description or lead. A new lead joins the
+// team if they aren't in it yet.
+func (s *Service) UpdateTeam(ctx context.Context, organizationID, id uuid.UUID, input UpdateTeamInput) (*models.Team, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionTeamManage); err != nil {
+		return nil, err
+	}
+	team, err := s.getTeam(ctx, organizationID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if input.Name != nil {
+		name, err := normalizeTeamName(*input.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.ensureTeamNameFree(ctx, organizationID, name, team.ID); err != nil {
+			return nil, err
+		}
+		updates["name"] = name
+	}
+	if input.Description != nil {
+		updates["description"] = strings.TrimSpace(*input.Description)
+	}
+	var newLead *uuid.UUID
+	if input.LeadID != nil {
+		if *input.LeadID == uuid.Nil {
+			updates["lead_id"] = nil
+		} else {
+			if err := s.requireActiveMembers(ctx, organizationID, []uuid.UUID{*input.LeadID}); err != nil {
+				return nil, err
+			}
+			leadID := *input.LeadID
+			newLead = &leadID
+			updates["lead_id"] = leadID
+		}
+	}
+	if len(updates) == 0 {
+		return team, nil
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(team).Updates(updates).Error; err != nil {
+			return err
+		}
+		if newLead == nil {
+			return nil
+		}
+		return tx.FirstOrCreate(&models.TeamMember{}, models.TeamMember{TeamID: team.ID, UserID: *newLead}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.getTeam(ctx, organizationID, id)
+}
+
+// DeleteTeam removes a team. Tasks assigned to it keep the team id and stop
+// notifying anyone through it.
+func (s *Service) DeleteTeam(ctx context.Context, organizationID, id uuid.UUID) error {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionTeamManage); err != nil {
+		return err
+	}
+	team, err := s.getTeam(ctx, organizationID, id)
+	if err != nil {
+		return err
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("team_id = ?", team.ID).Delete(&models.TeamMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(team).Error
+	})
+}
+
+// AddTeamMember adds an active organization member to a team
+func (s *Service) AddTeamMember(ctx context.Context, organizationID, teamID, userID uuid.UUID) (*models.Team, error) {
+	team, err := s.getTeam(ctx, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeTeamMembership(ctx, team); err != nil {
+		return nil, err
+	}
+	if err := s.requireActiveMembers(ctx, organizationID, []uuid.UUID{userID}); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.WithContext(ctx).
+		FirstOrCreate(&models.TeamMember{}, models.TeamMember{TeamID: team.ID, UserID: userID}).Error; err != nil {
+		return nil, err
+	}
+	return s.getTeam(ctx, organizationID, teamID)
+}
+
+// RemoveTeamMember takes a user out of a team, removing the lead clears it
+func (s *Service) RemoveTeamMember(ctx context.Context, organizationID, teamID, userID uuid.UUID) (*models.Team, error) {
+	team, err := s.getTeam(ctx, organizationID, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeTeamMembership(ctx, team); err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("team_id = ? AND user_id = ?", team.ID, userID).Delete(&models.TeamMember{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTeamMemberNotFound
+		}
+		if team.LeadID != nil && *team.LeadID == userID {
+			return tx.Model(team).Update("lead_id", nil).Error
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.getTeam(ctx, organizationID, teamID)
+}
+
+// removeFromTeams drops a departing organization member from its teams
+func removeFromTeams(tx *gorm.DB, organizationID, userID uuid.UUID) error {
+	teamIDs := tx.Model(&models.Team{}).Select("id").Where("organization_id = ?", organizationID)
+	if err := tx.Where("user_id = ? AND team_id IN (?)", userID, teamIDs).Delete(&models.TeamMember{}).Error; err != nil {
+		return err
+	}
+	return tx.Model(&models.Team{}).
+		Where("organization_id = ? AND lead_id = ?", organizationID, userID).
+		Update("lead_id", nil).Error
+}
+
+// authorizeTeamMembership lets team leads manage their own team next to
+// members holding team.manage
+func (s *Service) authorizeTeamMembership(ctx context.Context, team *models.Team) error {
+	actor, err := s.authorize(ctx, team.OrganizationID, teamReadPermission)
+	if err != nil {
+		return err
+	}
+	if actor == nil || RoleHasPermission(actor.Role, orgdomain.PermissionTeamManage) {
+		return nil
+	}
+	if team.LeadID != nil && *team.LeadID == actor.UserID {
+		return nil
+	}
+	return ErrPermissionDenied
+}
+
+func (s *Service) getTeam(ctx context.Context, organizationID, id uuid.UUID) (*models.Team, error) {
+	var team models.Team
+	if err := s.db.WithContext(ctx).Preload("Members").
+		First(&team, "id = ? AND organization_id = ?", id, organizationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTeamNotFound
+		}
+		return nil, err
+	}
+	return &team, nil
+}
+
+// ensureTeamNameFree checks no other team of the organization uses name,
+// ignoring case
+func (s *Service) ensureTeamNameFree(ctx context.Context, organizationID uuid.UUID, name string, exceptID uuid.UUID) error {
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.Team{}).
+		Where("organization_id = ? AND LOWER(name) = LOWER(?) AND id <> ?", organizationID, name, exceptID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTeamExists
+	}
+	return nil
+}
+
+// requireActiveMembers checks every user is an active member of the
+// organization. Guests only see the tasks shared with them, so they can't
+// join teams tasks get assigned to.
+func (s *Service) requireActiveMembers(ctx context.Context, organizationID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	var count int64
+	if err := s.db.WithContext(ctx).Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND status = ? AND role <> ? AND user_id IN ?", organizationID, orgdomain.MemberStatusActive, orgdomain.RoleGuest, userIDs).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) != len(userIDs) {
+		return ErrTeamMemberNotInOrg
+	}
+	return nil
+}
+
+func normalizeTeamName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxTeamNameLength {
+		return "", ErrInvalidTeamName
+	}
+	return name, nil
+}
+
+func uniqueUUIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id == uuid.Nil {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aliirah/task-flow/services/organization-service/internal/event"
+	"github.com/aliirah/task-flow/services/organization-service/internal/models"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInvalidPlan        = errors.New("invalid plan")
+	ErrMemberLimitReached = errors.New("organization has reached its member limit")
+)
+
+// Usage is what an organization uses next to the limits of its plan
+type Usage struct {
+	OrganizationID uuid.UUID
+	Plan           string
+	Limits         orgdomain.Limits
+	Members        int64
+	Tasks          int64
+	StorageBytes   int64
+	UpdatedAt      time.Time
+}
+
+// GetUsage returns the organization's usage. Members with org.update and
+// internal callers can read it.
+func (s *Service) GetUsage(ctx context.Context, organizationID uuid.UUID) (*Usage, error) {
+	if _, err := s.authorize(ctx, organizationID, orgdomain.PermissionOrgUpdate); err != nil {
+		return nil, err
+	}
+	org, err := s.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	db := s.db.WithContext(ctx)
+	members, err := countMembers(db, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	var counters models.OrganizationUsage
+	err = db.Where("organization_id = ?", org.ID).First(&counters).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	usage := &Usage{
+		OrganizationID: org.ID,
+		Plan:           org.Plan,
+		Limits:         orgdomain.PlanLimits(org.Plan),
+		Members:        members,
+		Tasks:          counters.Tasks,
+		StorageBytes:   counters.StorageBytes,
+		UpdatedAt:      counters.UpdatedAt,
+	}
+	if usage.UpdatedAt.IsZero() {
+		usage.UpdatedAt = org.CreatedAt
+	}
+	return usage, nil
+}
+
+// SetPlan moves the organization to another plan. Plans are changed by
+// billing, so only internal callers may set them.
+func (s *Service) SetPlan(ctx context.Context, organizationID uuid.UUID, plan string) (*Usage, error) {
+	if triggeredBy(ctx) != "" {
+		return nil, ErrPermissionDenied
+	}
+	if _, ok := orgdomain.PlanSet[plan]; !ok {
+		return nil, ErrInvalidPlan
+	}
+	org, err := s.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if org.Plan != plan {
+		if err := s.db.WithContext(ctx).Model(org).Update("plan", plan).Error; err != nil {
+			return nil, err
+		}
+		s.publish("updated", org.ID, func(p event.OrganizationEventPublisher) error {
+			return p.OrganizationUpdated(ctx, org, "")
+		})
+	}
+	return s.GetUsage(ctx, org.ID)
+}
+
+// RecordTaskUsage counts the task in or out of the organization's task
+// counter. Tasks are tracked by id, so a redelivered event changes nothing,
+// and the counter never drops below zero.
+func (s *Service) RecordTaskUsage(ctx context.Context, organizationID, taskID uuid.UUID, counted bool) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var delta int64 = 1
+		if counted {
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.CountedTask{
+				TaskID:         taskID,
+				OrganizationID: organizationID,
+			})
+			if result.Error != nil || result.RowsAffected == 0 {
+				return result.Error
+			}
+		} else {
+			// Uncount the task where it was counted
+			var task models.CountedTask
+			if err := tx.First(&task, "task_id = ?", taskID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil
+				}
+				return err
+			}
+			if err := tx.Delete(&task).Error; err != nil {
+				return err
+			}
+			organizationID = task.OrganizationID
+			delta = -1
+		}
+		return addTaskUsage(tx, organizationID, delta)
+	})
+}
+
+// MoveTaskUsage moves a counted task to the counter of the organization it
+// now belongs to. Tasks that are not counted are left alone, and a
+// redelivered move finds the task already moved.
+func (s *Service) MoveTaskUsage(ctx context.Context, organizationID, taskID uuid.UUID) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var task models.CountedTask
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&task, "task_id = ?", taskID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+		if task.OrganizationID == organizationID {
+			return nil
+		}
+
+		previous := task.OrganizationID
+		if err := tx.Model(&task).Update("organization_id", organizationID).Error; err != nil {
+			return err
+		}
+		if err := addTaskUsage(tx, previous, -1); err != nil {
+			return err
+		}
+		return addTaskUsage(tx, organizationID, 1)
+	})
+}
+
+// addTaskUsage adds delta to the organization's task counter, which never
+// drops below zero
+func addTaskUsage(tx *gorm.DB, organizationID uuid.UUID, delta int64) error {
+	initial := delta
+	if initial < 0 {
+		initial = 0
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "organization_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"tasks":      gorm.Expr("GREATEST(organization_usages.tasks + ?, 0)", delta),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&models.OrganizationUsage{
+		OrganizationID: organizationID,
+		Tasks:          initial,
+	}).Error
+}
+
+// checkMemberLimit fails when the organization has no room for another
+// member. It locks the organization row until tx ends, so concurrent joins
+// are counted one after the other.
+func checkMemberLimit(tx *gorm.DB, organizationID uuid.UUID) error {
+	var org models.Organization
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id", "plan").
+		First(&org, "id = ?", organizationID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrOrganizationNotFound
+		}
+		return err
+	}
+	members, err := countMembers(tx, organizationID)
+	if err != nil {
+		return err
+	}
+	if !orgdomain.WithinLimit(orgdomain.PlanLimits(org.Plan).MaxMembers, members) {
+		return ErrMemberLimitReached
+	}
+	return nil
+}
+
+// countMembers counts the memberships that hold a seat, which is all but the
+// ones that left
+func countMembers(tx *gorm.DB, organizationID uuid.UUID) (int64, error) {
+	var count int64
+	err := tx.Model(&models.OrganizationMember{}).
+		Where("organization_id = ? AND status <> ?", organizationID, orgdomain.MemberStatusLeft).
+		Count(&count).Error
+	return count, err
+}
@@ -49,6 +49,12 @@ func (c *Consumer) Start() error {
 		}
 	}()
 
+	go func() {
+		if err := c.rabbit.ConsumeMessages(messaging.OrganizationSearchEventsQueue, c.handleOrganizationEvent); err != nil {
+			log.S().Errorw("organization search consumer stopped", "error", err)
+		}
+	}()
+
 	return nil
 }
 
@@ -65,6 +71,7 @@ func (c *Consumer) handleTaskEvent(ctx context.Context, msg amqp.Delivery) error
 			return fmt.Errorf("unmarshal task created event: %w", err)
 		}
 		doc := mapTaskToDocument(event.TaskID, event.OrganizationID, event.Title, event.Description, event.Assignee, event.Reporter)
+		doc.SharedWith = amqpMsg.SharedWith
 		return c.search.UpsertDocument(ctx, doc)
 	case contracts.TaskEventUpdated:
 		var event contracts.TaskUpdatedEvent
@@ -72,13 +79,35 @@ func (c *Consumer) handleTaskEvent(ctx context.Context, msg amqp.Delivery) error
 			return fmt.Errorf("unmarshal task updated event: %w", err)
 		}
 		doc := mapTaskToDocument(event.TaskID, event.OrganizationID, event.Title, event.Description, event.Assignee, event.Reporter)
-		return c.search.UpsertDocument(ctx, doc)
+		doc.SharedWith = amqpMsg.SharedWith
+		if err := c.search.UpsertDocument(ctx, doc); err != nil {
+			return err
+		}
+		if event.Changes != nil && event.Changes.Sharing != nil {
+			return c.search.UpdateTaskSharing(ctx, event.TaskID, amqpMsg.SharedWith)
+		}
+		return nil
 	case contracts.TaskEventDeleted:
 		var event contracts.TaskDeletedEvent
 		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
 			return fmt.Errorf("unmarshal task deleted event: %w", err)
 		}
 		return c.search.DeleteDocument(ctx, search.DocumentTypeTask, event.TaskID)
+	case contracts.TaskEventArchived:
+		var event contracts.TaskArchivedEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			return fmt.Errorf("unmarshal task archived event: %w", err)
+		}
+		// Archived tasks are hidden from search until restored
+		return c.search.DeleteDocument(ctx, search.DocumentTypeTask, event.TaskID)
+	case contracts.TaskEventRestored:
+		var event contracts.TaskRestoredEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			return fmt.Errorf("unmarshal task restored event: %w", err)
+		}
+		doc := mapTaskToDocument(event.TaskID, event.OrganizationID, event.Title, event.Description, event.Assignee, event.Reporter)
+		doc.SharedWith = amqpMsg.SharedWith
+		return c.search.UpsertDocument(ctx, doc)
 	default:
 		return nil
 	}
@@ -97,6 +126,7 @@ func (c *Consumer) handleCommentEvent(ctx context.Context, msg amqp.Delivery) er
 			return fmt.Errorf("unmarshal comment created event: %w", err)
 		}
 		doc := mapCommentToDocument(event.CommentID, event.TaskID, event.OrganizationID, event.Content, event.User)
+		doc.SharedWith = amqpMsg.SharedWith
 		return c.search.UpsertDocument(ctx, doc)
 	case contracts.CommentEventUpdated:
 		var event contracts.CommentUpdatedEvent
@@ -104,6 +134,7 @@ func (c *Consumer) handleCommentEvent(ctx context.Context, msg amqp.Delivery) er
 			return fmt.Errorf("unmarshal comment updated event: %w", err)
 		}
 		doc := mapCommentToDocument(event.CommentID, event.TaskID, event.OrganizationID, event.Content, event.User)
+		doc.SharedWith = amqpMsg.SharedWith
 		return c.search.UpsertDocument(ctx, doc)
 	case contracts.CommentEventDeleted:
 		var event contracts.CommentDeletedEvent
@@ -141,6 +172,24 @@ func (c *Consumer) handleUserEvent(ctx context.Context, msg amqp.Delivery) error
 	}
 }
 
+func (c *Consumer) handleOrganizationEvent(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("unmarshal organization amqp message: %w", err)
+	}
+
+	switch amqpMsg.EventType {
+	case contracts.OrganizationEventDeleted:
+		var event contracts.OrganizationDeletedEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			return fmt.Errorf("unmarshal organization deleted event: %w", err)
+		}
+		return c.search.DeleteOrganizationDocuments(ctx, event.OrganizationID)
+	default:
+		return nil
+	}
+}
+
 func mapTaskToDocument(taskID, orgID, title, description string, assignee, reporter *contracts.TaskUser) search.Document {
 	metadata := map[string]string{}
 	if assignee != nil {
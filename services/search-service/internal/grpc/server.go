@@ -23,7 +23,7 @@ func (s *Server) Search(ctx context.Context, req *searchpb.SearchRequest) (*sear
 		return nil, fmt.Errorf("missing request")
 	}
 	docTypes := search.ParseDocumentTypes(req.Types)
-	resp, err := s.searchSvc.Search(ctx, req.Query, docTypes, int(req.Limit), req.OrganizationId, req.UserId)
+	resp, err := s.searchSvc.Search(ctx, req.Query, docTypes, int(req.Limit), req.OrganizationId, req.UserId, req.SharedOnly)
 	if err != nil {
 		return nil, err
 	}
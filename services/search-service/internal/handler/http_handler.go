@@ -55,7 +55,9 @@ func (h *Handler) handleSearch(c *gin.Context) {
 
 	docTypes := search.ParseDocumentTypes(strings.Split(c.Query("types"), ","))
 
-	results, err := h.search.Search(c.Request.Context(), query, docTypes, limit, orgID, userID)
+	sharedOnly := c.Query("sharedOnly") == "true"
+
+	results, err := h.search.Search(c.Request.Context(), query, docTypes, limit, orgID, userID, sharedOnly)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -108,6 +108,7 @@ func (r *Reindexer) reindexTasks(ctx context.Context) (map[string]*taskpb.Task,
 					"status":   task.Status,
 					"priority": task.Priority,
 				},
+				SharedWith: task.SharedWith,
 			}
 			if err := r.search.UpsertDocument(ctx, doc); err != nil {
 				return nil, fmt.Errorf("index task %s: %w", task.Id, err)
@@ -156,6 +157,7 @@ func (r *Reindexer) reindexComments(ctx context.Context, tasks map[string]*taskp
 					OrganizationID: task.OrganizationId,
 					TaskID:         taskID,
 					UserID:         comment.UserId,
+					SharedWith:     task.SharedWith,
 				}
 				if err := r.search.UpsertDocument(ctx, doc); err != nil {
 					return fmt.Errorf("index comment %s: %w", comment.Id, err)
@@ -50,6 +50,8 @@ type Document struct {
 	UserID         string            `json:"userId,omitempty"`
 	Suggest        []string          `json:"suggest,omitempty"`
 	Metadata       map[string]string `json:"metadata,omitempty"`
+	// SharedWith lists the guests who may find a task or comment
+	SharedWith []string `json:"sharedWith,omitempty"`
 }
 
 type Service struct {
@@ -121,6 +123,7 @@ func (s *Service) EnsureIndex(ctx context.Context) error {
 				"taskId":         map[string]interface{}{"type": "keyword"},
 				"userId":         map[string]interface{}{"type": "keyword"},
 				"metadata":       map[string]interface{}{"type": "object"},
+				"sharedWith":     map[string]interface{}{"type": "keyword"},
 			},
 		},
 	}
@@ -195,7 +198,39 @@ func (s *Service) DeleteDocument(ctx context.Context, docType DocumentType, id s
 	return nil
 }
 
-func (s *Service) Search(ctx context.Context, query string, types []DocumentType, limit int, organizationID, userID string) (*contracts.SearchResponse, error) {
+// DeleteOrganizationDocuments removes every task and comment indexed for an
+// organization
+func (s *Service) DeleteOrganizationDocuments(ctx context.Context, organizationID string) error {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"term": map[string]interface{}{
+				"organizationId": organizationID,
+			},
+		},
+	})
+
+	res, err := s.performRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/_delete_by_query?conflicts=proceed", s.endpoint, s.indexName), payload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if res.StatusCode >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("delete by query error: %s", string(body))
+	}
+	return nil
+}
+
+// Search finds documents of an organization. With sharedOnly the results are
+// limited to the tasks and comments shared with userID, for guests. Both are
+// filtered by Elasticsearch, so pages are full and the total only counts
+// documents the caller may see.
+func (s *Service) Search(ctx context.Context, query string, types []DocumentType, limit int, organizationID, userID string, sharedOnly bool) (*contracts.SearchResponse, error) {
 	if limit <= 0 || limit > 100 {
 		limit = 20
 	}
@@ -223,7 +258,27 @@ func (s *Service) Search(ctx context.Context, query string, types []DocumentType
 	}
 
 	boolQuery := body["query"].(map[string]interface{})["bool"].(map[string]interface{})
-	filters := make([]interface{}, 0, 1)
+	filters := make([]interface{}, 0, 3)
+	// Users belong to no organization and stay searchable by members
+	if organizationID != "" {
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []interface{}{
+					map[string]interface{}{"term": map[string]interface{}{"organizationId": organizationID}},
+					map[string]interface{}{"term": map[string]interface{}{"type": string(DocumentTypeUser)}},
+				},
+				"minimum_should_match": 1,
+			},
+		})
+	}
+	// Only tasks and comments carry sharedWith, so guests never see users
+	if sharedOnly {
+		filters = append(filters, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"sharedWith": []string{userID},
+			},
+		})
+	}
 	if len(types) > 0 {
 		typeStrings := make([]string, 0, len(types))
 		for _, t := range types {
@@ -284,11 +339,6 @@ func (s *Service) Search(ctx context.Context, query string, types []DocumentType
 		if hl, ok := hit.Highlight["content"]; ok && len(hl) > 0 {
 			summary = strings.Join(hl, " ... ")
 		}
-		if organizationID != "" && doc.Type != DocumentTypeUser {
-			if doc.OrganizationID == "" || doc.OrganizationID != organizationID {
-				continue
-			}
-		}
 		resultItem := contracts.SearchResult{
 			ID:             doc.ID,
 			Type:           string(doc.Type),
@@ -315,7 +365,7 @@ func (s *Service) Suggest(ctx context.Context, query string, limit int, organiza
 		limit = 8
 	}
 
-	resp, err := s.Search(ctx, query, nil, limit*3, organizationID, userID)
+	resp, err := s.Search(ctx, query, nil, limit*3, organizationID, userID, false)
 	if err != nil {
 		return nil, err
 	}
@@ -339,6 +389,44 @@ func (s *Service) Suggest(ctx context.Context, query string, limit int, organiza
 	return results, nil
 }
 
+// UpdateTaskSharing copies the guests a task is shared with onto its indexed
+// comments
+func (s *Service) UpdateTaskSharing(ctx context.Context, taskID string, sharedWith []string) error {
+	if sharedWith == nil {
+		sharedWith = []string{}
+	}
+	payload, _ := json.Marshal(map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": []interface{}{
+					map[string]interface{}{"term": map[string]interface{}{"type": string(DocumentTypeComment)}},
+					map[string]interface{}{"term": map[string]interface{}{"taskId": taskID}},
+				},
+			},
+		},
+		"script": map[string]interface{}{
+			"source": "ctx._source.sharedWith = params.sharedWith",
+			"params": map[string]interface{}{"sharedWith": sharedWith},
+		},
+	})
+
+	res, err := s.performRequest(ctx, http.MethodPost, fmt.Sprintf("%s/%s/_update_by_query?conflicts=proceed", s.endpoint, s.indexName), payload)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	if res.StatusCode >= 300 {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("update by query error: %s", string(body))
+	}
+	return nil
+}
+
 func (s *Service) performRequest(ctx context.Context, method, url string, payload []byte) (*http.Response, error) {
 	var body io.Reader
 	if payload != nil {
@@ -15,7 +15,7 @@ import (
 type CommentEventPublisher interface {
 	CommentCreated(ctx context.Context, comment *models.Comment, task *models.Task, user *userpb.User) error
 	CommentUpdated(ctx context.Context, comment *models.Comment, task *models.Task, user *userpb.User) error
-	CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, user *userpb.User) error
+	CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, sharedWith []string, user *userpb.User) error
 }
 
 // NewCommentPublisher builds a RabbitMQ-backed CommentEventPublisher
@@ -40,7 +40,7 @@ func (noopCommentPublisher) CommentUpdated(ctx context.Context, comment *models.
 	return nil
 }
 
-func (noopCommentPublisher) CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, user *userpb.User) error {
+func (noopCommentPublisher) CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, sharedWith []string, user *userpb.User) error {
 	return nil
 }
 
@@ -78,6 +78,7 @@ func (p *commentPublisher) CommentCreated(ctx context.Context, comment *models.C
 		UserID:         comment.UserID.String(),
 		EventType:      contracts.CommentEventCreated,
 		Data:           data,
+		SharedWith:     task.SharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "comment."+task.OrganizationID.String(), msg)
@@ -117,12 +118,13 @@ func (p *commentPublisher) CommentUpdated(ctx context.Context, comment *models.C
 		UserID:         comment.UserID.String(),
 		EventType:      contracts.CommentEventUpdated,
 		Data:           data,
+		SharedWith:     task.SharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "comment."+task.OrganizationID.String(), msg)
 }
 
-func (p *commentPublisher) CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, user *userpb.User) error {
+func (p *commentPublisher) CommentDeleted(ctx context.Context, commentID, taskID, organizationID, userID string, sharedWith []string, user *userpb.User) error {
 	eventData := contracts.CommentDeletedEvent{
 		CommentID:      commentID,
 		TaskID:         taskID,
@@ -149,6 +151,7 @@ func (p *commentPublisher) CommentDeleted(ctx context.Context, commentID, taskID
 		UserID:         userID,
 		EventType:      contracts.CommentEventDeleted,
 		Data:           data,
+		SharedWith:     sharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "comment."+organizationID, msg)
@@ -0,0 +1,50 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	"github.com/google/uuid"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// OrganizationPurger removes the task data of a deleted organization
+type OrganizationPurger interface {
+	PurgeOrganization(ctx context.Context, organizationID uuid.UUID) error
+}
+
+// OrganizationConsumer purges tasks once their organization is deleted
+type OrganizationConsumer struct {
+	rabbitmq *messaging.RabbitMQ
+	purger   OrganizationPurger
+}
+
+func NewOrganizationConsumer(rabbitmq *messaging.RabbitMQ, purger OrganizationPurger) *OrganizationConsumer {
+	return &OrganizationConsumer{rabbitmq: rabbitmq, purger: purger}
+}
+
+func (c *OrganizationConsumer) Listen() error {
+	log.S().Info("task organization consumer listening for events")
+	return c.rabbitmq.ConsumeMessages(messaging.OrganizationTaskEventsQueue, c.handle)
+}
+
+func (c *OrganizationConsumer) handle(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("unmarshal organization amqp message: %w", err)
+	}
+	if amqpMsg.EventType != contracts.OrganizationEventDeleted {
+		return nil
+	}
+
+	organizationID, err := uuid.Parse(amqpMsg.OrganizationID)
+	if err != nil {
+		log.S().Warnw("task organization consumer skipping event", "eventType", amqpMsg.EventType, "organizationId", amqpMsg.OrganizationID)
+		return nil
+	}
+	return c.purger.PurgeOrganization(ctx, organizationID)
+}
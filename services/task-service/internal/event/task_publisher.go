@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aliirah/task-flow/services/task-service/internal/models"
 	"github.com/aliirah/task-flow/shared/contracts"
@@ -15,8 +16,10 @@ import (
 // TaskEventPublisher describes the behaviour required to broadcast task lifecycle events.
 type TaskEventPublisher interface {
 	TaskCreated(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser) error
-	TaskUpdated(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser) error
+	TaskUpdated(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser, changes *contracts.TaskChanges) error
 	TaskDeleted(ctx context.Context, task *models.Task, reporter, assignee *userpb.User) error
+	TaskArchived(ctx context.Context, task *models.Task, purgeAt time.Time, triggeredBy *contracts.TaskUser) error
+	TaskRestored(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser) error
 }
 
 // NewTaskPublisher builds a RabbitMQ-backed TaskEventPublisher
@@ -47,6 +50,9 @@ func (p *taskPublisher) TaskCreated(ctx context.Context, task *models.Task, repo
 		ReporterID:     task.ReporterID.String(),
 	}
 
+	if task.AssigneeTeamID != nil {
+		eventData.AssigneeTeamID = task.AssigneeTeamID.String()
+	}
 	if triggeredBy != nil {
 		eventData.TriggeredByID = triggeredBy.ID
 		eventData.TriggeredBy = triggeredBy
@@ -92,12 +98,13 @@ func (p *taskPublisher) TaskCreated(ctx context.Context, task *models.Task, repo
 		UserID:         task.AssigneeID.String(),
 		EventType:      contracts.TaskEventCreated,
 		Data:           data,
+		SharedWith:     task.SharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "task."+task.OrganizationID.String(), msg)
 }
 
-func (p *taskPublisher) TaskUpdated(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser) error {
+func (p *taskPublisher) TaskUpdated(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser, changes *contracts.TaskChanges) error {
 	if p == nil || p.mq == nil || task == nil {
 		return nil
 	}
@@ -111,8 +118,14 @@ func (p *taskPublisher) TaskUpdated(ctx context.Context, task *models.Task, repo
 		Priority:       task.Priority,
 		AssigneeID:     task.AssigneeID.String(),
 		ReporterID:     task.ReporterID.String(),
+		ChecklistTotal: task.ChecklistTotal,
+		ChecklistDone:  task.ChecklistDone,
+		Changes:        changes,
 	}
 
+	if task.AssigneeTeamID != nil {
+		eventData.AssigneeTeamID = task.AssigneeTeamID.String()
+	}
 	if triggeredBy != nil {
 		eventData.TriggeredByID = triggeredBy.ID
 		eventData.TriggeredBy = triggeredBy
@@ -155,6 +168,7 @@ func (p *taskPublisher) TaskUpdated(ctx context.Context, task *models.Task, repo
 		UserID:         task.AssigneeID.String(),
 		EventType:      contracts.TaskEventUpdated,
 		Data:           data,
+		SharedWith:     task.SharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "task."+task.OrganizationID.String(), msg)
@@ -208,6 +222,108 @@ func (p *taskPublisher) TaskDeleted(ctx context.Context, task *models.Task, repo
 		UserID:         task.ReporterID.String(),
 		EventType:      contracts.TaskEventDeleted,
 		Data:           data,
+		SharedWith:     task.SharedWith,
+	}
+
+	return p.mq.PublishMessage(ctx, "task."+task.OrganizationID.String(), msg)
+}
+
+func (p *taskPublisher) TaskArchived(ctx context.Context, task *models.Task, purgeAt time.Time, triggeredBy *contracts.TaskUser) error {
+	if p == nil || p.mq == nil || task == nil {
+		return nil
+	}
+
+	eventData := &contracts.TaskArchivedEvent{
+		TaskID:         task.ID.String(),
+		OrganizationID: task.OrganizationID.String(),
+		Title:          task.Title,
+		Status:         task.Status,
+		ReporterID:     task.ReporterID.String(),
+		AssigneeID:     task.AssigneeID.String(),
+		PurgeAt:        purgeAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if triggeredBy != nil {
+		eventData.TriggeredByID = triggeredBy.ID
+		eventData.TriggeredBy = triggeredBy
+	}
+	if task.ArchivedAt.Valid {
+		eventData.ArchivedAt = task.ArchivedAt.Time.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	data, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("marshal task archived event: %w", err)
+	}
+
+	msg := contracts.AmqpMessage{
+		OrganizationID: task.OrganizationID.String(),
+		UserID:         task.AssigneeID.String(),
+		EventType:      contracts.TaskEventArchived,
+		Data:           data,
+		SharedWith:     task.SharedWith,
+	}
+
+	return p.mq.PublishMessage(ctx, "task."+task.OrganizationID.String(), msg)
+}
+
+func (p *taskPublisher) TaskRestored(ctx context.Context, task *models.Task, reporter, assignee *userpb.User, triggeredBy *contracts.TaskUser) error {
+	if p == nil || p.mq == nil || task == nil {
+		return nil
+	}
+
+	eventData := &contracts.TaskRestoredEvent{
+		TaskID:         task.ID.String(),
+		OrganizationID: task.OrganizationID.String(),
+		Title:          task.Title,
+		Description:    task.Description,
+		Status:         task.Status,
+		Priority:       task.Priority,
+		ReporterID:     task.ReporterID.String(),
+		AssigneeID:     task.AssigneeID.String(),
+	}
+
+	if triggeredBy != nil {
+		eventData.TriggeredByID = triggeredBy.ID
+		eventData.TriggeredBy = triggeredBy
+	}
+
+	if reporter != nil {
+		eventData.Reporter = &contracts.TaskUser{
+			ID:        reporter.Id,
+			FirstName: reporter.FirstName,
+			LastName:  reporter.LastName,
+			Email:     reporter.Email,
+		}
+	}
+
+	if assignee != nil {
+		eventData.Assignee = &contracts.TaskUser{
+			ID:        assignee.Id,
+			FirstName: assignee.FirstName,
+			LastName:  assignee.LastName,
+			Email:     assignee.Email,
+		}
+	}
+
+	if task.DueAt != nil {
+		eventData.DueAt = task.DueAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if !task.UpdatedAt.IsZero() {
+		eventData.RestoredAt = task.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	data, err := json.Marshal(eventData)
+	if err != nil {
+		return fmt.Errorf("marshal task restored event: %w", err)
+	}
+
+	msg := contracts.AmqpMessage{
+		OrganizationID: task.OrganizationID.String(),
+		UserID:         task.AssigneeID.String(),
+		EventType:      contracts.TaskEventRestored,
+		Data:           data,
+		SharedWith:     task.SharedWith,
 	}
 
 	return p.mq.PublishMessage(ctx, "task."+task.OrganizationID.String(), msg)
@@ -219,10 +335,18 @@ func (noopTaskPublisher) TaskCreated(context.Context, *models.Task, *userpb.User
 	return nil
 }
 
-func (noopTaskPublisher) TaskUpdated(context.Context, *models.Task, *userpb.User, *userpb.User, *contracts.TaskUser) error {
+func (noopTaskPublisher) TaskUpdated(context.Context, *models.Task, *userpb.User, *userpb.User, *contracts.TaskUser, *contracts.TaskChanges) error {
 	return nil
 }
 
 func (noopTaskPublisher) TaskDeleted(context.Context, *models.Task, *userpb.User, *userpb.User) error {
 	return nil
 }
+
+func (noopTaskPublisher) TaskArchived(context.Context, *models.Task, time.Time, *contracts.TaskUser) error {
+	return nil
+}
+
+func (noopTaskPublisher) TaskRestored(context.Context, *models.Task, *userpb.User, *userpb.User, *contracts.TaskUser) error {
+	return nil
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/services/task-service/internal/service"
+	"github.com/aliirah/task-flow/shared/authctx"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Checklist handlers
+func (h *TaskHandler) ListChecklistItems(ctx context.Context, req *taskpb.ListChecklistItemsRequest) (*taskpb.ListChecklistItemsResponse, error) {
+	taskID, err := parseUUID(req.GetTaskId())
+	if err != nil || taskID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	items, err := h.svc.ListChecklistItems(ctx, taskID, initiator)
+	if err != nil {
+		return nil, checklistError(err)
+	}
+
+	return &taskpb.ListChecklistItemsResponse{Items: toProtoChecklistItems(items)}, nil
+}
+
+func (h *TaskHandler) AddChecklistItem(ctx context.Context, req *taskpb.AddChecklistItemRequest) (*taskpb.ChecklistItem, error) {
+	taskID, err := parseUUID(req.GetTaskId())
+	if err != nil || taskID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	item, err := h.svc.AddChecklistItem(ctx, taskID, req.GetText(), initiator)
+	if err != nil {
+		return nil, checklistError(err)
+	}
+
+	return toProtoChecklistItem(item), nil
+}
+
+func (h *TaskHandler) ToggleChecklistItem(ctx context.Context, req *taskpb.ToggleChecklistItemRequest) (*taskpb.ChecklistItem, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checklist item id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	var done *bool
+	if req.GetDone() != nil {
+		value := req.GetDone().GetValue()
+		done = &value
+	}
+
+	item, err := h.svc.ToggleChecklistItem(ctx, id, done, initiator)
+	if err != nil {
+		return nil, checklistError(err)
+	}
+
+	return toProtoChecklistItem(item), nil
+}
+
+func (h *TaskHandler) ReorderChecklistItems(ctx context.Context, req *taskpb.ReorderChecklistItemsRequest) (*taskpb.ListChecklistItemsResponse, error) {
+	taskID, err := parseUUID(req.GetTaskId())
+	if err != nil || taskID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	itemIDs := make([]uuid.UUID, 0, len(req.GetItemIds()))
+	for _, raw := range req.GetItemIds() {
+		id, err := parseUUID(raw)
+		if err != nil || id == uuid.Nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid checklist item id")
+		}
+		itemIDs = append(itemIDs, id)
+	}
+
+	items, err := h.svc.ReorderChecklistItems(ctx, taskID, itemIDs, initiator)
+	if err != nil {
+		return nil, checklistError(err)
+	}
+
+	return &taskpb.ListChecklistItemsResponse{Items: toProtoChecklistItems(items)}, nil
+}
+
+func (h *TaskHandler) DeleteChecklistItem(ctx context.Context, req *taskpb.DeleteChecklistItemRequest) (*emptypb.Empty, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid checklist item id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := h.svc.DeleteChecklistItem(ctx, id, initiator); err != nil {
+		return nil, checklistError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toProtoChecklistItems(items []models.ChecklistItem) []*taskpb.ChecklistItem {
+	result := make([]*taskpb.ChecklistItem, 0, len(items))
+	for i := range items {
+		result = append(result, toProtoChecklistItem(&items[i]))
+	}
+	return result
+}
+
+func toProtoChecklistItem(item *models.ChecklistItem) *taskpb.ChecklistItem {
+	if item == nil {
+		return nil
+	}
+
+	protoItem := &taskpb.ChecklistItem{
+		Id:        item.ID.String(),
+		TaskId:    item.TaskID.String(),
+		Text:      item.Text,
+		Done:      item.Done,
+		Position:  int32(item.Position),
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}
+	if item.DoneBy != nil {
+		protoItem.DoneBy = item.DoneBy.String()
+	}
+	if item.DoneAt != nil {
+		protoItem.DoneAt = timestamppb.New(*item.DoneAt)
+	}
+	return protoItem
+}
+
+func checklistError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "checklist item or task not found")
+	case errors.Is(err, service.ErrChecklistTextRequired), errors.Is(err, service.ErrChecklistOrderMismatch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case isAuthorizationError(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
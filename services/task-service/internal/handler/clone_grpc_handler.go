@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/service"
+	"github.com/aliirah/task-flow/shared/authctx"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TaskHandler) CloneTask(ctx context.Context, req *taskpb.CloneTaskRequest) (*taskpb.CloneTaskResponse, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+	targetOrgID, err := parseUUID(req.GetTargetOrganizationId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target organization id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	result, err := h.svc.CloneTask(ctx, service.CloneTaskInput{
+		SourceID:             id,
+		TargetOrganizationID: targetOrgID,
+		Title:                req.GetTitle(),
+		IncludeSubtasks:      req.GetIncludeSubtasks(),
+		IncludeChecklist:     req.GetIncludeChecklist(),
+		IncludeComments:      req.GetIncludeComments(),
+	}, initiator)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	resp := &taskpb.CloneTaskResponse{
+		Task:     toProtoTask(result.Task),
+		Subtasks: make([]*taskpb.Task, 0, len(result.Subtasks)),
+	}
+	for i := range result.Subtasks {
+		resp.Subtasks = append(resp.Subtasks, toProtoTask(&result.Subtasks[i]))
+	}
+	for _, taskID := range result.Unassigned {
+		resp.UnassignedTaskIds = append(resp.UnassignedTaskIds, taskID.String())
+	}
+	return resp, nil
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/service"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Export handlers
+func (h *TaskHandler) ExportTasks(req *taskpb.ExportTasksRequest, stream grpc.ServerStreamingServer[taskpb.ExportTaskRow]) error {
+	ctx := stream.Context()
+
+	params := service.ExportTasksParams{
+		ListTasksParams: service.ListTasksParams{
+			Status:    req.GetStatus(),
+			SortBy:    req.GetSortBy(),
+			SortOrder: req.GetSortOrder(),
+			Search:    req.GetSearch(),
+		},
+		OnlyWithDueDate: req.GetOnlyWithDueDate(),
+	}
+
+	if req.GetFeedToken() != "" {
+		// Calendar feeds are fetched without a session, the token decides
+		// whose tasks are exported.
+		feed, err := h.svc.ResolveCalendarFeedToken(ctx, req.GetFeedToken())
+		if err != nil {
+			return exportError(err)
+		}
+		params.OrganizationID = feed.OrganizationID
+		params.AssigneeID = feed.UserID
+		params.OnlyWithDueDate = true
+	} else {
+		orgID, err := parseUUID(req.GetOrganizationId())
+		if err != nil || orgID == uuid.Nil {
+			return status.Error(codes.InvalidArgument, "invalid organization id")
+		}
+		initiator, ok := authctx.IncomingUser(ctx)
+		if !ok {
+			return status.Error(codes.Unauthenticated, "user not authenticated")
+		}
+		userID, err := parseUUID(initiator.ID)
+		if err != nil {
+			return status.Error(codes.Unauthenticated, "invalid user id")
+		}
+		if err := h.svc.CheckOrganizationPermission(ctx, userID, orgID, orgdomain.PermissionTaskRead); err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		params.OrganizationID = orgID
+
+		if req.GetAssigneeId() != "" {
+			id, err := parseUUID(req.GetAssigneeId())
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid assignee id")
+			}
+			params.AssigneeID = id
+		}
+		if req.GetReporterId() != "" {
+			id, err := parseUUID(req.GetReporterId())
+			if err != nil {
+				return status.Error(codes.InvalidArgument, "invalid reporter id")
+			}
+			params.ReporterID = id
+		}
+	}
+
+	err := h.svc.ExportTasks(ctx, params, func(row service.ExportedTask) error {
+		out := &taskpb.ExportTaskRow{Task: toProtoTask(&row.Task)}
+		if row.Assignee != nil {
+			out.AssigneeName = exportUserName(row.Assignee.GetFirstName(), row.Assignee.GetLastName())
+			out.AssigneeEmail = row.Assignee.GetEmail()
+		}
+		if row.Reporter != nil {
+			out.ReporterName = exportUserName(row.Reporter.GetFirstName(), row.Reporter.GetLastName())
+			out.ReporterEmail = row.Reporter.GetEmail()
+		}
+		return stream.Send(out)
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (h *TaskHandler) CreateCalendarFeedToken(ctx context.Context, req *taskpb.CreateCalendarFeedTokenRequest) (*taskpb.CalendarFeedToken, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	token, record, err := h.svc.CreateCalendarFeedToken(ctx, orgID, initiator)
+	if err != nil {
+		return nil, exportError(err)
+	}
+
+	return &taskpb.CalendarFeedToken{
+		Token:          token,
+		OrganizationId: record.OrganizationID.String(),
+		UserId:         record.UserID.String(),
+		CreatedAt:      timestamppb.New(record.CreatedAt),
+	}, nil
+}
+
+func (h *TaskHandler) RevokeCalendarFeedToken(ctx context.Context, req *taskpb.RevokeCalendarFeedTokenRequest) (*emptypb.Empty, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	if err := h.svc.RevokeCalendarFeedToken(ctx, orgID, initiator); err != nil {
+		return nil, exportError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func exportUserName(first, last string) string {
+	switch {
+	case first == "":
+		return last
+	case last == "":
+		return first
+	}
+	return first + " " + last
+}
+
+func exportError(err error) error {
+	if errors.Is(err, service.ErrCalendarFeedTokenInvalid) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if isAuthorizationError(err) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.Internal, err.Error())
+}
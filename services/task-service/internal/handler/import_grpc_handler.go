@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/services/task-service/internal/service"
+	"github.com/aliirah/task-flow/shared/authctx"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Import handlers
+func (h *TaskHandler) StartTaskImport(ctx context.Context, req *taskpb.StartTaskImportRequest) (*taskpb.ImportJob, error) {
+	orgID, err := parseUUID(req.GetOrganizationId())
+	if err != nil || orgID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid organization id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	job, err := h.svc.StartTaskImport(ctx, service.StartImportInput{
+		OrganizationID: orgID,
+		Format:         req.GetFormat(),
+		Data:           req.GetData(),
+		DryRun:         req.GetDryRun(),
+	}, initiator)
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	return toProtoImportJob(job), nil
+}
+
+func (h *TaskHandler) GetTaskImport(ctx context.Context, req *taskpb.GetTaskImportRequest) (*taskpb.ImportJob, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid import id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	job, err := h.svc.GetTaskImport(ctx, id, initiator)
+	if err != nil {
+		return nil, importError(err)
+	}
+
+	return toProtoImportJob(job), nil
+}
+
+func toProtoImportJob(job *models.ImportJob) *taskpb.ImportJob {
+	if job == nil {
+		return nil
+	}
+
+	rowErrors := make([]*taskpb.ImportRowError, 0, len(job.Errors))
+	for _, rowErr := range job.Errors {
+		rowErrors = append(rowErrors, &taskpb.ImportRowError{
+			Row:     int32(rowErr.Row),
+			Field:   rowErr.Field,
+			Message: rowErr.Message,
+		})
+	}
+
+	protoJob := &taskpb.ImportJob{
+		Id:             job.ID.String(),
+		OrganizationId: job.OrganizationID.String(),
+		CreatedBy:      job.CreatedBy.String(),
+		Format:         job.Format,
+		Status:         job.Status,
+		DryRun:         job.DryRun,
+		TotalRows:      int32(job.TotalRows),
+		ProcessedRows:  int32(job.ProcessedRows),
+		CreatedCount:   int32(job.CreatedCount),
+		Errors:         rowErrors,
+		CreatedAt:      timestamppb.New(job.CreatedAt),
+		UpdatedAt:      timestamppb.New(job.UpdatedAt),
+	}
+	if job.CompletedAt != nil {
+		protoJob.CompletedAt = timestamppb.New(*job.CompletedAt)
+	}
+	return protoJob
+}
+
+func importError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "import not found")
+	case errors.Is(err, service.ErrImportUnsupportedFormat),
+		errors.Is(err, service.ErrImportEmpty),
+		errors.Is(err, service.ErrImportTooLarge),
+		errors.Is(err, service.ErrImportInvalidFile):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrTaskLimitReached):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case isAuthorizationError(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *TaskHandler) ShareTask(ctx context.Context, req *taskpb.TaskShareRequest) (*taskpb.Task, error) {
+	return h.changeTaskShare(ctx, req, h.svc.ShareTask)
+}
+
+func (h *TaskHandler) UnshareTask(ctx context.Context, req *taskpb.TaskShareRequest) (*taskpb.Task, error) {
+	return h.changeTaskShare(ctx, req, h.svc.UnshareTask)
+}
+
+// shareChange is ShareTask or UnshareTask of the service
+type shareChange func(ctx context.Context, taskID, userID uuid.UUID, initiator authctx.User) (*models.Task, error)
+
+func (h *TaskHandler) changeTaskShare(ctx context.Context, req *taskpb.TaskShareRequest, change shareChange) (*taskpb.Task, error) {
+	taskID, err := parseUUID(req.GetTaskId())
+	if err != nil || taskID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+	userID, err := parseUUID(req.GetUserId())
+	if err != nil || userID == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	task, err := change(ctx, taskID, userID, initiator)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoTask(task), nil
+}
@@ -39,6 +39,10 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 	if err != nil && req.GetReporterId() != "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid reporter id")
 	}
+	assigneeTeamID, err := parseUUID(req.GetAssigneeTeamId())
+	if err != nil && req.GetAssigneeTeamId() != "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid assignee team id")
+	}
 
 	initiator, _ := authctx.IncomingUser(ctx)
 
@@ -59,13 +63,14 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *taskpb.CreateTaskRequ
 		Type:           req.GetType(),
 		OrganizationID: orgID,
 		AssigneeID:     assigneeID,
+		AssigneeTeamID: assigneeTeamID,
 		ReporterID:     reporterID,
 		ParentTaskID:   parentTaskID,
 		DisplayOrder:   int(req.GetDisplayOrder()),
 		DueAt:          timestampToTime(req.GetDueAt()),
 	}, initiator)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcError(err)
 	}
 
 	return toProtoTask(task), nil
@@ -76,10 +81,17 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *taskpb.GetTaskRequest) (
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid task id")
 	}
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
 	task, err := h.svc.GetTask(ctx, id)
 	if err != nil {
 		return nil, grpcError(err)
 	}
+	if err := h.svc.AuthorizeTaskRead(ctx, task, initiator); err != nil {
+		return nil, grpcError(err)
+	}
 	return toProtoTask(task), nil
 }
 
@@ -91,6 +103,9 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *taskpb.ListTasksReques
 		SortBy:    req.GetSortBy(),
 		SortOrder: req.GetSortOrder(),
 		Search:    req.GetSearch(),
+		Archived:  req.GetArchived(),
+
+		IncludeAssigneeTeams: req.GetIncludeAssigneeTeams(),
 	}
 
 	if req.GetOrganizationId() != "" {
@@ -107,6 +122,13 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *taskpb.ListTasksReques
 		}
 		params.AssigneeID = id
 	}
+	if req.GetAssigneeTeamId() != "" {
+		id, err := parseUUID(req.GetAssigneeTeamId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid assignee team id")
+		}
+		params.AssigneeTeamID = id
+	}
 	if req.GetReporterId() != "" {
 		id, err := parseUUID(req.GetReporterId())
 		if err != nil {
@@ -115,15 +137,29 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *taskpb.ListTasksReques
 		params.ReporterID = id
 	}
 
+	// Users only list organizations they can read; calls from other
+	// services, such as the search reindexer, carry no user
+	if initiator, ok := authctx.IncomingUser(ctx); ok {
+		scoped, err := h.svc.ScopeTaskList(ctx, params, initiator)
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		params = scoped
+	}
+
 	tasks, err := h.svc.ListTasks(ctx, params)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcError(err)
 	}
 
 	items := make([]*taskpb.Task, 0, len(tasks))
 	for _, t := range tasks {
 		task := t
-		items = append(items, toProtoTask(&task))
+		item := toProtoTask(&task)
+		if purgeAt := h.svc.PurgeAt(ctx, &task); purgeAt != nil {
+			item.PurgeAt = timestamppb.New(*purgeAt)
+		}
+		items = append(items, item)
 	}
 
 	return &taskpb.ListTasksResponse{Items: items}, nil
@@ -171,6 +207,14 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *taskpb.UpdateTaskRequ
 		}
 		input.AssigneeID = &value
 	}
+	if req.GetAssigneeTeamId() != nil {
+		// An empty value unassigns the team
+		value, err := parseUUID(req.GetAssigneeTeamId().GetValue())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid assignee team id")
+		}
+		input.AssigneeTeamID = &value
+	}
 	if req.GetReporterId() != nil {
 		value, err := parseUUID(req.GetReporterId().GetValue())
 		if err != nil {
@@ -215,6 +259,27 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *taskpb.DeleteTaskRequ
 	return &emptypb.Empty{}, nil
 }
 
+func (h *TaskHandler) RestoreTask(ctx context.Context, req *taskpb.RestoreTaskRequest) (*taskpb.Task, error) {
+	id, err := parseUUID(req.GetId())
+	if err != nil || id == uuid.Nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid task id")
+	}
+
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
+	task, err := h.svc.RestoreTask(ctx, id, initiator)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotArchived) || errors.Is(err, service.ErrParentArchived) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, grpcError(err)
+	}
+	return toProtoTask(task), nil
+}
+
 func (h *TaskHandler) ReorderTasks(ctx context.Context, req *taskpb.ReorderTasksRequest) (*emptypb.Empty, error) {
 	orgID, err := parseUUID(req.GetOrganizationId())
 	if err != nil {
@@ -240,8 +305,12 @@ func (h *TaskHandler) ReorderTasks(ctx context.Context, req *taskpb.ReorderTasks
 		}
 	}
 
-	if err := h.svc.ReorderTasks(ctx, orgID, orders); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	if err := h.svc.ReorderTasks(ctx, orgID, orders, initiator); err != nil {
+		return nil, grpcError(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -265,21 +334,30 @@ func toProtoTask(task *models.Task) *taskpb.Task {
 		Type:           task.Type,
 		OrganizationId: task.OrganizationID.String(),
 		DisplayOrder:   int32(task.DisplayOrder),
+		ChecklistTotal: int32(task.ChecklistTotal),
+		ChecklistDone:  int32(task.ChecklistDone),
 		DueAt:          due,
 		CreatedAt:      timestamppb.New(task.CreatedAt),
 		UpdatedAt:      timestamppb.New(task.UpdatedAt),
+		SharedWith:     task.SharedWith,
 	}
 
 	// Only include IDs if they are not zero UUID
 	if task.AssigneeID != uuid.Nil {
 		protoTask.AssigneeId = task.AssigneeID.String()
 	}
+	if task.AssigneeTeamID != nil && *task.AssigneeTeamID != uuid.Nil {
+		protoTask.AssigneeTeamId = task.AssigneeTeamID.String()
+	}
 	if task.ReporterID != uuid.Nil {
 		protoTask.ReporterId = task.ReporterID.String()
 	}
 	if task.ParentTaskID != nil && *task.ParentTaskID != uuid.Nil {
 		protoTask.ParentTaskId = task.ParentTaskID.String()
 	}
+	if task.ArchivedAt.Valid {
+		protoTask.ArchivedAt = timestamppb.New(task.ArchivedAt.Time)
+	}
 
 	return protoTask
 }
@@ -303,9 +381,27 @@ func grpcError(err error) error {
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return status.Error(codes.NotFound, "task not found")
 	}
+	if isAuthorizationError(err) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if errors.Is(err, service.ErrInvalidTeam) || errors.Is(err, service.ErrTaskTypeNotAllowed) ||
+		errors.Is(err, service.ErrShareNotGuest) || errors.Is(err, service.ErrInvalidAssignee) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, service.ErrTaskLimitReached) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 	return status.Error(codes.Internal, err.Error())
 }
 
+// isAuthorizationError reports whether err is a membership or role check failure
+func isAuthorizationError(err error) bool {
+	return errors.Is(err, service.ErrNotOrganizationMember) ||
+		errors.Is(err, service.ErrMembershipPending) ||
+		errors.Is(err, service.ErrMembershipSuspended) ||
+		errors.Is(err, service.ErrPermissionDenied)
+}
+
 // Comment handlers
 func (h *TaskHandler) CreateComment(ctx context.Context, req *taskpb.CreateCommentRequest) (*taskpb.Comment, error) {
 	taskID, err := parseUUID(req.GetTaskId())
@@ -340,6 +436,9 @@ func (h *TaskHandler) CreateComment(ctx context.Context, req *taskpb.CreateComme
 		MentionedUsers:  req.GetMentionedUsers(),
 	})
 	if err != nil {
+		if isAuthorizationError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -352,6 +451,11 @@ func (h *TaskHandler) GetComment(ctx context.Context, req *taskpb.GetCommentRequ
 		return nil, status.Error(codes.InvalidArgument, "invalid comment id")
 	}
 
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+
 	comment, err := h.svc.GetComment(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -359,6 +463,11 @@ func (h *TaskHandler) GetComment(ctx context.Context, req *taskpb.GetCommentRequ
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if comment.Task != nil {
+		if err := h.svc.AuthorizeTaskRead(ctx, comment.Task, initiator); err != nil {
+			return nil, grpcError(err)
+		}
+	}
 
 	return toProtoComment(comment), nil
 }
@@ -369,6 +478,18 @@ func (h *TaskHandler) ListComments(ctx context.Context, req *taskpb.ListComments
 		return nil, status.Error(codes.InvalidArgument, "invalid task id")
 	}
 
+	initiator, ok := authctx.IncomingUser(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not authenticated")
+	}
+	task, err := h.svc.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if err := h.svc.AuthorizeTaskRead(ctx, task, initiator); err != nil {
+		return nil, grpcError(err)
+	}
+
 	comments, hasMore, err := h.svc.ListComments(ctx, service.ListCommentsParams{
 		TaskID:         taskID,
 		Page:           int(req.GetPage()),
@@ -412,6 +533,9 @@ func (h *TaskHandler) UpdateComment(ctx context.Context, req *taskpb.UpdateComme
 		MentionedUsers: req.GetMentionedUsers(),
 	}, userID)
 	if err != nil {
+		if isAuthorizationError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -435,6 +559,9 @@ func (h *TaskHandler) DeleteComment(ctx context.Context, req *taskpb.DeleteComme
 	}
 
 	if err := h.svc.DeleteComment(ctx, id, userID); err != nil {
+		if isAuthorizationError(err) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CalendarFeedToken lets calendar apps fetch a user's task feed without a JWT.
+// Only the SHA-256 hash of the token is stored.
+type CalendarFeedToken struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;index:idx_calendar_feed_owner,unique"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index:idx_calendar_feed_owner,unique"`
+	TokenHash      string    `gorm:"not null;uniqueIndex"`
+	LastUsedAt     *time.Time
+	CreatedAt      time.Time
+}
+
+func (t *CalendarFeedToken) BeforeCreate(tx *gorm.DB) error {
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ChecklistItem struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primaryKey"`
+	TaskID    uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Text      string     `gorm:"type:text;not null"`
+	Done      bool       `gorm:"not null;default:false"`
+	DoneBy    *uuid.UUID `gorm:"type:uuid"`
+	DoneAt    *time.Time
+	Position  int `gorm:"not null;default:0;index"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (c *ChecklistItem) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
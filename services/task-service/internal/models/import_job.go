@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ImportStatusValidated = "validated"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+type ImportRowError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// ImportRowErrors is stored as a JSON document on the import job row
+type ImportRowErrors []ImportRowError
+
+func (e ImportRowErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (e *ImportRowErrors) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for ImportRowErrors: %T", value)
+	}
+	return json.Unmarshal(data, e)
+}
+
+type ImportJob struct {
+	ID             uuid.UUID       `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID       `gorm:"type:uuid;not null;index"`
+	CreatedBy      uuid.UUID       `gorm:"type:uuid;not null;index"`
+	Format         string          `gorm:"not null"`
+	Status         string          `gorm:"not null;index"`
+	DryRun         bool            `gorm:"not null;default:false"`
+	TotalRows      int             `gorm:"not null;default:0"`
+	ProcessedRows  int             `gorm:"not null;default:0"`
+	CreatedCount   int             `gorm:"not null;default:0"`
+	Errors         ImportRowErrors `gorm:"type:jsonb"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    *time.Time
+}
+
+func (j *ImportJob) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	return nil
+}
@@ -17,13 +17,20 @@ type Task struct {
 	Type           string         `gorm:"not null;default:task"` // task, story, sub-task
 	OrganizationID uuid.UUID      `gorm:"type:uuid;index"`
 	AssigneeID     uuid.UUID      `gorm:"type:uuid;index"`
+	AssigneeTeamID *uuid.UUID     `gorm:"type:uuid;index"` // team assigned next to or instead of a user
 	ReporterID     uuid.UUID      `gorm:"type:uuid;index"`
 	ParentTaskID   *uuid.UUID     `gorm:"type:uuid;index"` // for sub-tasks
 	DisplayOrder   int            `gorm:"default:0;index"`
 	MentionedUsers pq.StringArray `gorm:"type:text[]"`
+	SharedWith     pq.StringArray `gorm:"type:text[]"` // guests the task is shared with
+	ChecklistTotal int            `gorm:"not null;default:0"`
+	ChecklistDone  int            `gorm:"not null;default:0"`
 	DueAt          *time.Time
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+	// ArchivedAt soft deletes the task, archived rows are purged after the retention period
+	ArchivedAt gorm.DeletedAt `gorm:"index"`
+	ArchivedBy *uuid.UUID     `gorm:"type:uuid"`
 }
 
 func (t *Task) BeforeCreate(tx *gorm.DB) error {
@@ -33,6 +40,16 @@ func (t *Task) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// SharedWithUser reports whether the task is shared with the guest userID
+func (t *Task) SharedWithUser(userID string) bool {
+	for _, id := range t.SharedWith {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
 func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(&Task{}, &Comment{})
+	return db.AutoMigrate(&Task{}, &Comment{}, &ChecklistItem{}, &ImportJob{}, &CalendarFeedToken{})
 }
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const purgeBatchSize = 100
+
+var (
+	ErrTaskNotArchived = errors.New("task is not archived")
+	ErrParentArchived  = errors.New("the parent task is archived, restore it first")
+)
+
+// PurgeAt returns when an archived task will be permanently removed, going by
+// its organization's retention settings
+func (s *Service) PurgeAt(ctx context.Context, task *models.Task) *time.Time {
+	if task == nil || !task.ArchivedAt.Valid {
+		return nil
+	}
+	purgeAt := task.ArchivedAt.Time.Add(s.organizationSettings(ctx, task.OrganizationID).ArchiveRetention())
+	return &purgeAt
+}
+
+// archiveTask soft deletes the task with its sub-tasks and tells other
+// services to hide them. They share one archive time, which is how a restore
+// finds the sub-tasks that went with their parent.
+func (s *Service) archiveTask(ctx context.Context, task *models.Task, initiator authctx.User) error {
+	var archived []models.Task
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		subtasks, err := collectDescendants(tx, task.ID)
+		if err != nil {
+			return err
+		}
+		ids := []uuid.UUID{task.ID}
+		for _, subtask := range subtasks {
+			ids = append(ids, subtask.ID)
+		}
+
+		if initiatorID, err := uuid.Parse(initiator.ID); err == nil {
+			if err := tx.Model(&models.Task{}).Where("id IN ?", ids).Update("archived_by", initiatorID).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("id IN ?", ids).Delete(&models.Task{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().First(task, "id = ?", task.ID).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("id IN ?", ids[1:]).Find(&archived).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.publisher != nil {
+		triggeredBy := taskUserFromAuth(initiator)
+		tasks := append([]models.Task{*task}, archived...)
+		for i := range tasks {
+			if err := s.publisher.TaskArchived(ctx, &tasks[i], *s.PurgeAt(ctx, &tasks[i]), triggeredBy); err != nil {
+				log.S().Errorw("failed to publish task archived event", "error", err, "taskId", tasks[i].ID.String())
+			}
+		}
+	}
+	return nil
+}
+
+// RestoreTask brings an archived task back before it is purged, together with
+// the sub-tasks archived with it. A sub-task can't come back while its parent
+// is archived.
+func (s *Service) RestoreTask(ctx context.Context, id uuid.UUID, initiator authctx.User) (*models.Task, error) {
+	var task models.Task
+	if err := s.db.WithContext(ctx).Unscoped().First(&task, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	if !task.ArchivedAt.Valid {
+		return nil, ErrTaskNotArchived
+	}
+	if err := s.authorizeTaskRemoval(ctx, &task, initiator); err != nil {
+		return nil, err
+	}
+	if task.ParentTaskID != nil {
+		var parent models.Task
+		err := s.db.WithContext(ctx).Unscoped().Select("id", "archived_at").First(&parent, "id = ?", *task.ParentTaskID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if err == nil && parent.ArchivedAt.Valid {
+			return nil, ErrParentArchived
+		}
+	}
+
+	var restored []models.Task
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		subtasks, err := collectDescendants(tx.Unscoped().Where("archived_at = ?", task.ArchivedAt.Time), task.ID)
+		if err != nil {
+			return err
+		}
+		ids := []uuid.UUID{task.ID}
+		for _, subtask := range subtasks {
+			ids = append(ids, subtask.ID)
+		}
+
+		if err := tx.Unscoped().Model(&models.Task{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"archived_at": nil,
+			"archived_by": nil,
+			"updated_at":  time.Now().UTC(),
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.First(&task, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids[1:]).Find(&restored).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.publisher != nil {
+		s.publishTaskRestored(ctx, &task, initiator)
+		for i := range restored {
+			s.publishTaskRestored(ctx, &restored[i], initiator)
+		}
+	}
+
+	return &task, nil
+}
+
+func (s *Service) publishTaskRestored(ctx context.Context, task *models.Task, initiator authctx.User) {
+	var reporter *userpb.User
+	if resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: task.ReporterID.String()}); err == nil {
+		reporter = resp
+	}
+
+	var assignee *userpb.User
+	if task.AssigneeID != uuid.Nil {
+		if resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: task.AssigneeID.String()}); err == nil {
+			assignee = resp
+		}
+	}
+
+	triggeredBy := taskUserFromAuth(initiator)
+	if triggeredBy == nil {
+		triggeredBy = reporterTaskUserFallback(task.ReporterID, reporter)
+	}
+
+	if err := s.publisher.TaskRestored(ctx, task, reporter, assignee, triggeredBy); err != nil {
+		log.S().Errorw("failed to publish task restored event", "error", err, "taskId", task.ID.String())
+	}
+}
+
+// PurgeArchivedTasks permanently removes tasks archived longer than their
+// organization's retention period, together with their comments and
+// checklist. Organizations whose settings can't be read are left for the
+// next run rather than purged by a default period.
+func (s *Service) PurgeArchivedTasks(ctx context.Context) (int, error) {
+	var organizationIDs []uuid.UUID
+	if err := s.db.WithContext(ctx).Unscoped().Model(&models.Task{}).
+		Where("archived_at IS NOT NULL").
+		Distinct().
+		Pluck("organization_id", &organizationIDs).Error; err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, organizationID := range organizationIDs {
+		retention, err := s.archiveRetention(ctx, organizationID)
+		if err != nil {
+			log.S().Warnw("skipping archive purge for organization", "organizationId", organizationID.String(), "error", err)
+			continue
+		}
+		count, err := s.purgeOrganizationArchive(ctx, organizationID, time.Now().UTC().Add(-retention))
+		purged += count
+		if err != nil {
+			return purged, err
+		}
+	}
+	return purged, nil
+}
+
+// archiveRetention reads an organization's retention period, failing rather
+// than falling back to the default
+func (s *Service) archiveRetention(ctx context.Context, organizationID uuid.UUID) (time.Duration, error) {
+	if s.settings == nil {
+		return orgdomain.DefaultSettings().ArchiveRetention(), nil
+	}
+	settings, err := s.settings.Get(ctx, organizationID.String())
+	if err != nil {
+		return 0, err
+	}
+	return settings.ArchiveRetention(), nil
+}
+
+// purgeOrganizationArchive purges the organization's tasks archived before
+// cutoff
+func (s *Service) purgeOrganizationArchive(ctx context.Context, organizationID uuid.UUID, cutoff time.Time) (int, error) {
+	purged := 0
+	for {
+		var tasks []models.Task
+		if err := s.db.WithContext(ctx).Unscoped().
+			Where("organization_id = ? AND archived_at IS NOT NULL AND archived_at < ?", organizationID, cutoff).
+			Order("archived_at ASC").
+			Limit(purgeBatchSize).
+			Find(&tasks).Error; err != nil {
+			return purged, err
+		}
+		if len(tasks) == 0 {
+			return purged, nil
+		}
+
+		for i := range tasks {
+			task := &tasks[i]
+			err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				if err := tx.Unscoped().Where("task_id = ?", task.ID).Delete(&models.Comment{}).Error; err != nil {
+					return err
+				}
+				if err := tx.Where("task_id = ?", task.ID).Delete(&models.ChecklistItem{}).Error; err != nil {
+					return err
+				}
+				// Sub-tasks archived with the task go in the same purge, any
+				// other become top-level tasks
+				if err := tx.Unscoped().Model(&models.Task{}).
+					Where("parent_task_id = ?", task.ID).
+					Update("parent_task_id", nil).Error; err != nil {
+					return err
+				}
+				return tx.Unscoped().Delete(&models.Task{}, "id = ?", task.ID).Error
+			})
+			if err != nil {
+				return purged, err
+			}
+			purged++
+
+			if s.publisher != nil {
+				if err := s.publisher.TaskDeleted(ctx, task, nil, nil); err != nil {
+					log.S().Errorw("failed to publish task deleted event", "error", err, "taskId", task.ID.String())
+				}
+			}
+		}
+
+		if len(tasks) < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// RunArchivePurger purges expired archived tasks every interval until ctx is done
+func (s *Service) RunArchivePurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.PurgeArchivedTasks(ctx)
+		if err != nil {
+			log.S().Errorw("failed to purge archived tasks", "error", err)
+		} else if purged > 0 {
+			log.S().Infow("purged archived tasks", "count", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrNotOrganizationMember = errors.New("user is not a member of this organization")
+	ErrMembershipPending     = errors.New("membership in this organization is waiting for approval")
+	ErrMembershipSuspended   = errors.New("membership in this organization is suspended")
+	ErrPermissionDenied      = errors.New("your role in the organization does not allow this")
+	ErrInvalidAssignee       = errors.New("assignee must be an active member of the organization, not a guest")
+)
+
+// CheckOrganizationPermission checks the user's role in an organization grants
+// permission. An empty permission only checks membership.
+func (s *Service) CheckOrganizationPermission(ctx context.Context, userID, organizationID uuid.UUID, permission string) error {
+	if s.orgSvc == nil {
+		return fmt.Errorf("organization service not available")
+	}
+	// Service keys only reach the organization they were created for
+	if caller, ok := authctx.IncomingUser(ctx); ok && !caller.CanAccessOrganization(organizationID.String()) {
+		return ErrNotOrganizationMember
+	}
+
+	resp, err := s.orgSvc.CheckPermission(ctx, &organizationpb.CheckPermissionRequest{
+		OrganizationId: organizationID.String(),
+		UserId:         userID.String(),
+		Permission:     permission,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check organization permission: %w", err)
+	}
+	if !resp.GetMember() {
+		switch resp.GetStatus() {
+		case orgdomain.MemberStatusPending:
+			return ErrMembershipPending
+		case orgdomain.MemberStatusSuspended:
+			return ErrMembershipSuspended
+		}
+		return ErrNotOrganizationMember
+	}
+	if !resp.GetAllowed() {
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, permission)
+	}
+	return nil
+}
+
+// validateAssignee checks a user can be assigned tasks in an organization: an
+// active member who sees all of its tasks, which leaves out guests. Users who
+// don't qualify are reported as ErrInvalidAssignee.
+func (s *Service) validateAssignee(ctx context.Context, userID, organizationID uuid.UUID) error {
+	err := s.CheckOrganizationPermission(ctx, userID, organizationID, orgdomain.PermissionTaskRead)
+	if isMembershipError(err) || errors.Is(err, ErrPermissionDenied) {
+		return ErrInvalidAssignee
+	}
+	return err
+}
+
+// isMembershipError reports whether err says the user holds no usable
+// membership, as opposed to the check itself failing
+func isMembershipError(err error) bool {
+	return errors.Is(err, ErrNotOrganizationMember) ||
+		errors.Is(err, ErrMembershipPending) ||
+		errors.Is(err, ErrMembershipSuspended)
+}
+
+// authorizeInitiator checks the calling user holds permission in an organization
+func (s *Service) authorizeInitiator(ctx context.Context, initiator authctx.User, organizationID uuid.UUID, permission string) error {
+	userID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return fmt.Errorf("invalid user id")
+	}
+	return s.CheckOrganizationPermission(ctx, userID, organizationID, permission)
+}
+
+// AuthorizeTaskRead checks the calling user can see a task
+func (s *Service) AuthorizeTaskRead(ctx context.Context, task *models.Task, initiator authctx.User) error {
+	userID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return fmt.Errorf("invalid user id")
+	}
+	return s.checkTaskRead(ctx, userID, task)
+}
+
+// checkTaskRead checks the user can see a task. Guests only see the tasks
+// shared with them.
+func (s *Service) checkTaskRead(ctx context.Context, userID uuid.UUID, task *models.Task) error {
+	err := s.CheckOrganizationPermission(ctx, userID, task.OrganizationID, orgdomain.PermissionTaskRead)
+	if !errors.Is(err, ErrPermissionDenied) || !task.SharedWithUser(userID.String()) {
+		return err
+	}
+	return s.CheckOrganizationPermission(ctx, userID, task.OrganizationID, orgdomain.PermissionTaskReadShared)
+}
+
+// ScopeTaskList limits a listing to the tasks the calling user can see: every
+// task of organizations they can read and the tasks shared with them where
+// they are a guest.
+func (s *Service) ScopeTaskList(ctx context.Context, params ListTasksParams, initiator authctx.User) (ListTasksParams, error) {
+	userID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return params, fmt.Errorf("invalid user id")
+	}
+
+	if params.OrganizationID == uuid.Nil {
+		organizationIDs, sharedOrganizationIDs, err := s.ReadableOrganizations(ctx, initiator)
+		if err != nil {
+			return params, err
+		}
+		params.OrganizationIDs = organizationIDs
+		if len(sharedOrganizationIDs) > 0 {
+			params.SharedOrganizationIDs = sharedOrganizationIDs
+			params.SharedWithID = userID
+		}
+		return params, nil
+	}
+
+	err = s.CheckOrganizationPermission(ctx, userID, params.OrganizationID, orgdomain.PermissionTaskRead)
+	if !errors.Is(err, ErrPermissionDenied) {
+		return params, err
+	}
+	if err := s.CheckOrganizationPermission(ctx, userID, params.OrganizationID, orgdomain.PermissionTaskReadShared); err != nil {
+		return params, err
+	}
+	params.OrganizationIDs = []uuid.UUID{}
+	params.SharedOrganizationIDs = []uuid.UUID{params.OrganizationID}
+	params.SharedWithID = userID
+	return params, nil
+}
+
+// authorizeTaskRemoval lets reporters archive and restore their own tasks
+// with task.update; other tasks need task.delete.
+func (s *Service) authorizeTaskRemoval(ctx context.Context, task *models.Task, initiator authctx.User) error {
+	permission := orgdomain.PermissionTaskDelete
+	if task.ReporterID != uuid.Nil && task.ReporterID.String() == initiator.ID {
+		permission = orgdomain.PermissionTaskUpdate
+	}
+	return s.authorizeInitiator(ctx, initiator, task.OrganizationID, permission)
+}
+
+// ReadableOrganizations lists the organizations whose tasks the calling user
+// can list, those with an active membership the caller may access. Where the
+// user is a guest, only the tasks shared with them can be listed; those
+// organizations are returned apart.
+func (s *Service) ReadableOrganizations(ctx context.Context, initiator authctx.User) ([]uuid.UUID, []uuid.UUID, error) {
+	if s.orgSvc == nil {
+		return nil, nil, fmt.Errorf("organization service not available")
+	}
+	resp, err := s.orgSvc.ListUserMemberships(ctx, &organizationpb.ListUserMembershipsRequest{
+		UserId: initiator.ID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list organization memberships: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(resp.GetMemberships()))
+	var sharedIDs []uuid.UUID
+	for _, membership := range resp.GetMemberships() {
+		if membership.GetStatus() != orgdomain.MemberStatusActive {
+			continue
+		}
+		if !initiator.CanAccessOrganization(membership.GetOrganizationId()) {
+			continue
+		}
+		id, err := uuid.Parse(membership.GetOrganizationId())
+		if err != nil {
+			continue
+		}
+		if membership.GetRole() == orgdomain.RoleGuest {
+			sharedIDs = append(sharedIDs, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, sharedIDs, nil
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrChecklistTextRequired  = errors.New("checklist item text cannot be empty")
+	ErrChecklistOrderMismatch = errors.New("checklist order must list every item of the task exactly once")
+)
+
+// ListChecklistItems returns the checklist of a task in display order
+func (s *Service) ListChecklistItems(ctx context.Context, taskID uuid.UUID, initiator authctx.User) ([]models.ChecklistItem, error) {
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AuthorizeTaskRead(ctx, task, initiator); err != nil {
+		return nil, err
+	}
+
+	var items []models.ChecklistItem
+	if err := s.db.WithContext(ctx).
+		Where("task_id = ?", taskID).
+		Order("position ASC, created_at ASC").
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// AddChecklistItem appends a new item to the end of a task checklist
+func (s *Service) AddChecklistItem(ctx context.Context, taskID uuid.UUID, text string, initiator authctx.User) (*models.ChecklistItem, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrChecklistTextRequired
+	}
+
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+
+	item := &models.ChecklistItem{
+		TaskID: taskID,
+		Text:   text,
+	}
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Locking the task keeps concurrent adds from taking the same position
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Take(&models.Task{}, "id = ?", taskID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ChecklistItem{}).
+			Where("task_id = ?", taskID).
+			Select("COALESCE(MAX(position), -1) + 1").
+			Scan(&item.Position).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(item).Error; err != nil {
+			return err
+		}
+		return refreshChecklistProgress(tx, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishChecklistChange(ctx, task, &contracts.ChecklistChange{
+		Action: contracts.ChecklistActionAdded,
+		ItemID: item.ID.String(),
+		Text:   item.Text,
+	}, initiator)
+
+	return item, nil
+}
+
+// ToggleChecklistItem marks an item as done or not done. When done is nil the
+// current state is flipped.
+func (s *Service) ToggleChecklistItem(ctx context.Context, id uuid.UUID, done *bool, initiator authctx.User) (*models.ChecklistItem, error) {
+	var item models.ChecklistItem
+	if err := s.db.WithContext(ctx).First(&item, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+
+	task, err := s.GetTask(ctx, item.TaskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+
+	newDone := !item.Done
+	if done != nil {
+		newDone = *done
+	}
+
+	updates := map[string]interface{}{
+		"done":    newDone,
+		"done_by": nil,
+		"done_at": nil,
+	}
+	if newDone {
+		now := time.Now().UTC()
+		updates["done_at"] = now
+		if initiatorID, err := uuid.Parse(initiator.ID); err == nil {
+			updates["done_by"] = initiatorID
+		}
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&item).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.First(&item, "id = ?", id).Error; err != nil {
+			return err
+		}
+		return refreshChecklistProgress(tx, task)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishChecklistChange(ctx, task, &contracts.ChecklistChange{
+		Action: contracts.ChecklistActionToggled,
+		ItemID: item.ID.String(),
+		Text:   item.Text,
+		Done:   item.Done,
+	}, initiator)
+
+	return &item, nil
+}
+
+// ReorderChecklistItems rewrites item positions to match the given order. The
+// order must contain every item of the task exactly once.
+func (s *Service) ReorderChecklistItems(ctx context.Context, taskID uuid.UUID, itemIDs []uuid.UUID, initiator authctx.User) ([]models.ChecklistItem, error) {
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+
+	var items []models.ChecklistItem
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []models.ChecklistItem
+		if err := tx.Where("task_id = ?", taskID).Find(&existing).Error; err != nil {
+			return err
+		}
+		if len(existing) != len(itemIDs) {
+			return ErrChecklistOrderMismatch
+		}
+
+		known := make(map[uuid.UUID]bool, len(existing))
+		for _, item := range existing {
+			known[item.ID] = true
+		}
+		for position, id := range itemIDs {
+			if !known[id] {
+				return ErrChecklistOrderMismatch
+			}
+			// Drop the id so duplicates in the request are rejected
+			delete(known, id)
+
+			if err := tx.Model(&models.ChecklistItem{}).
+				Where("id = ?", id).
+				Update("position", position).Error; err != nil {
+				return fmt.Errorf("failed to update position for checklist item %s: %w", id, err)
+			}
+		}
+
+		return tx.Where("task_id = ?", taskID).
+			Order("position ASC").
+			Find(&items).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishChecklistChange(ctx, task, &contracts.ChecklistChange{
+		Action: contracts.ChecklistActionReordered,
+	}, initiator)
+
+	return items, nil
+}
+
+// DeleteChecklistItem removes an item and closes the gap in positions
+func (s *Service) DeleteChecklistItem(ctx context.Context, id uuid.UUID, initiator authctx.User) error {
+	var item models.ChecklistItem
+	if err := s.db.WithContext(ctx).First(&item, "id = ?", id).Error; err != nil {
+		return err
+	}
+
+	task, err := s.GetTask(ctx, item.TaskID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&item).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.ChecklistItem{}).
+			Where("task_id = ? AND position > ?", item.TaskID, item.Position).
+			Update("position", gorm.Expr("position - 1")).Error; err != nil {
+			return err
+		}
+		return refreshChecklistProgress(tx, task)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishChecklistChange(ctx, task, &contracts.ChecklistChange{
+		Action: contracts.ChecklistActionDeleted,
+		ItemID: item.ID.String(),
+		Text:   item.Text,
+		Done:   item.Done,
+	}, initiator)
+
+	return nil
+}
+
+// refreshChecklistProgress recounts the checklist of a task and stores the
+// totals on the task row so listings don't need to join the items.
+func refreshChecklistProgress(tx *gorm.DB, task *models.Task) error {
+	var total, done int64
+	if err := tx.Model(&models.ChecklistItem{}).Where("task_id = ?", task.ID).Count(&total).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&models.ChecklistItem{}).Where("task_id = ? AND done = ?", task.ID, true).Count(&done).Error; err != nil {
+		return err
+	}
+
+	if err := tx.Model(task).Updates(map[string]interface{}{
+		"checklist_total": int(total),
+		"checklist_done":  int(done),
+	}).Error; err != nil {
+		return err
+	}
+	return tx.First(task, "id = ?", task.ID).Error
+}
+
+// publishChecklistChange emits a task updated event carrying the checklist
+// change so task viewers refresh live.
+func (s *Service) publishChecklistChange(ctx context.Context, task *models.Task, change *contracts.ChecklistChange, initiator authctx.User) {
+	change.Total = task.ChecklistTotal
+	change.Completed = task.ChecklistDone
+
+	if err := s.publishTaskChanges(ctx, task, &contracts.TaskChanges{Checklist: change}, initiator); err != nil {
+		log.S().Errorw("failed to publish checklist change", "error", err, "taskId", task.ID.String(), "action", change.Action)
+	}
+}
+
+// publishTaskChanges emits a task updated event for changes made outside
+// UpdateTask
+func (s *Service) publishTaskChanges(ctx context.Context, task *models.Task, changes *contracts.TaskChanges, initiator authctx.User) error {
+	if s.publisher == nil {
+		return nil
+	}
+
+	var reporter *userpb.User
+	if resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: task.ReporterID.String()}); err == nil {
+		reporter = resp
+	}
+
+	var assignee *userpb.User
+	if task.AssigneeID != uuid.Nil {
+		if resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: task.AssigneeID.String()}); err == nil {
+			assignee = resp
+		}
+	}
+
+	triggeredBy := taskUserFromAuth(initiator)
+	if triggeredBy == nil {
+		triggeredBy = reporterTaskUserFallback(task.ReporterID, reporter)
+	}
+
+	return s.publisher.TaskUpdated(ctx, task, reporter, assignee, triggeredBy, changes)
+}
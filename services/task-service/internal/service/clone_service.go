@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const cloneTitleSuffix = " (copy)"
+
+type CloneTaskInput struct {
+	SourceID             uuid.UUID
+	TargetOrganizationID uuid.UUID
+	Title                string
+	IncludeSubtasks      bool
+	IncludeChecklist     bool
+	IncludeComments      bool
+}
+
+type CloneTaskResult struct {
+	Task     *models.Task
+	Subtasks []models.Task
+	// Unassigned lists clones whose assignee can't be assigned in the target
+	// organization, or whose team stayed behind in the source organization
+	Unassigned []uuid.UUID
+}
+
+// CloneTask copies a task, optionally with its sub-tasks, checklist and
+// comments, into the same or another organization the caller belongs to.
+// The caller becomes the reporter of every copy. Comments name their authors
+// and mentioned users, so they are not copied into another organization.
+func (s *Service) CloneTask(ctx context.Context, input CloneTaskInput, initiator authctx.User) (*CloneTaskResult, error) {
+	initiatorID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id")
+	}
+
+	source, err := s.GetTask(ctx, input.SourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.CheckOrganizationPermission(ctx, initiatorID, source.OrganizationID, orgdomain.PermissionTaskRead); err != nil {
+		return nil, err
+	}
+
+	targetOrgID := input.TargetOrganizationID
+	if targetOrgID == uuid.Nil {
+		targetOrgID = source.OrganizationID
+	}
+	if err := s.CheckOrganizationPermission(ctx, initiatorID, targetOrgID, orgdomain.PermissionTaskCreate); err != nil {
+		return nil, err
+	}
+
+	sources := []models.Task{*source}
+	if input.IncludeSubtasks {
+		subtasks, err := s.collectSubtasks(ctx, source.ID)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, subtasks...)
+	}
+	// Every copy has to be of a type the target organization allows
+	settings := s.organizationSettings(ctx, targetOrgID)
+	for _, src := range sources {
+		if !settings.TaskTypeAllowed(src.Type) {
+			return nil, ErrTaskTypeNotAllowed
+		}
+	}
+	// Keep assignees who can be assigned in the target organization, drop the rest
+	assignees := make(map[uuid.UUID]bool)
+	for _, task := range sources {
+		if task.AssigneeID == uuid.Nil {
+			continue
+		}
+		if _, ok := assignees[task.AssigneeID]; ok {
+			continue
+		}
+		assignees[task.AssigneeID] = s.validateAssignee(ctx, task.AssigneeID, targetOrgID) == nil
+	}
+
+	title := strings.TrimSpace(input.Title)
+	if title == "" {
+		title = source.Title + cloneTitleSuffix
+	}
+
+	result := &CloneTaskResult{}
+	clones := make([]models.Task, 0, len(sources))
+	var comments []models.Comment
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.checkTaskLimit(ctx, tx, targetOrgID, int64(len(sources))); err != nil {
+			return err
+		}
+		// Sources are ordered parents first so parent ids are known when a
+		// sub-task is copied
+		newIDs := make(map[uuid.UUID]uuid.UUID, len(sources))
+		for i, src := range sources {
+			clone := models.Task{
+				Title:          src.Title,
+				Description:    src.Description,
+				Status:         src.Status,
+				Priority:       src.Priority,
+				Type:           src.Type,
+				OrganizationID: targetOrgID,
+				ReporterID:     initiatorID,
+				DisplayOrder:   src.DisplayOrder,
+				DueAt:          src.DueAt,
+			}
+			if i == 0 {
+				clone.Title = title
+				// The root copy only stays under its parent inside the same organization
+				if targetOrgID == source.OrganizationID {
+					clone.ParentTaskID = src.ParentTaskID
+				}
+			} else if src.ParentTaskID != nil {
+				parentID := newIDs[*src.ParentTaskID]
+				clone.ParentTaskID = &parentID
+			}
+
+			if assignees[src.AssigneeID] {
+				clone.AssigneeID = src.AssigneeID
+			}
+			// Teams don't cross organizations
+			if src.AssigneeTeamID != nil && targetOrgID == source.OrganizationID {
+				teamID := *src.AssigneeTeamID
+				clone.AssigneeTeamID = &teamID
+			}
+
+			if err := tx.Create(&clone).Error; err != nil {
+				return err
+			}
+			if (src.AssigneeID != uuid.Nil && clone.AssigneeID == uuid.Nil) ||
+				(src.AssigneeTeamID != nil && clone.AssigneeTeamID == nil) {
+				result.Unassigned = append(result.Unassigned, clone.ID)
+			}
+			newIDs[src.ID] = clone.ID
+
+			if input.IncludeChecklist {
+				if err := cloneChecklist(tx, src.ID, &clone); err != nil {
+					return err
+				}
+			}
+			if input.IncludeComments && targetOrgID == source.OrganizationID {
+				copied, err := cloneComments(tx, src.ID, clone.ID)
+				if err != nil {
+					return err
+				}
+				comments = append(comments, copied...)
+			}
+
+			clones = append(clones, clone)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishClones(ctx, clones, comments, initiator)
+
+	result.Task = &clones[0]
+	result.Subtasks = clones[1:]
+	return result, nil
+}
+
+// collectSubtasks returns every descendant of a task, parents before children
+func (s *Service) collectSubtasks(ctx context.Context, rootID uuid.UUID) ([]models.Task, error) {
+	return collectDescendants(s.db.WithContext(ctx), rootID)
+}
+
+// collectDescendants walks down from a task through the tasks query matches,
+// returning parents before children
+func collectDescendants(query *gorm.DB, rootID uuid.UUID) ([]models.Task, error) {
+	query = query.Session(&gorm.Session{})
+	var result []models.Task
+	seen := map[uuid.UUID]bool{rootID: true}
+	queue := []uuid.UUID{rootID}
+
+	for len(queue) > 0 {
+		var children []models.Task
+		if err := query.
+			Where("parent_task_id IN ?", queue).
+			Order("display_order ASC, created_at ASC").
+			Find(&children).Error; err != nil {
+			return nil, err
+		}
+
+		queue = queue[:0]
+		for _, child := range children {
+			if seen[child.ID] {
+				continue
+			}
+			seen[child.ID] = true
+			result = append(result, child)
+			queue = append(queue, child.ID)
+		}
+	}
+	return result, nil
+}
+
+// cloneChecklist copies checklist items unchecked and refreshes the counts on the clone
+func cloneChecklist(tx *gorm.DB, sourceID uuid.UUID, clone *models.Task) error {
+	var items []models.ChecklistItem
+	if err := tx.Where("task_id = ?", sourceID).Order("position ASC").Find(&items).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	copies := make([]models.ChecklistItem, 0, len(items))
+	for _, item := range items {
+		copies = append(copies, models.ChecklistItem{
+			TaskID:   clone.ID,
+			Text:     item.Text,
+			Position: item.Position,
+		})
+	}
+	if err := tx.Create(&copies).Error; err != nil {
+		return err
+	}
+	return refreshChecklistProgress(tx, clone)
+}
+
+// cloneComments copies a comment thread keeping authors and reply structure
+func cloneComments(tx *gorm.DB, sourceID, cloneID uuid.UUID) ([]models.Comment, error) {
+	var comments []models.Comment
+	if err := tx.Where("task_id = ?", sourceID).Order("created_at ASC").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+
+	newIDs := make(map[uuid.UUID]uuid.UUID, len(comments))
+	for _, comment := range comments {
+		newIDs[comment.ID] = uuid.New()
+	}
+
+	copies := make([]models.Comment, 0, len(comments))
+	for _, comment := range comments {
+		copied := models.Comment{
+			ID:             newIDs[comment.ID],
+			TaskID:         cloneID,
+			UserID:         comment.UserID,
+			Content:        comment.Content,
+			MentionedUsers: comment.MentionedUsers,
+		}
+		if comment.ParentCommentID != nil {
+			if parentID, ok := newIDs[*comment.ParentCommentID]; ok {
+				copied.ParentCommentID = &parentID
+			}
+		}
+		copies = append(copies, copied)
+	}
+	if len(copies) == 0 {
+		return nil, nil
+	}
+	if err := tx.Create(&copies).Error; err != nil {
+		return nil, err
+	}
+	return copies, nil
+}
+
+// publishClones emits creation events for every cloned task and comment
+func (s *Service) publishClones(ctx context.Context, clones []models.Task, comments []models.Comment, initiator authctx.User) {
+	users := make(map[uuid.UUID]*userpb.User)
+	lookup := func(id uuid.UUID) *userpb.User {
+		if id == uuid.Nil {
+			return nil
+		}
+		if user, ok := users[id]; ok {
+			return user
+		}
+		resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: id.String()})
+		if err != nil {
+			resp = nil
+		}
+		users[id] = resp
+		return resp
+	}
+
+	triggeredBy := taskUserFromAuth(initiator)
+	byID := make(map[uuid.UUID]*models.Task, len(clones))
+	for i := range clones {
+		clone := &clones[i]
+		byID[clone.ID] = clone
+		if err := s.publisher.TaskCreated(ctx, clone, lookup(clone.ReporterID), lookup(clone.AssigneeID), triggeredBy); err != nil {
+			log.S().Errorw("failed to publish cloned task created event", "error", err, "taskId", clone.ID.String())
+		}
+	}
+
+	for i := range comments {
+		comment := &comments[i]
+		if err := s.commentPublisher.CommentCreated(ctx, comment, byID[comment.TaskID], lookup(comment.UserID)); err != nil {
+			log.S().Errorw("failed to publish cloned comment created event", "error", err, "commentId", comment.ID.String())
+		}
+	}
+}
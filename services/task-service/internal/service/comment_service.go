@@ -8,6 +8,7 @@ import (
 
 	"github.com/aliirah/task-flow/services/task-service/internal/models"
 	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	log "github.com/aliirah/task-flow/shared/logging"
 	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
 	"github.com/google/uuid"
@@ -66,8 +67,12 @@ func (s *Service) CreateComment(ctx context.Context, input CreateCommentInput) (
 		return nil, err
 	}
 
-	// Validate user is a member of the task's organization
-	if err := s.ValidateOrganizationMembership(ctx, input.UserID, task.OrganizationID); err != nil {
+	// Validate the user's role in the task's organization allows commenting
+	if err := s.CheckOrganizationPermission(ctx, input.UserID, task.OrganizationID, orgdomain.PermissionCommentCreate); err != nil {
+		return nil, err
+	}
+	// Guests only comment on the tasks shared with them
+	if err := s.checkTaskRead(ctx, input.UserID, &task); err != nil {
 		return nil, err
 	}
 
@@ -316,8 +321,10 @@ func (s *Service) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.U
 	commentID := comment.ID.String()
 	taskID := comment.TaskID.String()
 	var organizationID string
+	var sharedWith []string
 	if comment.Task != nil {
 		organizationID = comment.Task.OrganizationID.String()
+		sharedWith = comment.Task.SharedWith
 	}
 
 	// Fetch user details for WebSocket event
@@ -334,7 +341,7 @@ func (s *Service) DeleteComment(ctx context.Context, id uuid.UUID, userID uuid.U
 	// Publish WebSocket event
 	if organizationID != "" {
 		go func() {
-			if err := s.commentPublisher.CommentDeleted(context.Background(), commentID, taskID, organizationID, userID.String(), user); err != nil {
+			if err := s.commentPublisher.CommentDeleted(context.Background(), commentID, taskID, organizationID, userID.String(), sharedWith, user); err != nil {
 				log.S().Errorw("failed to publish comment deleted event", "error", err, "commentId", commentID, "taskId", taskID)
 			}
 		}()
@@ -405,6 +412,7 @@ func (s *Service) publishCommentNotifications(ctx context.Context, task *models.
 	for recipientID := range recipientSet {
 		recipients = append(recipients, recipientID)
 	}
+	recipients = s.appendTeamRecipients(ctx, recipients, task.OrganizationID, task.AssigneeTeamID, comment.UserID)
 
 	// Publish comment created event
 	if len(recipients) > 0 {
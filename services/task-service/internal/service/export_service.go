@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	exportBatchSize       = 200
+	calendarFeedTokenPref = "tfcal_"
+)
+
+var ErrCalendarFeedTokenInvalid = errors.New("calendar feed token is invalid")
+
+type ExportTasksParams struct {
+	ListTasksParams
+	OnlyWithDueDate bool
+}
+
+// ExportedTask is a task with its people resolved for export
+type ExportedTask struct {
+	Task     models.Task
+	Assignee *userpb.User
+	Reporter *userpb.User
+}
+
+// ExportTasks walks every task matching the ListTasks filters in batches and
+// hands each one, with assignee and reporter resolved, to emit.
+func (s *Service) ExportTasks(ctx context.Context, params ExportTasksParams, emit func(ExportedTask) error) error {
+	query := s.taskListQuery(ctx, params.ListTasksParams)
+	if params.OnlyWithDueDate {
+		query = query.Where("due_at IS NOT NULL")
+	}
+
+	users := make(map[string]*userpb.User)
+	for offset := 0; ; offset += exportBatchSize {
+		var tasks []models.Task
+		if err := query.Session(&gorm.Session{}).Offset(offset).Limit(exportBatchSize).Find(&tasks).Error; err != nil {
+			return err
+		}
+		if len(tasks) == 0 {
+			return nil
+		}
+
+		if err := s.resolveExportUsers(ctx, tasks, users); err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if err := emit(ExportedTask{
+				Task:     task,
+				Assignee: users[task.AssigneeID.String()],
+				Reporter: users[task.ReporterID.String()],
+			}); err != nil {
+				return err
+			}
+		}
+
+		if len(tasks) < exportBatchSize {
+			return nil
+		}
+	}
+}
+
+// resolveExportUsers fetches users referenced by the batch that aren't cached yet
+func (s *Service) resolveExportUsers(ctx context.Context, tasks []models.Task, cache map[string]*userpb.User) error {
+	missing := make(map[string]struct{})
+	for _, task := range tasks {
+		for _, id := range []uuid.UUID{task.AssigneeID, task.ReporterID} {
+			if id == uuid.Nil {
+				continue
+			}
+			if _, ok := cache[id.String()]; !ok {
+				missing[id.String()] = struct{}{}
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(missing))
+	for id := range missing {
+		ids = append(ids, id)
+	}
+	resp, err := s.userSvc.ListUsersByIDs(ctx, &userpb.ListUsersByIDsRequest{Ids: ids})
+	if err != nil {
+		return fmt.Errorf("failed to resolve task users: %w", err)
+	}
+	for _, user := range resp.GetItems() {
+		cache[user.GetId()] = user
+	}
+	// Remember unknown ids so deleted users aren't looked up again
+	for _, id := range ids {
+		if _, ok := cache[id]; !ok {
+			cache[id] = nil
+		}
+	}
+	return nil
+}
+
+// CreateCalendarFeedToken issues a new feed token for the caller in an
+// organization, replacing any previous one.
+func (s *Service) CreateCalendarFeedToken(ctx context.Context, organizationID uuid.UUID, initiator authctx.User) (string, *models.CalendarFeedToken, error) {
+	userID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid user id")
+	}
+	if err := s.CheckOrganizationPermission(ctx, userID, organizationID, orgdomain.PermissionTaskRead); err != nil {
+		return "", nil, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, fmt.Errorf("generate calendar feed token: %w", err)
+	}
+	token := calendarFeedTokenPref + hex.EncodeToString(raw)
+
+	record := &models.CalendarFeedToken{
+		UserID:         userID,
+		OrganizationID: organizationID,
+		TokenHash:      hashFeedToken(token),
+		CreatedAt:      time.Now().UTC(),
+	}
+	if err := s.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "organization_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"token_hash": record.TokenHash, "created_at": record.CreatedAt, "last_used_at": nil}),
+	}).Create(record).Error; err != nil {
+		return "", nil, err
+	}
+
+	return token, record, nil
+}
+
+// RevokeCalendarFeedToken removes the caller's feed token for an organization
+func (s *Service) RevokeCalendarFeedToken(ctx context.Context, organizationID uuid.UUID, initiator authctx.User) error {
+	userID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return fmt.Errorf("invalid user id")
+	}
+	return s.db.WithContext(ctx).
+		Delete(&models.CalendarFeedToken{}, "user_id = ? AND organization_id = ?", userID, organizationID).Error
+}
+
+// ResolveCalendarFeedToken returns the feed owner if the token is known and
+// the owner still belongs to the organization.
+func (s *Service) ResolveCalendarFeedToken(ctx context.Context, token string) (*models.CalendarFeedToken, error) {
+	token = strings.TrimSpace(token)
+	if !strings.HasPrefix(token, calendarFeedTokenPref) {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+
+	var record models.CalendarFeedToken
+	if err := s.db.WithContext(ctx).First(&record, "token_hash = ?", hashFeedToken(token)).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCalendarFeedTokenInvalid
+		}
+		return nil, err
+	}
+
+	if err := s.CheckOrganizationPermission(ctx, record.UserID, record.OrganizationID, orgdomain.PermissionTaskRead); err != nil {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+
+	now := time.Now().UTC()
+	if err := s.db.WithContext(ctx).Model(&record).Update("last_used_at", now).Error; err != nil {
+		log.S().Warnw("failed to record calendar feed usage", "error", err, "tokenId", record.ID.String())
+	}
+	return &record, nil
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
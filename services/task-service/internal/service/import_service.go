@@ -0,0 +1,568 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	taskdomain "github.com/aliirah/task-flow/shared/domain/task"
+	log "github.com/aliirah/task-flow/shared/logging"
+	userpb "github.com/aliirah/task-flow/shared/proto/user/v1"
+	"github.com/aliirah/task-flow/shared/util/stringset"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	ImportFormatCSV  = "csv"
+	ImportFormatJSON = "json"
+
+	// maxImportRows caps a single import so one request can't monopolise the service
+	maxImportRows   = 5000
+	importBatchSize = 100
+	// importTimeout bounds a background import, which outlives the request
+	importTimeout = 10 * time.Minute
+)
+
+var (
+	ErrImportUnsupportedFormat = errors.New("import format must be csv or json")
+	ErrImportEmpty             = errors.New("import contains no rows")
+	ErrImportTooLarge          = fmt.Errorf("import exceeds the maximum of %d rows", maxImportRows)
+	ErrImportInvalidFile       = errors.New("invalid import file")
+)
+
+// ImportRow is a single task row as read from the uploaded file. Ref is an
+// optional file-local identifier other rows can point to through Parent.
+type ImportRow struct {
+	Line          int    `json:"-"`
+	Ref           string `json:"ref"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Status        string `json:"status"`
+	Priority      string `json:"priority"`
+	Type          string `json:"type"`
+	AssigneeEmail string `json:"assigneeEmail"`
+	DueDate       string `json:"dueDate"`
+	Parent        string `json:"parent"`
+}
+
+type StartImportInput struct {
+	OrganizationID uuid.UUID
+	Format         string
+	Data           []byte
+	DryRun         bool
+}
+
+// plannedTask is a validated row ready to be created
+type plannedTask struct {
+	row          ImportRow
+	task         models.Task
+	parentRef    string
+	assigneeUser *userpb.User
+}
+
+// StartTaskImport parses and validates the rows synchronously. A dry run only
+// stores the validation report. Otherwise, when every row is valid, tasks are
+// created in the background in a single transaction and progress is tracked
+// on the job.
+func (s *Service) StartTaskImport(ctx context.Context, input StartImportInput, initiator authctx.User) (*models.ImportJob, error) {
+	initiatorID, err := uuid.Parse(initiator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user id")
+	}
+	if err := s.CheckOrganizationPermission(ctx, initiatorID, input.OrganizationID, orgdomain.PermissionTaskCreate); err != nil {
+		return nil, err
+	}
+
+	format := strings.ToLower(strings.TrimSpace(input.Format))
+	rows, err := parseImportRows(format, input.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, rowErrors, err := s.planImport(ctx, rows, input.OrganizationID, initiatorID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rowErrors) == 0 && !input.DryRun {
+		// Checked again when the tasks are created, this only fails early
+		if err := s.checkTaskLimit(ctx, s.db.WithContext(ctx), input.OrganizationID, int64(len(plan))); err != nil {
+			return nil, err
+		}
+	}
+
+	job := &models.ImportJob{
+		OrganizationID: input.OrganizationID,
+		CreatedBy:      initiatorID,
+		Format:         format,
+		DryRun:         input.DryRun,
+		TotalRows:      len(rows),
+		Errors:         rowErrors,
+	}
+
+	now := time.Now().UTC()
+	switch {
+	case len(rowErrors) > 0:
+		// Nothing is imported until the file validates cleanly, so parent
+		// references never point at rows that were skipped.
+		job.Status = models.ImportStatusFailed
+		job.CompletedAt = &now
+	case input.DryRun:
+		job.Status = models.ImportStatusValidated
+		job.CompletedAt = &now
+	default:
+		job.Status = models.ImportStatusRunning
+	}
+
+	if err := s.db.WithContext(ctx).Create(job).Error; err != nil {
+		return nil, err
+	}
+
+	if job.Status == models.ImportStatusRunning {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), importTimeout)
+			defer cancel()
+			s.runImport(ctx, job.ID, plan, initiator)
+		}()
+	}
+
+	return job, nil
+}
+
+// GetTaskImport returns an import job if the caller belongs to its organization
+func (s *Service) GetTaskImport(ctx context.Context, id uuid.UUID, initiator authctx.User) (*models.ImportJob, error) {
+	var job models.ImportJob
+	if err := s.db.WithContext(ctx).First(&job, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, job.OrganizationID, orgdomain.PermissionTaskRead); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func parseImportRows(format string, data []byte) ([]ImportRow, error) {
+	var (
+		rows []ImportRow
+		err  error
+	)
+	switch format {
+	case ImportFormatCSV:
+		rows, err = parseImportCSV(data)
+	case ImportFormatJSON:
+		rows, err = parseImportJSON(data)
+	default:
+		return nil, ErrImportUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrImportEmpty
+	}
+	if len(rows) > maxImportRows {
+		return nil, ErrImportTooLarge
+	}
+	return rows, nil
+}
+
+// importColumns maps accepted CSV header spellings onto row fields
+var importColumns = map[string]string{
+	"ref":            "ref",
+	"external_id":    "ref",
+	"title":          "title",
+	"description":    "description",
+	"status":         "status",
+	"priority":       "priority",
+	"type":           "type",
+	"assignee":       "assignee_email",
+	"assignee_email": "assignee_email",
+	"due":            "due_date",
+	"due_at":         "due_date",
+	"due_date":       "due_date",
+	"parent":         "parent",
+	"parent_ref":     "parent",
+	"parent_id":      "parent",
+}
+
+func parseImportCSV(data []byte) ([]ImportRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.TrimLeadingSpace = true
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrImportEmpty
+		}
+		return nil, fmt.Errorf("%w: csv header: %v", ErrImportInvalidFile, err)
+	}
+
+	columns := make([]string, len(header))
+	hasTitle := false
+	for i, name := range header {
+		key := strings.ToLower(strings.TrimSpace(name))
+		key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+		columns[i] = importColumns[key]
+		if columns[i] == "title" {
+			hasTitle = true
+		}
+	}
+	if !hasTitle {
+		return nil, fmt.Errorf("%w: csv header must include a title column", ErrImportInvalidFile)
+	}
+
+	var rows []ImportRow
+	line := 1
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, fmt.Errorf("%w: csv line %d: %v", ErrImportInvalidFile, line, err)
+		}
+
+		row := ImportRow{Line: line}
+		for i, value := range record {
+			if i >= len(columns) {
+				break
+			}
+			value = strings.TrimSpace(value)
+			switch columns[i] {
+			case "ref":
+				row.Ref = value
+			case "title":
+				row.Title = value
+			case "description":
+				row.Description = value
+			case "status":
+				row.Status = value
+			case "priority":
+				row.Priority = value
+			case "type":
+				row.Type = value
+			case "assignee_email":
+				row.AssigneeEmail = value
+			case "due_date":
+				row.DueDate = value
+			case "parent":
+				row.Parent = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func parseImportJSON(data []byte) ([]ImportRow, error) {
+	var rows []ImportRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("%w: expected a json array of task objects: %v", ErrImportInvalidFile, err)
+	}
+	for i := range rows {
+		rows[i].Line = i + 1
+	}
+	return rows, nil
+}
+
+// planImport validates every row and orders the result so parents are created
+// before their children.
+func (s *Service) planImport(ctx context.Context, rows []ImportRow, organizationID, reporterID uuid.UUID) ([]*plannedTask, models.ImportRowErrors, error) {
+	var rowErrors models.ImportRowErrors
+	addError := func(row ImportRow, field, message string) {
+		rowErrors = append(rowErrors, models.ImportRowError{Row: row.Line, Field: field, Message: message})
+	}
+
+	assignees, err := s.resolveAssigneeEmails(ctx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Assignees must be members of the organization other than guests,
+	// checked once per user
+	assigneeErrors := make(map[string]error, len(assignees))
+	for email, user := range assignees {
+		userID, err := uuid.Parse(user.GetId())
+		if err != nil {
+			assigneeErrors[email] = ErrInvalidAssignee
+			continue
+		}
+		err = s.validateAssignee(ctx, userID, organizationID)
+		if err != nil && !errors.Is(err, ErrInvalidAssignee) {
+			return nil, nil, err
+		}
+		assigneeErrors[email] = err
+	}
+
+	settings := s.organizationSettings(ctx, organizationID)
+
+	refs := make(map[string]*plannedTask, len(rows))
+	planned := make([]*plannedTask, 0, len(rows))
+	for _, row := range rows {
+		item := &plannedTask{row: row}
+		item.task = models.Task{
+			Title:          strings.TrimSpace(row.Title),
+			Description:    strings.TrimSpace(row.Description),
+			OrganizationID: organizationID,
+			ReporterID:     reporterID,
+		}
+
+		if item.task.Title == "" {
+			addError(row, "title", "title is required")
+		}
+
+		if value, err := stringset.Normalize(row.Status, "status", taskdomain.StatusSet, "open"); err != nil {
+			addError(row, "status", err.Error())
+		} else {
+			item.task.Status = value
+		}
+		if value, err := stringset.Normalize(row.Priority, "priority", taskdomain.PrioritySet, settings.Tasks.DefaultPriority); err != nil {
+			addError(row, "priority", err.Error())
+		} else {
+			item.task.Priority = value
+		}
+
+		typeFallback := "task"
+		if strings.TrimSpace(row.Parent) != "" {
+			typeFallback = "sub-task"
+		}
+		if value, err := stringset.Normalize(row.Type, "type", taskdomain.TypeSet, typeFallback); err != nil {
+			addError(row, "type", err.Error())
+		} else if !settings.TaskTypeAllowed(value) {
+			addError(row, "type", ErrTaskTypeNotAllowed.Error())
+		} else {
+			item.task.Type = value
+		}
+
+		if email := normalizeEmail(row.AssigneeEmail); email != "" {
+			if user, ok := assignees[email]; !ok {
+				addError(row, "assigneeEmail", fmt.Sprintf("no user found with email %s", email))
+			} else if err := assigneeErrors[email]; err != nil {
+				addError(row, "assigneeEmail", fmt.Sprintf("%s cannot be assigned: %v", email, err))
+			} else {
+				item.task.AssigneeID, _ = uuid.Parse(user.GetId())
+				item.assigneeUser = user
+			}
+		}
+
+		if due := strings.TrimSpace(row.DueDate); due != "" {
+			parsed, err := parseImportDate(due)
+			if err != nil {
+				addError(row, "dueDate", "due date must be RFC3339 or YYYY-MM-DD")
+			} else {
+				item.task.DueAt = &parsed
+			}
+		}
+
+		if ref := strings.TrimSpace(row.Ref); ref != "" {
+			if _, exists := refs[ref]; exists {
+				addError(row, "ref", fmt.Sprintf("duplicate ref %q", ref))
+			} else {
+				refs[ref] = item
+			}
+		}
+
+		planned = append(planned, item)
+	}
+
+	// Parent references resolve to another row's ref first, then to an
+	// existing task id in the same organization.
+	for _, item := range planned {
+		parent := strings.TrimSpace(item.row.Parent)
+		if parent == "" {
+			continue
+		}
+		if _, ok := refs[parent]; ok {
+			if parent == strings.TrimSpace(item.row.Ref) {
+				addError(item.row, "parent", "a task cannot be its own parent")
+				continue
+			}
+			item.parentRef = parent
+			continue
+		}
+
+		parentID, err := uuid.Parse(parent)
+		if err != nil {
+			addError(item.row, "parent", fmt.Sprintf("parent %q matches no ref in the file and is not a task id", parent))
+			continue
+		}
+		var count int64
+		if err := s.db.WithContext(ctx).Model(&models.Task{}).
+			Where("id = ? AND organization_id = ?", parentID, organizationID).
+			Count(&count).Error; err != nil {
+			return nil, nil, err
+		}
+		if count == 0 {
+			addError(item.row, "parent", fmt.Sprintf("parent task %s not found in this organization", parentID))
+			continue
+		}
+		item.task.ParentTaskID = &parentID
+	}
+
+	ordered, cycleRows := orderImportPlan(planned, refs)
+	for _, item := range cycleRows {
+		addError(item.row, "parent", "parent references form a cycle")
+	}
+
+	return ordered, rowErrors, nil
+}
+
+// orderImportPlan sorts rows so each in-file parent precedes its children and
+// returns any rows caught in a reference cycle.
+func orderImportPlan(planned []*plannedTask, refs map[string]*plannedTask) ([]*plannedTask, []*plannedTask) {
+	ordered := make([]*plannedTask, 0, len(planned))
+	placed := make(map[*plannedTask]bool, len(planned))
+	remaining := planned
+
+	for len(remaining) > 0 {
+		var next []*plannedTask
+		for _, item := range remaining {
+			if item.parentRef == "" || placed[refs[item.parentRef]] {
+				ordered = append(ordered, item)
+				placed[item] = true
+				continue
+			}
+			next = append(next, item)
+		}
+		if len(next) == len(remaining) {
+			return ordered, next
+		}
+		remaining = next
+	}
+	return ordered, nil
+}
+
+// resolveAssigneeEmails looks up the users behind the assignee emails, once
+// per distinct email
+func (s *Service) resolveAssigneeEmails(ctx context.Context, rows []ImportRow) (map[string]*userpb.User, error) {
+	users := make(map[string]*userpb.User)
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		email := normalizeEmail(row.AssigneeEmail)
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		// The query also matches names, so only an exact email counts
+		resp, err := s.userSvc.ListUsers(ctx, &userpb.ListUsersRequest{Query: email, Limit: 20})
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up assignee %s: %w", email, err)
+		}
+		for _, user := range resp.GetItems() {
+			if normalizeEmail(user.GetEmail()) == email {
+				users[email] = user
+				break
+			}
+		}
+	}
+	return users, nil
+}
+
+// runImport creates the planned tasks a batch at a time. Each batch is its
+// own short transaction that checks the task limit for its tasks and records
+// the progress, so other creates in the organization only wait for one batch
+// and the job never counts rows that were not committed. A failed batch stops
+// the import; the batches before it stay.
+func (s *Service) runImport(ctx context.Context, jobID uuid.UUID, plan []*plannedTask, initiator authctx.User) {
+	createdIDs := make(map[string]uuid.UUID, len(plan))
+	processed := 0
+
+	var reporter *userpb.User
+	if resp, err := s.userSvc.GetUser(ctx, &userpb.GetUserRequest{Id: initiator.ID}); err == nil {
+		reporter = resp
+	}
+	triggeredBy := taskUserFromAuth(initiator)
+
+	for start := 0; start < len(plan); start += importBatchSize {
+		end := start + importBatchSize
+		if end > len(plan) {
+			end = len(plan)
+		}
+		batch := plan[start:end]
+
+		failedRow := batch[0].row.Line
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := s.checkTaskLimit(ctx, tx, batch[0].task.OrganizationID, int64(len(batch))); err != nil {
+				return err
+			}
+			for _, item := range batch {
+				if item.parentRef != "" {
+					parentID := createdIDs[item.parentRef]
+					item.task.ParentTaskID = &parentID
+				}
+				if err := tx.Create(&item.task).Error; err != nil {
+					failedRow = item.row.Line
+					return fmt.Errorf("row %d: %w", item.row.Line, err)
+				}
+				if ref := strings.TrimSpace(item.row.Ref); ref != "" {
+					createdIDs[ref] = item.task.ID
+				}
+			}
+			return tx.Model(&models.ImportJob{}).
+				Where("id = ?", jobID).
+				Updates(map[string]interface{}{
+					"processed_rows": end,
+					"created_count":  end,
+				}).Error
+		})
+		if err != nil {
+			log.S().Errorw("task import failed", "error", err, "jobId", jobID.String())
+			s.finishImport(ctx, jobID, models.ImportStatusFailed, processed, processed, models.ImportRowErrors{
+				{Row: failedRow, Message: fmt.Sprintf("import stopped after %d tasks were created: %v", processed, err)},
+			})
+			return
+		}
+
+		processed = end
+		for _, item := range batch {
+			if err := s.publisher.TaskCreated(ctx, &item.task, reporter, item.assigneeUser, triggeredBy); err != nil {
+				log.S().Errorw("failed to publish imported task created event", "error", err, "taskId", item.task.ID.String())
+			}
+		}
+	}
+
+	s.finishImport(ctx, jobID, models.ImportStatusCompleted, len(plan), len(plan), nil)
+}
+
+func (s *Service) finishImport(ctx context.Context, jobID uuid.UUID, status string, processed, created int, rowErrors models.ImportRowErrors) {
+	updates := map[string]interface{}{
+		"status":         status,
+		"processed_rows": processed,
+		"created_count":  created,
+		"completed_at":   time.Now().UTC(),
+	}
+	if rowErrors != nil {
+		updates["errors"] = rowErrors
+	}
+	// The job is recorded even when the import ran out of time
+	ctx = context.WithoutCancel(ctx)
+	if err := s.db.WithContext(ctx).Model(&models.ImportJob{}).Where("id = ?", jobID).Updates(updates).Error; err != nil {
+		log.S().Errorw("failed to finish task import", "error", err, "jobId", jobID.String(), "status", status)
+	}
+}
+
+func parseImportDate(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	parsed, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.UTC(), nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
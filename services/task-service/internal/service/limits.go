@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrTaskLimitReached = errors.New("organization has reached its task limit")
+
+// checkTaskLimit fails when adding count tasks would take the organization
+// past its plan's task limit. The plan comes from organization-service but
+// tasks are counted here, as its usage counter trails the task events. Call
+// it inside the transaction that creates the tasks: it holds a lock on the
+// organization until tx ends, so concurrent creates are counted one after
+// the other.
+func (s *Service) checkTaskLimit(ctx context.Context, tx *gorm.DB, organizationID uuid.UUID, count int64) error {
+	if s.orgSvc == nil {
+		return fmt.Errorf("organization service not available")
+	}
+	usage, err := s.orgSvc.GetOrganizationUsage(ctx, &organizationpb.GetOrganizationUsageRequest{
+		OrganizationId: organizationID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch organization usage: %w", err)
+	}
+	limit := usage.GetLimits().GetMaxTasks()
+	if limit == 0 {
+		return nil
+	}
+
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", organizationID.String()).Error; err != nil {
+		return err
+	}
+	// Archived tasks are soft deleted and left out of the count
+	var tasks int64
+	if err := tx.Model(&models.Task{}).
+		Where("organization_id = ?", organizationID).
+		Count(&tasks).Error; err != nil {
+		return err
+	}
+	if !orgdomain.WithinLimit(limit, tasks+count-1) {
+		return ErrTaskLimitReached
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// PurgeOrganization permanently removes the tasks of a deleted organization
+// with their comments and checklists, its import jobs and calendar feeds.
+// Search documents are purged by search-service from the same event, so no
+// per-task events are published.
+func (s *Service) PurgeOrganization(ctx context.Context, organizationID uuid.UUID) error {
+	var purged int64
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		taskIDs := tx.Unscoped().Model(&models.Task{}).Select("id").Where("organization_id = ?", organizationID)
+		if err := tx.Unscoped().Where("task_id IN (?)", taskIDs).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("task_id IN (?)", taskIDs).Delete(&models.ChecklistItem{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Where("organization_id = ?", organizationID).Delete(&models.Task{})
+		if result.Error != nil {
+			return result.Error
+		}
+		purged = result.RowsAffected
+		if err := tx.Where("organization_id = ?", organizationID).Delete(&models.ImportJob{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("organization_id = ?", organizationID).Delete(&models.CalendarFeedToken{}).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	log.S().Infow("purged deleted organization tasks", "organizationId", organizationID, "tasks", purged)
+	return nil
+}
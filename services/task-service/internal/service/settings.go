@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	"github.com/google/uuid"
+)
+
+var ErrTaskTypeNotAllowed = errors.New("task type is not allowed in this organization")
+
+// SettingsReader returns an organization's settings
+type SettingsReader interface {
+	Get(ctx context.Context, organizationID string) (orgdomain.Settings, error)
+}
+
+// SetSettingsReader makes the service follow organization settings, without
+// one the defaults apply
+func (s *Service) SetSettingsReader(reader SettingsReader) {
+	s.settings = reader
+}
+
+// organizationSettings returns the settings of an organization, falling back
+// to the defaults when they can't be read
+func (s *Service) organizationSettings(ctx context.Context, organizationID uuid.UUID) orgdomain.Settings {
+	if s.settings == nil {
+		return orgdomain.DefaultSettings()
+	}
+	settings, err := s.settings.Get(ctx, organizationID.String())
+	if err != nil {
+		log.S().Warnw("using default organization settings", "organizationId", organizationID.String(), "error", err)
+		return orgdomain.DefaultSettings()
+	}
+	return settings
+}
+
+// NotificationAllowed reports whether the organization lets eventType reach
+// its members
+func (s *Service) NotificationAllowed(ctx context.Context, organizationID, eventType string) bool {
+	id, err := uuid.Parse(organizationID)
+	if err != nil {
+		return true
+	}
+	return !s.organizationSettings(ctx, id).NotificationMuted(eventType)
+}
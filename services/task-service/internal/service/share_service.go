@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aliirah/task-flow/services/task-service/internal/models"
+	"github.com/aliirah/task-flow/shared/authctx"
+	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	log "github.com/aliirah/task-flow/shared/logging"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrShareNotGuest = errors.New("tasks can only be shared with active guests of the organization")
+
+// ShareTask lets a guest of the task's organization see the task and its
+// comments. Members who can update the task share it.
+func (s *Service) ShareTask(ctx context.Context, taskID, userID uuid.UUID, initiator authctx.User) (*models.Task, error) {
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+	if task.SharedWithUser(userID.String()) {
+		return task, nil
+	}
+	if err := s.checkGuest(ctx, task.OrganizationID, userID); err != nil {
+		return nil, err
+	}
+
+	// The array is changed in place so concurrent shares don't overwrite
+	// each other
+	result := s.db.WithContext(ctx).Model(task).
+		Where("NOT (? = ANY(COALESCE(shared_with, '{}')))", userID.String()).
+		Update("shared_with", gorm.Expr("array_append(COALESCE(shared_with, '{}'), ?)", userID.String()))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := s.reloadSharing(ctx, task); err != nil {
+		return nil, err
+	}
+	// Someone else shared it first and announced it
+	if result.RowsAffected == 0 {
+		return task, nil
+	}
+
+	s.publishSharingChange(ctx, task, contracts.SharingActionShared, userID, initiator)
+	return task, nil
+}
+
+// UnshareTask takes a guest's access to the task away
+func (s *Service) UnshareTask(ctx context.Context, taskID, userID uuid.UUID, initiator authctx.User) (*models.Task, error) {
+	task, err := s.GetTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+	if !task.SharedWithUser(userID.String()) {
+		return task, nil
+	}
+
+	result := s.db.WithContext(ctx).Model(task).
+		Where("? = ANY(shared_with)", userID.String()).
+		Update("shared_with", gorm.Expr("array_remove(shared_with, ?)", userID.String()))
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if err := s.reloadSharing(ctx, task); err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return task, nil
+	}
+
+	s.publishSharingChange(ctx, task, contracts.SharingActionUnshared, userID, initiator)
+	return task, nil
+}
+
+// reloadSharing reads the guests the task is shared with after a change
+func (s *Service) reloadSharing(ctx context.Context, task *models.Task) error {
+	return s.db.WithContext(ctx).
+		Select("shared_with", "updated_at").
+		Take(task, "id = ?", task.ID).Error
+}
+
+// checkGuest checks the user is an active guest of the organization. Other
+// members already see every task.
+func (s *Service) checkGuest(ctx context.Context, organizationID, userID uuid.UUID) error {
+	if s.orgSvc == nil {
+		return fmt.Errorf("organization service not available")
+	}
+	resp, err := s.orgSvc.CheckPermission(ctx, &organizationpb.CheckPermissionRequest{
+		OrganizationId: organizationID.String(),
+		UserId:         userID.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check organization membership: %w", err)
+	}
+	if !resp.GetMember() || resp.GetRole() != orgdomain.RoleGuest {
+		return ErrShareNotGuest
+	}
+	return nil
+}
+
+// visibleRecipients keeps the notification recipients who can see the task:
+// members other than guests, and guests it is shared with. Notifications are
+// best effort, so a recipient whose check fails is dropped.
+func (s *Service) visibleRecipients(ctx context.Context, task *models.Task, recipients []uuid.UUID) []uuid.UUID {
+	visible := make([]uuid.UUID, 0, len(recipients))
+	for _, id := range recipients {
+		if task.SharedWithUser(id.String()) {
+			visible = append(visible, id)
+			continue
+		}
+		err := s.CheckOrganizationPermission(ctx, id, task.OrganizationID, orgdomain.PermissionTaskRead)
+		if err == nil {
+			visible = append(visible, id)
+			continue
+		}
+		if !isMembershipError(err) && !errors.Is(err, ErrPermissionDenied) {
+			log.S().Warnw("dropping notification recipient", "error", err, "taskId", task.ID.String(), "userId", id.String())
+		}
+	}
+	return visible
+}
+
+func (s *Service) publishSharingChange(ctx context.Context, task *models.Task, action string, userID uuid.UUID, initiator authctx.User) {
+	change := &contracts.SharingChange{
+		Action: action,
+		UserID: userID.String(),
+	}
+	if err := s.publishTaskChanges(ctx, task, &contracts.TaskChanges{Sharing: change}, initiator); err != nil {
+		log.S().Errorw("failed to publish sharing change", "error", err, "taskId", task.ID.String(), "action", action)
+	}
+}
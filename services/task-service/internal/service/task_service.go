@@ -10,6 +10,7 @@ import (
 	"github.com/aliirah/task-flow/services/task-service/internal/models"
 	"github.com/aliirah/task-flow/shared/authctx"
 	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
 	log "github.com/aliirah/task-flow/shared/logging"
 	"github.com/aliirah/task-flow/shared/messaging"
 	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
@@ -27,6 +28,7 @@ type Service struct {
 	notifPublisher   *messaging.NotificationPublisher
 	userSvc          userpb.UserServiceClient
 	orgSvc           organizationpb.OrganizationServiceClient
+	settings         SettingsReader
 }
 
 func New(db *gorm.DB, publisher event.TaskEventPublisher, commentPublisher event.CommentEventPublisher, notifPublisher *messaging.NotificationPublisher, userSvc userpb.UserServiceClient, orgSvc organizationpb.OrganizationServiceClient) *Service {
@@ -48,6 +50,7 @@ type CreateTaskInput struct {
 	Type           string
 	OrganizationID uuid.UUID
 	AssigneeID     uuid.UUID
+	AssigneeTeamID uuid.UUID
 	ReporterID     uuid.UUID
 	ParentTaskID   *uuid.UUID
 	DisplayOrder   int
@@ -55,11 +58,26 @@ type CreateTaskInput struct {
 }
 
 func (s *Service) CreateTask(ctx context.Context, input CreateTaskInput, initiator authctx.User) (*models.Task, error) {
+	if err := s.authorizeInitiator(ctx, initiator, input.OrganizationID, orgdomain.PermissionTaskCreate); err != nil {
+		return nil, err
+	}
+	if input.AssigneeID != uuid.Nil {
+		if err := s.validateAssignee(ctx, input.AssigneeID, input.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+	if input.AssigneeTeamID != uuid.Nil {
+		if err := s.validateTeam(ctx, input.OrganizationID, input.AssigneeTeamID); err != nil {
+			return nil, err
+		}
+	}
+	settings := s.organizationSettings(ctx, input.OrganizationID)
+
 	task := &models.Task{
 		Title:          strings.TrimSpace(input.Title),
 		Description:    strings.TrimSpace(input.Description),
 		Status:         defaultString(strings.ToLower(strings.TrimSpace(input.Status)), "open"),
-		Priority:       defaultString(strings.ToLower(strings.TrimSpace(input.Priority)), "medium"),
+		Priority:       defaultString(strings.ToLower(strings.TrimSpace(input.Priority)), settings.Tasks.DefaultPriority),
 		Type:           defaultString(strings.ToLower(strings.TrimSpace(input.Type)), "task"),
 		OrganizationID: input.OrganizationID,
 		AssigneeID:     input.AssigneeID,
@@ -68,8 +86,20 @@ func (s *Service) CreateTask(ctx context.Context, input CreateTaskInput, initiat
 		DisplayOrder:   input.DisplayOrder,
 		DueAt:          input.DueAt,
 	}
+	if input.AssigneeTeamID != uuid.Nil {
+		teamID := input.AssigneeTeamID
+		task.AssigneeTeamID = &teamID
+	}
+	if !settings.TaskTypeAllowed(task.Type) {
+		return nil, ErrTaskTypeNotAllowed
+	}
 
-	if err := s.db.WithContext(ctx).Create(task).Error; err != nil {
+	if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.checkTaskLimit(ctx, tx, input.OrganizationID, 1); err != nil {
+			return err
+		}
+		return tx.Create(task).Error
+	}); err != nil {
 		return nil, err
 	}
 
@@ -118,6 +148,8 @@ func (s *Service) CreateTask(ctx context.Context, input CreateTaskInput, initiat
 	if task.ReporterID != uuid.Nil && task.ReporterID != initiatorUUID && task.ReporterID != task.AssigneeID {
 		recipients = append(recipients, task.ReporterID)
 	}
+	recipients = s.appendTeamRecipients(ctx, recipients, task.OrganizationID, task.AssigneeTeamID, initiatorUUID)
+	recipients = s.visibleRecipients(ctx, task, recipients)
 
 	if len(recipients) > 0 {
 		// Convert UUIDs to strings
@@ -169,6 +201,7 @@ func (s *Service) GetTask(ctx context.Context, id uuid.UUID) (*models.Task, erro
 type ListTasksParams struct {
 	OrganizationID uuid.UUID
 	AssigneeID     uuid.UUID
+	AssigneeTeamID uuid.UUID
 	ReporterID     uuid.UUID
 	Status         string
 	Page           int
@@ -176,6 +209,18 @@ type ListTasksParams struct {
 	SortBy         string
 	SortOrder      string
 	Search         string
+	Archived       bool
+	// OrganizationIDs limits the listing to these organizations when not nil
+	OrganizationIDs []uuid.UUID
+	// SharedOrganizationIDs adds the tasks of these organizations shared with
+	// SharedWithID, for organizations where the user is a guest
+	SharedOrganizationIDs []uuid.UUID
+	SharedWithID          uuid.UUID
+	// IncludeAssigneeTeams also matches tasks assigned to the teams of
+	// AssigneeID
+	IncludeAssigneeTeams bool
+	// assigneeTeamIDs holds the resolved teams of AssigneeID
+	assigneeTeamIDs []uuid.UUID
 }
 
 func (s *Service) ListTasks(ctx context.Context, params ListTasksParams) ([]models.Task, error) {
@@ -187,14 +232,63 @@ func (s *Service) ListTasks(ctx context.Context, params ListTasksParams) ([]mode
 	}
 	offset := (params.Page - 1) * params.Limit
 
+	params, err := s.resolveAssigneeTeams(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	query := s.taskListQuery(ctx, params)
+
+	var tasks []models.Task
+	if err := query.Offset(offset).Limit(params.Limit).Find(&tasks).Error; err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// resolveAssigneeTeams looks up the teams of the assignee when the listing
+// includes tasks assigned to them
+func (s *Service) resolveAssigneeTeams(ctx context.Context, params ListTasksParams) (ListTasksParams, error) {
+	if !params.IncludeAssigneeTeams || params.AssigneeID == uuid.Nil {
+		return params, nil
+	}
+	organizationIDs := params.OrganizationIDs
+	if params.OrganizationID != uuid.Nil {
+		organizationIDs = []uuid.UUID{params.OrganizationID}
+	}
+	teamIDs, err := s.userTeamIDs(ctx, params.AssigneeID, organizationIDs)
+	if err != nil {
+		return params, err
+	}
+	params.assigneeTeamIDs = teamIDs
+	return params, nil
+}
+
+// taskListQuery builds the filtered and sorted task query shared by listing and export
+func (s *Service) taskListQuery(ctx context.Context, params ListTasksParams) *gorm.DB {
 	query := s.db.WithContext(ctx).Model(&models.Task{})
+	if params.Archived {
+		query = query.Unscoped().Where("archived_at IS NOT NULL")
+	}
 
 	// Apply filters
 	if params.OrganizationID != uuid.Nil {
 		query = query.Where("organization_id = ?", params.OrganizationID)
 	}
+	if params.SharedWithID != uuid.Nil {
+		query = query.Where("(organization_id IN ? OR (organization_id IN ? AND ? = ANY(shared_with)))",
+			params.OrganizationIDs, params.SharedOrganizationIDs, params.SharedWithID.String())
+	} else if params.OrganizationIDs != nil {
+		query = query.Where("organization_id IN ?", params.OrganizationIDs)
+	}
 	if params.AssigneeID != uuid.Nil {
-		query = query.Where("assignee_id = ?", params.AssigneeID)
+		if len(params.assigneeTeamIDs) > 0 {
+			query = query.Where("(assignee_id = ? OR assignee_team_id IN ?)", params.AssigneeID, params.assigneeTeamIDs)
+		} else {
+			query = query.Where("assignee_id = ?", params.AssigneeID)
+		}
+	}
+	if params.AssigneeTeamID != uuid.Nil {
+		query = query.Where("assignee_team_id = ?", params.AssigneeTeamID)
 	}
 	if params.ReporterID != uuid.Nil {
 		query = query.Where("reporter_id = ?", params.ReporterID)
@@ -233,13 +327,8 @@ func (s *Service) ListTasks(ctx context.Context, params ListTasksParams) ([]mode
 		}
 	}
 
-	query = query.Order(sortField + " " + sortOrder)
-
-	var tasks []models.Task
-	if err := query.Offset(offset).Limit(params.Limit).Find(&tasks).Error; err != nil {
-		return nil, err
-	}
-	return tasks, nil
+	// id breaks ties so batched export pages stay stable
+	return query.Order(sortField + " " + sortOrder).Order("id")
 }
 
 type UpdateTaskInput struct {
@@ -250,6 +339,8 @@ type UpdateTaskInput struct {
 	Type           *string
 	OrganizationID *uuid.UUID
 	AssigneeID     *uuid.UUID
+	// AssigneeTeamID replaces the assigned team, uuid.Nil removes it
+	AssigneeTeamID *uuid.UUID
 	ReporterID     *uuid.UUID
 	ParentTaskID   *uuid.UUID
 	DisplayOrder   *int
@@ -261,9 +352,52 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 	if err != nil {
 		return nil, err
 	}
+	if err := s.authorizeInitiator(ctx, initiator, task.OrganizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return nil, err
+	}
+	// Moving a task creates it in the other organization
+	targetOrgID := task.OrganizationID
+	if input.OrganizationID != nil && *input.OrganizationID != task.OrganizationID {
+		if err := s.authorizeInitiator(ctx, initiator, *input.OrganizationID, orgdomain.PermissionTaskCreate); err != nil {
+			return nil, err
+		}
+		targetOrgID = *input.OrganizationID
+		// Teams don't cross organizations
+		if input.AssigneeTeamID == nil && task.AssigneeTeamID != nil {
+			cleared := uuid.Nil
+			input.AssigneeTeamID = &cleared
+		}
+	}
+	// The assignee has to qualify in the organization the task ends up in
+	newAssigneeID := task.AssigneeID
+	if input.AssigneeID != nil {
+		newAssigneeID = *input.AssigneeID
+	}
+	if newAssigneeID != uuid.Nil && (newAssigneeID != task.AssigneeID || targetOrgID != task.OrganizationID) {
+		if err := s.validateAssignee(ctx, newAssigneeID, targetOrgID); err != nil {
+			return nil, err
+		}
+	}
+	if input.AssigneeTeamID != nil && *input.AssigneeTeamID != uuid.Nil {
+		if err := s.validateTeam(ctx, targetOrgID, *input.AssigneeTeamID); err != nil {
+			return nil, err
+		}
+	}
+	// The type has to be allowed in the organization the task ends up in
+	newType := task.Type
+	if input.Type != nil {
+		newType = strings.ToLower(strings.TrimSpace(*input.Type))
+	}
+	if newType != task.Type || targetOrgID != task.OrganizationID {
+		if !s.organizationSettings(ctx, targetOrgID).TaskTypeAllowed(newType) {
+			return nil, ErrTaskTypeNotAllowed
+		}
+	}
 
 	// Track changes for notifications
 	oldAssigneeID := task.AssigneeID
+	oldTeamID := task.AssigneeTeamID
+	oldOrgID := task.OrganizationID
 	type fieldChange struct {
 		Field string
 		Old   string
@@ -346,6 +480,28 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 		}
 		updates["assignee_id"] = *input.AssigneeID
 	}
+	if input.AssigneeTeamID != nil {
+		oldTeam := ""
+		if task.AssigneeTeamID != nil {
+			oldTeam = task.AssigneeTeamID.String()
+		}
+		newTeam := ""
+		if *input.AssigneeTeamID != uuid.Nil {
+			newTeam = input.AssigneeTeamID.String()
+		}
+		if oldTeam != newTeam {
+			changes = append(changes, fieldChange{
+				Field: "assigneeTeam",
+				Old:   oldTeam,
+				New:   newTeam,
+			})
+		}
+		if newTeam == "" {
+			updates["assignee_team_id"] = nil
+		} else {
+			updates["assignee_team_id"] = *input.AssigneeTeamID
+		}
+	}
 	if input.ReporterID != nil {
 		updates["reporter_id"] = *input.ReporterID
 	}
@@ -354,7 +510,15 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 	}
 
 	if len(updates) > 0 {
-		if err := s.db.WithContext(ctx).Model(task).Updates(updates).Error; err != nil {
+		if err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// A moved task counts against the target organization's limit
+			if targetOrgID != task.OrganizationID {
+				if err := s.checkTaskLimit(ctx, tx, targetOrgID, 1); err != nil {
+					return err
+				}
+			}
+			return tx.Model(task).Updates(updates).Error
+		}); err != nil {
 			return nil, err
 		}
 
@@ -395,7 +559,33 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 			triggeredBy = reporterTaskUserFallback(task.ReporterID, reporter)
 		}
 
-		if err := s.publisher.TaskUpdated(ctx, task, reporter, assignee, triggeredBy); err != nil {
+		// Build TaskChanges from changes slice
+		var taskChanges *contracts.TaskChanges
+		if len(changes) > 0 {
+			taskChanges = &contracts.TaskChanges{}
+			for _, change := range changes {
+				fc := &contracts.FieldChange{
+					Old: change.Old,
+					New: change.New,
+				}
+				switch change.Field {
+				case "title":
+					taskChanges.Title = fc
+				case "description":
+					taskChanges.Description = fc
+				case "status":
+					taskChanges.Status = fc
+				case "priority":
+					taskChanges.Priority = fc
+				case "assignee":
+					taskChanges.AssigneeID = fc
+				case "assigneeTeam":
+					taskChanges.AssigneeTeamID = fc
+				}
+			}
+		}
+
+		if err := s.publisher.TaskUpdated(ctx, task, reporter, assignee, triggeredBy, taskChanges); err != nil {
 			return nil, fmt.Errorf("failed to publish task updated event: %w", err)
 		} // Publish notification event
 		recipients := []uuid.UUID{}
@@ -410,6 +600,11 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 		if oldAssigneeID != uuid.Nil && oldAssigneeID != task.AssigneeID && oldAssigneeID != initiatorUUID {
 			recipients = append(recipients, oldAssigneeID)
 		}
+		recipients = s.appendTeamRecipients(ctx, recipients, task.OrganizationID, task.AssigneeTeamID, initiatorUUID)
+		// A team taken off the task hears about it too
+		if oldTeamID != nil && (task.AssigneeTeamID == nil || *oldTeamID != *task.AssigneeTeamID) {
+			recipients = s.appendTeamRecipients(ctx, recipients, oldOrgID, oldTeamID, initiatorUUID)
+		}
 
 		// Add all mentioned users from comments
 		recipientSet := make(map[uuid.UUID]bool)
@@ -424,6 +619,7 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 				recipientSet[mentionedUserID] = true
 			}
 		}
+		recipients = s.visibleRecipients(ctx, task, recipients)
 
 		if len(recipients) > 0 && len(changes) > 0 {
 			// Convert UUIDs to strings
@@ -432,27 +628,6 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 				recipientStrs[i] = id.String()
 			}
 
-			// Build TaskChanges from changes slice
-			taskChanges := &contracts.TaskChanges{}
-			for _, change := range changes {
-				fc := &contracts.FieldChange{
-					Old: change.Old,
-					New: change.New,
-				}
-				switch change.Field {
-				case "title":
-					taskChanges.Title = fc
-				case "description":
-					taskChanges.Description = fc
-				case "status":
-					taskChanges.Status = fc
-				case "priority":
-					taskChanges.Priority = fc
-				case "assignee":
-					taskChanges.AssigneeID = fc
-				}
-			}
-
 			taskData := &contracts.TaskNotificationData{
 				TaskID:      task.ID.String(),
 				Title:       task.Title,
@@ -487,12 +662,17 @@ func (s *Service) UpdateTask(ctx context.Context, id uuid.UUID, input UpdateTask
 	return task, nil
 }
 
+// DeleteTask archives a task. Archived tasks are hidden from listings and can
+// be restored until they are purged.
 func (s *Service) DeleteTask(ctx context.Context, id uuid.UUID, initiator authctx.User) error {
 	// Fetch task before deletion for notification
 	task, err := s.GetTask(ctx, id)
 	if err != nil {
 		return err
 	}
+	if err := s.authorizeTaskRemoval(ctx, task, initiator); err != nil {
+		return err
+	}
 
 	// Fetch reporter and assignee details for notification
 	var reporter *userpb.User
@@ -513,17 +693,11 @@ func (s *Service) DeleteTask(ctx context.Context, id uuid.UUID, initiator authct
 		}
 	}
 
-	// Delete the task
-	if err := s.db.WithContext(ctx).Delete(&models.Task{}, "id = ?", id).Error; err != nil {
+	// Archive the task, it is purged once the retention period is over
+	if err := s.archiveTask(ctx, task, initiator); err != nil {
 		return err
 	}
 
-	if s.publisher != nil {
-		if err := s.publisher.TaskDeleted(ctx, task, reporter, assignee); err != nil {
-			log.S().Errorw("failed to publish task deleted event", "error", err, "taskId", task.ID.String())
-		}
-	}
-
 	// Publish notification event
 	recipients := []uuid.UUID{}
 	initiatorUUID, _ := uuid.Parse(initiator.ID)
@@ -533,6 +707,8 @@ func (s *Service) DeleteTask(ctx context.Context, id uuid.UUID, initiator authct
 	if task.ReporterID != uuid.Nil && task.ReporterID != initiatorUUID && task.ReporterID != task.AssigneeID {
 		recipients = append(recipients, task.ReporterID)
 	}
+	recipients = s.appendTeamRecipients(ctx, recipients, task.OrganizationID, task.AssigneeTeamID, initiatorUUID)
+	recipients = s.visibleRecipients(ctx, task, recipients)
 
 	if len(recipients) > 0 {
 		// Convert UUIDs to strings
@@ -626,37 +802,20 @@ func reporterNameOrEmpty(reporter *userpb.User) string {
 	return fmt.Sprintf("%s %s", reporter.FirstName, reporter.LastName)
 }
 
-// ValidateOrganizationMembership checks if a user is a member of an organization
+// ValidateOrganizationMembership checks if a user is an active member of an
+// organization
 func (s *Service) ValidateOrganizationMembership(ctx context.Context, userID uuid.UUID, organizationID uuid.UUID) error {
-	if s.orgSvc == nil {
-		return fmt.Errorf("organization service not available")
-	}
-
-	resp, err := s.orgSvc.ListUserMemberships(ctx, &organizationpb.ListUserMembershipsRequest{
-		UserId: userID.String(),
-	})
-	if err != nil {
-		if status.Code(err) == codes.NotFound {
-			return fmt.Errorf("user is not a member of this organization")
-		}
-		return fmt.Errorf("failed to check organization membership: %w", err)
-	}
-
-	// Check if user is a member of the specified organization
-	for _, membership := range resp.GetMemberships() {
-		if membership.GetOrganizationId() == organizationID.String() {
-			return nil // User is a member
-		}
-	}
-
-	return fmt.Errorf("user is not a member of this organization")
+	return s.CheckOrganizationPermission(ctx, userID, organizationID, "")
 }
 
 // ReorderTasks updates the display_order of multiple tasks
 func (s *Service) ReorderTasks(ctx context.Context, organizationID uuid.UUID, taskOrders []struct {
 	ID           uuid.UUID
 	DisplayOrder int
-}) error {
+}, initiator authctx.User) error {
+	if err := s.authorizeInitiator(ctx, initiator, organizationID, orgdomain.PermissionTaskUpdate); err != nil {
+		return err
+	}
 	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		for _, order := range taskOrders {
 			// Verify task belongs to the organization
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/aliirah/task-flow/shared/logging"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var ErrInvalidTeam = errors.New("team does not belong to the task's organization")
+
+// validateTeam checks a team exists in the organization
+func (s *Service) validateTeam(ctx context.Context, organizationID, teamID uuid.UUID) error {
+	if s.orgSvc == nil {
+		return fmt.Errorf("organization service not available")
+	}
+	_, err := s.orgSvc.GetTeam(ctx, &organizationpb.TeamRequest{
+		OrganizationId: organizationID.String(),
+		Id:             teamID.String(),
+	})
+	if status.Code(err) == codes.NotFound {
+		return ErrInvalidTeam
+	}
+	if err != nil {
+		return fmt.Errorf("failed to fetch team: %w", err)
+	}
+	return nil
+}
+
+// teamMemberIDs lists the members of the task's team. Notifications are best
+// effort, so lookup failures only get logged.
+func (s *Service) teamMemberIDs(ctx context.Context, organizationID uuid.UUID, teamID *uuid.UUID) []uuid.UUID {
+	if teamID == nil || *teamID == uuid.Nil || s.orgSvc == nil {
+		return nil
+	}
+	team, err := s.orgSvc.GetTeam(ctx, &organizationpb.TeamRequest{
+		OrganizationId: organizationID.String(),
+		Id:             teamID.String(),
+	})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			log.S().Warnw("failed to fetch team members", "error", err, "teamId", teamID.String())
+		}
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(team.GetMemberIds()))
+	for _, raw := range team.GetMemberIds() {
+		if id, err := uuid.Parse(raw); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// userTeamIDs lists the teams a user belongs to across organizations
+func (s *Service) userTeamIDs(ctx context.Context, userID uuid.UUID, organizationIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if s.orgSvc == nil {
+		return nil, fmt.Errorf("organization service not available")
+	}
+	var ids []uuid.UUID
+	for _, organizationID := range organizationIDs {
+		resp, err := s.orgSvc.ListTeams(ctx, &organizationpb.ListTeamsRequest{
+			OrganizationId: organizationID.String(),
+			UserId:         userID.String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list teams: %w", err)
+		}
+		for _, team := range resp.GetItems() {
+			if id, err := uuid.Parse(team.GetId()); err == nil {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// appendTeamRecipients adds the members of the task's team to recipients,
+// skipping the initiator and users already in the list
+func (s *Service) appendTeamRecipients(ctx context.Context, recipients []uuid.UUID, organizationID uuid.UUID, teamID *uuid.UUID, initiatorID uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(recipients))
+	for _, id := range recipients {
+		seen[id] = true
+	}
+	for _, id := range s.teamMemberIDs(ctx, organizationID, teamID) {
+		if id == initiatorID || seen[id] {
+			continue
+		}
+		seen[id] = true
+		recipients = append(recipients, id)
+	}
+	return recipients
+}
@@ -20,6 +20,7 @@ import (
 	log "github.com/aliirah/task-flow/shared/logging"
 	"github.com/aliirah/task-flow/shared/messaging"
 	"github.com/aliirah/task-flow/shared/metrics"
+	"github.com/aliirah/task-flow/shared/orgsettings"
 	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
 	"github.com/aliirah/task-flow/shared/tracing"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -116,6 +117,28 @@ func main() {
 	notifPublisher := messaging.NewNotificationPublisher(rabbitMQ)
 
 	taskSvc := service.New(db, taskPublisher, commentPublisher, notifPublisher, grpcClients.User, grpcClients.Organization)
+
+	// Follow organization settings, cached until organization-service
+	// announces a change
+	orgSettings := orgsettings.NewClient(grpcClients.Organization, time.Duration(env.GetInt("ORG_SETTINGS_CACHE_SECONDS", 300))*time.Second)
+	if err := orgSettings.Listen(rabbitMQ); err != nil {
+		log.Error(fmt.Errorf("failed to start organization settings listener: %w", err))
+		os.Exit(1)
+	}
+	taskSvc.SetSettingsReader(orgSettings)
+	notifPublisher.SetFilter(taskSvc.NotificationAllowed)
+
+	// Purge archived tasks once they are past retention
+	purgeInterval := time.Duration(env.GetInt("TASK_ARCHIVE_PURGE_INTERVAL_MINUTES", 60)) * time.Minute
+	go taskSvc.RunArchivePurger(ctx, purgeInterval)
+
+	// Purge the tasks of deleted organizations
+	orgConsumer := event.NewOrganizationConsumer(rabbitMQ, taskSvc)
+	if err := orgConsumer.Listen(); err != nil {
+		log.Error(fmt.Errorf("failed to start organization event consumer: %w", err))
+		os.Exit(1)
+	}
+
 	taskHandler := handler.NewTaskHandler(taskSvc)
 
 	addr := env.GetString("TASK_GRPC_ADDR", ":50054")
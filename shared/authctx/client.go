@@ -0,0 +1,61 @@
+package authctx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const contextKeyClient contextKey = "authctx.client"
+
+const (
+	metadataClientIP        = "x-client-ip"
+	metadataClientUserAgent = "x-client-user-agent"
+)
+
+// Client describes the end user's connection as seen by the gateway.
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+// WithClient stores the client details on the supplied context.
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, contextKeyClient, client)
+}
+
+// ClientFromContext extracts client details from context.
+func ClientFromContext(ctx context.Context) (Client, bool) {
+	if ctx == nil {
+		return Client{}, false
+	}
+	v, ok := ctx.Value(contextKeyClient).(Client)
+	return v, ok
+}
+
+// OutgoingClientContext appends the client details to a gRPC outgoing context.
+func OutgoingClientContext(ctx context.Context, client Client) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	existingMD, _ := metadata.FromOutgoingContext(ctx)
+	md := metadata.New(nil)
+	if existingMD != nil {
+		md = existingMD.Copy()
+	}
+	md.Set(metadataClientIP, client.IP)
+	md.Set(metadataClientUserAgent, client.UserAgent)
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// IncomingClient reads client details from incoming gRPC metadata.
+func IncomingClient(ctx context.Context) Client {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Client{}
+	}
+	return Client{
+		IP:        first(md[metadataClientIP]),
+		UserAgent: first(md[metadataClientUserAgent]),
+	}
+}
@@ -2,6 +2,7 @@ package authctx
 
 import (
 	"context"
+	"strconv"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
@@ -21,6 +22,11 @@ const (
 	metadataRoles     = "x-user-roles"
 	metadataStatus    = "x-user-status"
 	metadataUserType  = "x-user-type"
+	metadataSessionID = "x-session-id"
+	metadataVerified  = "x-user-email-verified"
+	metadataTokenID   = "x-api-token-id"
+	metadataScopes    = "x-api-token-scopes"
+	metadataTokenOrg  = "x-api-token-organization"
 )
 
 // User carries identity and authorisation data across service boundaries.
@@ -32,6 +38,40 @@ type User struct {
 	Roles     []string
 	Status    string
 	UserType  string
+	SessionID string // login session the access token belongs to, if known
+	// EmailVerified is set once the user proved they own Email
+	EmailVerified bool
+	// TokenID is set when the request uses an API token rather than a login.
+	// Such requests are limited to Scopes and, for service keys, to
+	// OrganizationID.
+	TokenID        string
+	Scopes         []string
+	OrganizationID string
+}
+
+// IsAPIToken reports whether the user authenticated with an API token
+func (u User) IsAPIToken() bool {
+	return u.TokenID != ""
+}
+
+// HasScope reports whether the request may use scope. Logins carry every
+// scope, API tokens only those they were created with.
+func (u User) HasScope(scope string) bool {
+	if !u.IsAPIToken() {
+		return true
+	}
+	for _, granted := range u.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CanAccessOrganization reports whether a service key restriction, if any,
+// allows organizationID
+func (u User) CanAccessOrganization(organizationID string) bool {
+	return u.OrganizationID == "" || u.OrganizationID == organizationID
 }
 
 // WithUser stores the provided user on the supplied context.
@@ -67,6 +107,9 @@ func (u User) Fields() []zap.Field {
 	if len(u.Roles) > 0 {
 		fields = append(fields, zap.Strings("user_roles", u.Roles))
 	}
+	if u.TokenID != "" {
+		fields = append(fields, zap.String("api_token_id", u.TokenID))
+	}
 	return fields
 }
 
@@ -86,10 +129,18 @@ func OutgoingContext(ctx context.Context, user User) context.Context {
 	md.Set(metadataLastName, user.LastName)
 	md.Set(metadataStatus, user.Status)
 	md.Set(metadataUserType, user.UserType)
+	md.Set(metadataSessionID, user.SessionID)
+	md.Set(metadataVerified, strconv.FormatBool(user.EmailVerified))
+	md.Set(metadataTokenID, user.TokenID)
+	md.Set(metadataTokenOrg, user.OrganizationID)
 	delete(md, metadataRoles)
 	if len(user.Roles) > 0 {
 		md.Set(metadataRoles, user.Roles...)
 	}
+	delete(md, metadataScopes)
+	if len(user.Scopes) > 0 {
+		md.Set(metadataScopes, user.Scopes...)
+	}
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
@@ -106,7 +157,14 @@ func IncomingUser(ctx context.Context) (User, bool) {
 		LastName:  first(md[metadataLastName]),
 		Status:    first(md[metadataStatus]),
 		UserType:  first(md[metadataUserType]),
+		SessionID: first(md[metadataSessionID]),
 		Roles:     md[metadataRoles],
+
+		EmailVerified: first(md[metadataVerified]) == "true",
+
+		TokenID:        first(md[metadataTokenID]),
+		Scopes:         md[metadataScopes],
+		OrganizationID: first(md[metadataTokenOrg]),
 	}
 	if user.ID == "" {
 		return User{}, false
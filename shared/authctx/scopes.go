@@ -0,0 +1,44 @@
+package authctx
+
+// API token scopes, one read and one write scope per resource
+const (
+	ScopeTasksRead          = "tasks:read"
+	ScopeTasksWrite         = "tasks:write"
+	ScopeOrganizationsRead  = "organizations:read"
+	ScopeOrganizationsWrite = "organizations:write"
+	ScopeUsersRead          = "users:read"
+	ScopeUsersWrite         = "users:write"
+	ScopeNotificationsRead  = "notifications:read"
+	ScopeNotificationsWrite = "notifications:write"
+)
+
+// Scopes lists every scope a token can be granted
+var Scopes = []string{
+	ScopeTasksRead,
+	ScopeTasksWrite,
+	ScopeOrganizationsRead,
+	ScopeOrganizationsWrite,
+	ScopeUsersRead,
+	ScopeUsersWrite,
+	ScopeNotificationsRead,
+	ScopeNotificationsWrite,
+}
+
+// ValidScope reports whether scope is a known scope
+func ValidScope(scope string) bool {
+	for _, known := range Scopes {
+		if known == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceScope is the scope needed to read (or write) resource, e.g.
+// ResourceScope("tasks", true) is "tasks:write"
+func ResourceScope(resource string, write bool) string {
+	if write {
+		return resource + ":write"
+	}
+	return resource + ":read"
+}
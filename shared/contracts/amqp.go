@@ -7,12 +7,17 @@ type AmqpMessage struct {
 	UserID         string          `json:"user_id,omitempty"`
 	EventType      string          `json:"event_type"`
 	Data           json.RawMessage `json:"data,omitempty"`
+	// SharedWith lists the guests who may see a task or comment event, guests
+	// receive no other events of the organization
+	SharedWith []string `json:"shared_with,omitempty"`
 }
 
 const (
-	TaskEventCreated = "task.event.created"
-	TaskEventUpdated = "task.event.updated"
-	TaskEventDeleted = "task.event.deleted"
+	TaskEventCreated  = "task.event.created"
+	TaskEventUpdated  = "task.event.updated"
+	TaskEventDeleted  = "task.event.deleted"
+	TaskEventArchived = "task.event.archived"
+	TaskEventRestored = "task.event.restored"
 
 	CommentEventCreated = "comment.event.created"
 	CommentEventUpdated = "comment.event.updated"
@@ -21,6 +26,17 @@ const (
 	UserEventCreated = "user.event.created"
 	UserEventUpdated = "user.event.updated"
 	UserEventDeleted = "user.event.deleted"
+
+	AuthEventTokenRevoked = "auth.event.token_revoked"
+
+	OrganizationEventCreated             = "organization.event.created"
+	OrganizationEventUpdated             = "organization.event.updated"
+	OrganizationEventDeleted             = "organization.event.deleted"
+	OrganizationEventMemberAdded         = "organization.event.member_added"
+	OrganizationEventMemberRemoved       = "organization.event.member_removed"
+	OrganizationEventMemberRoleChanged   = "organization.event.member_role_changed"
+	OrganizationEventMemberStatusChanged = "organization.event.member_status_changed"
+	OrganizationEventSettingsUpdated     = "organization.event.settings_updated"
 )
 
 type TaskCreatedEvent struct {
@@ -32,6 +48,7 @@ type TaskCreatedEvent struct {
 	Priority       string    `json:"priority"`
 	ReporterID     string    `json:"reporterId"`
 	AssigneeID     string    `json:"assigneeId"`
+	AssigneeTeamID string    `json:"assigneeTeamId,omitempty"`
 	Reporter       *TaskUser `json:"reporter,omitempty"`
 	Assignee       *TaskUser `json:"assignee,omitempty"`
 	TriggeredByID  string    `json:"triggeredById,omitempty"`
@@ -42,6 +59,27 @@ type TaskCreatedEvent struct {
 }
 
 type TaskUpdatedEvent struct {
+	TaskID         string       `json:"taskId"`
+	OrganizationID string       `json:"organizationId"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description"`
+	Status         string       `json:"status"`
+	Priority       string       `json:"priority"`
+	ReporterID     string       `json:"reporterId"`
+	AssigneeID     string       `json:"assigneeId"`
+	AssigneeTeamID string       `json:"assigneeTeamId,omitempty"`
+	Reporter       *TaskUser    `json:"reporter,omitempty"`
+	Assignee       *TaskUser    `json:"assignee,omitempty"`
+	TriggeredByID  string       `json:"triggeredById,omitempty"`
+	TriggeredBy    *TaskUser    `json:"triggeredBy,omitempty"`
+	DueAt          string       `json:"dueAt,omitempty"`
+	UpdatedAt      string       `json:"updatedAt,omitempty"`
+	ChecklistTotal int          `json:"checklistTotal"`
+	ChecklistDone  int          `json:"checklistDone"`
+	Changes        *TaskChanges `json:"changes,omitempty"`
+}
+
+type TaskDeletedEvent struct {
 	TaskID         string    `json:"taskId"`
 	OrganizationID string    `json:"organizationId"`
 	Title          string    `json:"title"`
@@ -52,13 +90,24 @@ type TaskUpdatedEvent struct {
 	AssigneeID     string    `json:"assigneeId"`
 	Reporter       *TaskUser `json:"reporter,omitempty"`
 	Assignee       *TaskUser `json:"assignee,omitempty"`
+	DeletedAt      string    `json:"deletedAt,omitempty"`
+}
+
+type TaskArchivedEvent struct {
+	TaskID         string    `json:"taskId"`
+	OrganizationID string    `json:"organizationId"`
+	Title          string    `json:"title"`
+	Status         string    `json:"status"`
+	ReporterID     string    `json:"reporterId"`
+	AssigneeID     string    `json:"assigneeId"`
 	TriggeredByID  string    `json:"triggeredById,omitempty"`
 	TriggeredBy    *TaskUser `json:"triggeredBy,omitempty"`
-	DueAt          string    `json:"dueAt,omitempty"`
-	UpdatedAt      string    `json:"updatedAt,omitempty"`
+	ArchivedAt     string    `json:"archivedAt,omitempty"`
+	PurgeAt        string    `json:"purgeAt,omitempty"`
 }
 
-type TaskDeletedEvent struct {
+// TaskRestoredEvent carries the full task so consumers can rebuild their view
+type TaskRestoredEvent struct {
 	TaskID         string    `json:"taskId"`
 	OrganizationID string    `json:"organizationId"`
 	Title          string    `json:"title"`
@@ -69,7 +118,10 @@ type TaskDeletedEvent struct {
 	AssigneeID     string    `json:"assigneeId"`
 	Reporter       *TaskUser `json:"reporter,omitempty"`
 	Assignee       *TaskUser `json:"assignee,omitempty"`
-	DeletedAt      string    `json:"deletedAt,omitempty"`
+	TriggeredByID  string    `json:"triggeredById,omitempty"`
+	TriggeredBy    *TaskUser `json:"triggeredBy,omitempty"`
+	DueAt          string    `json:"dueAt,omitempty"`
+	RestoredAt     string    `json:"restoredAt,omitempty"`
 }
 
 type CommentCreatedEvent struct {
@@ -116,3 +168,58 @@ type UserEvent struct {
 	UpdatedAt string   `json:"updatedAt,omitempty"`
 	DeletedAt string   `json:"deletedAt,omitempty"`
 }
+
+// TokenRevokedEvent revokes a single access token when TokenID is set,
+// otherwise every token issued to UserID before RevokedBefore.
+type TokenRevokedEvent struct {
+	TokenID       string `json:"tokenId,omitempty"`
+	UserID        string `json:"userId"`
+	RevokedBefore string `json:"revokedBefore,omitempty"`
+	ExpiresAt     string `json:"expiresAt"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// OrganizationEvent describes an organization after it was created or updated
+type OrganizationEvent struct {
+	OrganizationID string `json:"organizationId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	OwnerID        string `json:"ownerId"`
+	TriggeredByID  string `json:"triggeredById,omitempty"`
+	CreatedAt      string `json:"createdAt,omitempty"`
+	UpdatedAt      string `json:"updatedAt,omitempty"`
+}
+
+// OrganizationMemberEvent describes a membership change. PreviousRole and
+// PreviousStatus are only set when the role or status changed.
+type OrganizationMemberEvent struct {
+	OrganizationID string `json:"organizationId"`
+	UserID         string `json:"userId"`
+	Role           string `json:"role"`
+	PreviousRole   string `json:"previousRole,omitempty"`
+	Status         string `json:"status"`
+	PreviousStatus string `json:"previousStatus,omitempty"`
+	TriggeredByID  string `json:"triggeredById,omitempty"`
+	OccurredAt     string `json:"occurredAt"`
+}
+
+// OrganizationSettingsUpdatedEvent tells readers to drop cached settings of
+// an organization older than Version
+type OrganizationSettingsUpdatedEvent struct {
+	OrganizationID string `json:"organizationId"`
+	Version        int64  `json:"version"`
+	TriggeredByID  string `json:"triggeredById,omitempty"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+// OrganizationDeletedEvent is published once an organization's deletion grace
+// period is over. Consumers purge the organization's data and must tolerate
+// the event being delivered more than once.
+type OrganizationDeletedEvent struct {
+	OrganizationID string `json:"organizationId"`
+	Name           string `json:"name"`
+	OwnerID        string `json:"ownerId"`
+	RequestedByID  string `json:"requestedById,omitempty"`
+	RequestedAt    string `json:"requestedAt,omitempty"`
+	DeletedAt      string `json:"deletedAt"`
+}
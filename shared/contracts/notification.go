@@ -2,13 +2,14 @@ package contracts
 
 // Notification event types
 const (
-	NotificationEventTaskCreated      = "notification.task.created"
-	NotificationEventTaskUpdated      = "notification.task.updated"
-	NotificationEventTaskDeleted      = "notification.task.deleted"
-	NotificationEventCommentCreated   = "notification.comment.created"
-	NotificationEventCommentUpdated   = "notification.comment.updated"
-	NotificationEventCommentDeleted   = "notification.comment.deleted"
-	NotificationEventCommentMentioned = "notification.comment.mentioned"
+	NotificationEventTaskCreated         = "notification.task.created"
+	NotificationEventTaskUpdated         = "notification.task.updated"
+	NotificationEventTaskDeleted         = "notification.task.deleted"
+	NotificationEventCommentCreated      = "notification.comment.created"
+	NotificationEventCommentUpdated      = "notification.comment.updated"
+	NotificationEventCommentDeleted      = "notification.comment.deleted"
+	NotificationEventCommentMentioned    = "notification.comment.mentioned"
+	NotificationEventOrganizationInvited = "notification.organization.invited"
 )
 
 // NotificationEvent is the base structure for all notification events
@@ -38,12 +39,45 @@ type TaskNotificationData struct {
 
 // TaskChanges tracks what changed in a task update
 type TaskChanges struct {
-	Title       *FieldChange `json:"title,omitempty"`
-	Description *FieldChange `json:"description,omitempty"`
-	Status      *FieldChange `json:"status,omitempty"`
-	Priority    *FieldChange `json:"priority,omitempty"`
-	AssigneeID  *FieldChange `json:"assigneeId,omitempty"`
-	DueAt       *FieldChange `json:"dueAt,omitempty"`
+	Title          *FieldChange     `json:"title,omitempty"`
+	Description    *FieldChange     `json:"description,omitempty"`
+	Status         *FieldChange     `json:"status,omitempty"`
+	Priority       *FieldChange     `json:"priority,omitempty"`
+	AssigneeID     *FieldChange     `json:"assigneeId,omitempty"`
+	AssigneeTeamID *FieldChange     `json:"assigneeTeamId,omitempty"`
+	DueAt          *FieldChange     `json:"dueAt,omitempty"`
+	Checklist      *ChecklistChange `json:"checklist,omitempty"`
+	Sharing        *SharingChange   `json:"sharing,omitempty"`
+}
+
+// Checklist change actions
+const (
+	ChecklistActionAdded     = "added"
+	ChecklistActionToggled   = "toggled"
+	ChecklistActionReordered = "reordered"
+	ChecklistActionDeleted   = "deleted"
+)
+
+// ChecklistChange describes a single checklist mutation and the resulting progress
+type ChecklistChange struct {
+	Action    string `json:"action"`
+	ItemID    string `json:"itemId,omitempty"`
+	Text      string `json:"text,omitempty"`
+	Done      bool   `json:"done"`
+	Total     int    `json:"total"`
+	Completed int    `json:"completed"`
+}
+
+// Sharing change actions
+const (
+	SharingActionShared   = "shared"
+	SharingActionUnshared = "unshared"
+)
+
+// SharingChange describes a guest gaining or losing access to a task
+type SharingChange struct {
+	Action string `json:"action"`
+	UserID string `json:"userId"`
 }
 
 // FieldChange represents before/after values
@@ -64,3 +98,13 @@ type CommentNotificationData struct {
 	TriggerUser    *TaskUser `json:"triggerUser,omitempty"`
 	MentionedUsers []string `json:"mentionedUsers,omitempty"`
 }
+
+// InvitationNotificationData contains organization invitation notification data
+type InvitationNotificationData struct {
+	InvitationID     string    `json:"invitationId"`
+	OrganizationID   string    `json:"organizationId"`
+	OrganizationName string    `json:"organizationName"`
+	Role             string    `json:"role"`
+	ExpiresAt        string    `json:"expiresAt"`
+	Inviter          *TaskUser `json:"inviter,omitempty"`
+}
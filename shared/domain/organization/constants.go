@@ -0,0 +1,79 @@
+package organization
+
+// Package organization provides shared organization domain constants used across services.
+
+// Member roles, from most to least privileged
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleGuest  = "guest" // only sees the tasks shared with them
+)
+
+// Membership statuses. Only active members are granted permissions.
+const (
+	MemberStatusActive = "active"
+	// MemberStatusPending members accepted an invitation and wait for approval
+	MemberStatusPending = "pending"
+	// MemberStatusSuspended members keep their role but lose access until
+	// reactivated
+	MemberStatusSuspended = "suspended"
+	// MemberStatusLeft members left the organization themselves
+	MemberStatusLeft = "left"
+)
+
+// Permissions checked against a member's role. organization-service owns the
+// mapping from roles to permissions.
+const (
+	PermissionOrgRead    = "org.read"
+	PermissionOrgUpdate  = "org.update"
+	PermissionOrgDelete  = "org.delete"
+	PermissionMemberRead = "member.read"
+	// PermissionMemberManage covers adding, removing and changing the role of
+	// members
+	PermissionMemberManage = "member.manage"
+	// PermissionTeamManage covers creating, changing and deleting teams. Team
+	// leads manage the membership of their own team without it.
+	PermissionTeamManage = "team.manage"
+	PermissionTaskRead   = "task.read"
+	// PermissionTaskReadShared covers reading the tasks shared with the member;
+	// PermissionTaskRead covers every task of the organization
+	PermissionTaskReadShared = "task.read_shared"
+	PermissionTaskCreate     = "task.create"
+	PermissionTaskUpdate     = "task.update"
+	// PermissionTaskDelete covers deleting tasks reported by someone else;
+	// reporters can delete their own tasks with PermissionTaskUpdate
+	PermissionTaskDelete    = "task.delete"
+	PermissionCommentCreate = "comment.create"
+)
+
+var (
+	// RoleSet defines the member roles recognised across services.
+	RoleSet = newStringSet(RoleOwner, RoleAdmin, RoleMember, RoleGuest)
+	// MemberStatusSet defines the membership statuses recognised across
+	// services.
+	MemberStatusSet = newStringSet(MemberStatusActive, MemberStatusPending, MemberStatusSuspended, MemberStatusLeft)
+	// PermissionSet defines the permissions recognised across services.
+	PermissionSet = newStringSet(
+		PermissionOrgRead,
+		PermissionOrgUpdate,
+		PermissionOrgDelete,
+		PermissionMemberRead,
+		PermissionMemberManage,
+		PermissionTeamManage,
+		PermissionTaskRead,
+		PermissionTaskReadShared,
+		PermissionTaskCreate,
+		PermissionTaskUpdate,
+		PermissionTaskDelete,
+		PermissionCommentCreate,
+	)
+)
+
+func newStringSet(values ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,60 @@
+package organization
+
+// Plans an organization can be on. Organizations start on DefaultPlan.
+const (
+	PlanFree     = "free"
+	PlanTeam     = "team"
+	PlanBusiness = "business"
+
+	DefaultPlan = PlanFree
+)
+
+// Limits caps what an organization may use. Zero means unlimited.
+type Limits struct {
+	MaxMembers int64
+	// MaxTasks counts tasks that are not archived
+	MaxTasks        int64
+	MaxStorageBytes int64
+	// APIRequestsPerMinute bounds the requests made with the organization's
+	// service keys
+	APIRequestsPerMinute int64
+}
+
+const gigabyte = int64(1) << 30
+
+var planLimits = map[string]Limits{
+	PlanFree: {
+		MaxMembers:           10,
+		MaxTasks:             1000,
+		MaxStorageBytes:      1 * gigabyte,
+		APIRequestsPerMinute: 60,
+	},
+	PlanTeam: {
+		MaxMembers:           100,
+		MaxTasks:             50000,
+		MaxStorageBytes:      100 * gigabyte,
+		APIRequestsPerMinute: 600,
+	},
+	PlanBusiness: {
+		MaxStorageBytes:      1024 * gigabyte,
+		APIRequestsPerMinute: 6000,
+	},
+}
+
+// PlanSet defines the plans recognised across services.
+var PlanSet = newStringSet(PlanFree, PlanTeam, PlanBusiness)
+
+// PlanLimits returns the limits of a plan, unknown plans get those of
+// DefaultPlan
+func PlanLimits(plan string) Limits {
+	if limits, ok := planLimits[plan]; ok {
+		return limits
+	}
+	return planLimits[DefaultPlan]
+}
+
+// WithinLimit reports whether one more can be used on top of used, a zero
+// limit is unlimited
+func WithinLimit(limit, used int64) bool {
+	return limit == 0 || used < limit
+}
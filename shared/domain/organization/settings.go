@@ -0,0 +1,174 @@
+package organization
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	taskdomain "github.com/aliirah/task-flow/shared/domain/task"
+)
+
+// SettingsSchemaVersion is the version of the settings document this build
+// writes. Older documents are upgraded by Normalize when read.
+const SettingsSchemaVersion = 1
+
+// DefaultTaskPriority is used for new tasks when an organization sets none
+const DefaultTaskPriority = "medium"
+
+// Archived tasks are kept this many days before they are purged, unless the
+// organization picks another period within the bounds
+const (
+	DefaultArchivedTaskDays = 30
+	MinArchivedTaskDays     = 1
+	MaxArchivedTaskDays     = 365
+)
+
+// MutableNotificationEvents lists the notification events an organization can
+// turn off for its members.
+var MutableNotificationEvents = newStringSet(
+	contracts.NotificationEventTaskCreated,
+	contracts.NotificationEventTaskUpdated,
+	contracts.NotificationEventTaskDeleted,
+	contracts.NotificationEventCommentCreated,
+	contracts.NotificationEventCommentUpdated,
+	contracts.NotificationEventCommentDeleted,
+	contracts.NotificationEventCommentMentioned,
+)
+
+// Settings is an organization's policy document. organization-service stores
+// it; other services read it through the orgsettings client.
+type Settings struct {
+	SchemaVersion int                  `json:"schemaVersion"`
+	Tasks         TaskSettings         `json:"tasks"`
+	Notifications NotificationSettings `json:"notifications"`
+	Security      SecuritySettings     `json:"security"`
+	Membership    MembershipSettings   `json:"membership"`
+	Retention     RetentionSettings    `json:"retention"`
+}
+
+type TaskSettings struct {
+	// DefaultPriority applies to new tasks created without one
+	DefaultPriority string `json:"defaultPriority"`
+	// AllowedTypes limits the task types members can use
+	AllowedTypes []string `json:"allowedTypes"`
+}
+
+type NotificationSettings struct {
+	// MutedEvents are notification event types not sent to members
+	MutedEvents []string `json:"mutedEvents"`
+}
+
+type SecuritySettings struct {
+	RequireTwoFactor bool `json:"requireTwoFactor"`
+}
+
+type MembershipSettings struct {
+	// RequireApproval keeps members joining through an invitation pending
+	// until an admin approves them
+	RequireApproval bool `json:"requireApproval"`
+}
+
+type RetentionSettings struct {
+	// ArchivedTaskDays is how long archived tasks can be restored before
+	// they are purged
+	ArchivedTaskDays int `json:"archivedTaskDays"`
+}
+
+// DefaultSettings returns the settings of an organization that never changed
+// them
+func DefaultSettings() Settings {
+	settings := Settings{}
+	settings.Normalize()
+	return settings
+}
+
+// Normalize upgrades the document to the current schema, fills unset values
+// with their defaults and tidies lists.
+func (s *Settings) Normalize() {
+	s.SchemaVersion = SettingsSchemaVersion
+
+	s.Tasks.DefaultPriority = strings.ToLower(strings.TrimSpace(s.Tasks.DefaultPriority))
+	if s.Tasks.DefaultPriority == "" {
+		s.Tasks.DefaultPriority = DefaultTaskPriority
+	}
+	s.Tasks.AllowedTypes = normalizeList(s.Tasks.AllowedTypes)
+	if len(s.Tasks.AllowedTypes) == 0 {
+		s.Tasks.AllowedTypes = setValues(taskdomain.TypeSet)
+	}
+	s.Notifications.MutedEvents = normalizeList(s.Notifications.MutedEvents)
+	if s.Retention.ArchivedTaskDays == 0 {
+		s.Retention.ArchivedTaskDays = DefaultArchivedTaskDays
+	}
+}
+
+// Validate checks every value of a normalized document is known
+func (s Settings) Validate() error {
+	if _, ok := taskdomain.PrioritySet[s.Tasks.DefaultPriority]; !ok {
+		return fmt.Errorf("unknown default task priority %q", s.Tasks.DefaultPriority)
+	}
+	for _, taskType := range s.Tasks.AllowedTypes {
+		if _, ok := taskdomain.TypeSet[taskType]; !ok {
+			return fmt.Errorf("unknown task type %q", taskType)
+		}
+	}
+	for _, event := range s.Notifications.MutedEvents {
+		if _, ok := MutableNotificationEvents[event]; !ok {
+			return fmt.Errorf("notification event %q cannot be muted", event)
+		}
+	}
+	if days := s.Retention.ArchivedTaskDays; days < MinArchivedTaskDays || days > MaxArchivedTaskDays {
+		return fmt.Errorf("archived tasks must be kept %d to %d days", MinArchivedTaskDays, MaxArchivedTaskDays)
+	}
+	return nil
+}
+
+// TaskTypeAllowed reports whether members may use taskType
+func (s Settings) TaskTypeAllowed(taskType string) bool {
+	if len(s.Tasks.AllowedTypes) == 0 {
+		return true
+	}
+	for _, allowed := range s.Tasks.AllowedTypes {
+		if allowed == taskType {
+			return true
+		}
+	}
+	return false
+}
+
+// ArchiveRetention returns how long archived tasks are kept
+func (s Settings) ArchiveRetention() time.Duration {
+	return time.Duration(s.Retention.ArchivedTaskDays) * 24 * time.Hour
+}
+
+// NotificationMuted reports whether the organization turned off eventType
+func (s Settings) NotificationMuted(eventType string) bool {
+	for _, muted := range s.Notifications.MutedEvents {
+		if muted == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeList lowercases, trims, dedupes and sorts values
+func normalizeList(values []string) []string {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if value != "" {
+			set[value] = struct{}{}
+		}
+	}
+	return setValues(set)
+}
+
+func setValues(set map[string]struct{}) []string {
+	values := make([]string, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
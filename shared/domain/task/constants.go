@@ -7,6 +7,8 @@ var (
 	StatusSet = newStringSet("open", "in_progress", "completed", "blocked", "cancelled")
 	// PrioritySet defines the allowed task priorities recognised across services.
 	PrioritySet = newStringSet("low", "medium", "high", "critical")
+	// TypeSet defines the allowed task types recognised across services.
+	TypeSet = newStringSet("task", "story", "sub-task")
 )
 
 func newStringSet(values ...string) map[string]struct{} {
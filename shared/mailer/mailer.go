@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+)
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers transactional email.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+	DriverLog  = "log"
+)
+
+// Config selects and configures a mailer driver.
+type Config struct {
+	Driver string
+	From   string
+
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+
+	// OutboxDir is where the file driver writes messages
+	OutboxDir string
+}
+
+// New builds the mailer for cfg.Driver. The file and log drivers are meant
+// for local development and never deliver anything.
+func New(cfg Config) (Mailer, error) {
+	switch cfg.Driver {
+	case DriverSMTP:
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("smtp mailer requires a host")
+		}
+		return NewSMTPMailer(cfg), nil
+	case DriverFile:
+		return NewFileMailer(cfg)
+	case DriverLog, "":
+		return NewLogMailer(), nil
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", cfg.Driver)
+	}
+}
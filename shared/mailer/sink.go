@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	log "github.com/aliirah/task-flow/shared/logging"
+)
+
+type fileMailer struct {
+	dir  string
+	from string
+}
+
+// NewFileMailer writes each message to cfg.OutboxDir as an .eml file instead
+// of sending it.
+func NewFileMailer(cfg Config) (Mailer, error) {
+	dir := cfg.OutboxDir
+	if dir == "" {
+		dir = "mail"
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create outbox %s: %w", dir, err)
+	}
+	return &fileMailer{dir: dir, from: cfg.From}, nil
+}
+
+var unsafeFilename = regexp.MustCompile(`[^a-zA-Z0-9@._-]+`)
+
+func (m *fileMailer) Send(_ context.Context, msg Message) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405.000000000"), unsafeFilename.ReplaceAllString(msg.To, "_"))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, format(m.from, msg), 0o644); err != nil {
+		return fmt.Errorf("write mail %s: %w", path, err)
+	}
+	log.S().Infow("mail written to outbox", "to", msg.To, "subject", msg.Subject, "path", path)
+	return nil
+}
+
+type logMailer struct{}
+
+// NewLogMailer logs each message, body included, instead of sending it.
+func NewLogMailer() Mailer {
+	return logMailer{}
+}
+
+func (logMailer) Send(_ context.Context, msg Message) error {
+	log.S().Infow("mail not sent (log driver)", "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
@@ -12,13 +12,16 @@ import (
 )
 
 var (
-	ErrConnectionNotFound = errors.New("connection not found")
+	ErrConnectionNotFound     = errors.New("connection not found")
+	ErrSubscriptionNotAllowed = errors.New("organization subscription not allowed")
 )
 
 type connectionInfo struct {
 	conn          *websocket.Conn
 	userID        string
 	subscriptions map[string]struct{}
+	granted       map[string]struct{} // organizations the user may subscribe to
+	guest         map[string]struct{} // granted organizations where the user is a guest
 	mutex         sync.Mutex
 }
 
@@ -57,6 +60,8 @@ func (cm *ConnectionManager) Add(userID string, conn *websocket.Conn) string {
 		conn:          conn,
 		userID:        userID,
 		subscriptions: make(map[string]struct{}),
+		granted:       make(map[string]struct{}),
+		guest:         make(map[string]struct{}),
 	}
 
 	if cm.userIndex[userID] == nil {
@@ -91,6 +96,74 @@ func (cm *ConnectionManager) Remove(connID string) {
 	}
 }
 
+// Grant allows the connection to subscribe to an organization. Guests only
+// receive the events of tasks shared with them.
+func (cm *ConnectionManager) Grant(connID, orgID string, guest bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	info, exists := cm.connections[connID]
+	if !exists {
+		return ErrConnectionNotFound
+	}
+	info.grantUnlocked(orgID, guest)
+	return nil
+}
+
+// GrantUser grants an organization to every live connection of the user and
+// subscribes them to it. Granting again updates whether the user is a guest.
+func (cm *ConnectionManager) GrantUser(userID, orgID string, guest bool) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for connID := range cm.userIndex[userID] {
+		info, ok := cm.connections[connID]
+		if !ok {
+			continue
+		}
+		info.grantUnlocked(orgID, guest)
+		cm.subscribeUnlocked(connID, info, orgID)
+	}
+}
+
+func (info *connectionInfo) grantUnlocked(orgID string, guest bool) {
+	info.granted[orgID] = struct{}{}
+	if guest {
+		info.guest[orgID] = struct{}{}
+	} else {
+		delete(info.guest, orgID)
+	}
+}
+
+// RevokeUser drops an organization from every live connection of the user,
+// so a removed member stops receiving its events and can't subscribe again
+func (cm *ConnectionManager) RevokeUser(userID, orgID string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for connID := range cm.userIndex[userID] {
+		if info, ok := cm.connections[connID]; ok {
+			delete(info.granted, orgID)
+			delete(info.guest, orgID)
+		}
+		cm.removeSubscriptionUnlocked(connID, orgID)
+	}
+}
+
+// RevokeOrganization drops an organization from every live connection
+func (cm *ConnectionManager) RevokeOrganization(orgID string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	for connID, info := range cm.connections {
+		delete(info.granted, orgID)
+		delete(info.guest, orgID)
+		cm.removeSubscriptionUnlocked(connID, orgID)
+	}
+}
+
+// Subscribe starts streaming an organization's events to the connection. The
+// organization must have been granted first.
 func (cm *ConnectionManager) Subscribe(connID, orgID string) error {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
@@ -99,9 +172,17 @@ func (cm *ConnectionManager) Subscribe(connID, orgID string) error {
 	if !exists {
 		return ErrConnectionNotFound
 	}
+	if _, ok := info.granted[orgID]; !ok {
+		return ErrSubscriptionNotAllowed
+	}
+
+	cm.subscribeUnlocked(connID, info, orgID)
+	return nil
+}
 
+func (cm *ConnectionManager) subscribeUnlocked(connID string, info *connectionInfo, orgID string) {
 	if _, already := info.subscriptions[orgID]; already {
-		return nil
+		return
 	}
 
 	info.subscriptions[orgID] = struct{}{}
@@ -109,7 +190,6 @@ func (cm *ConnectionManager) Subscribe(connID, orgID string) error {
 		cm.orgIndex[orgID] = make(map[string]struct{})
 	}
 	cm.orgIndex[orgID][connID] = struct{}{}
-	return nil
 }
 
 func (cm *ConnectionManager) Unsubscribe(connID, orgID string) {
@@ -133,15 +213,30 @@ func (cm *ConnectionManager) removeSubscriptionUnlocked(connID, orgID string) {
 	}
 }
 
+// BroadcastToOrg sends a message to the members subscribed to an
+// organization, guests excluded
 func (cm *ConnectionManager) BroadcastToOrg(orgID string, message contracts.WSMessage) error {
+	return cm.BroadcastToOrgShared(orgID, nil, message)
+}
+
+// BroadcastToOrgShared sends a task or comment message to the members
+// subscribed to an organization and to the guests in sharedWith
+func (cm *ConnectionManager) BroadcastToOrgShared(orgID string, sharedWith []string, message contracts.WSMessage) error {
 	cm.mu.RLock()
-	connIDs, ok := cm.orgIndex[orgID]
-	cm.mu.RUnlock()
-	if !ok {
-		return nil
+	connIDs := make([]string, 0, len(cm.orgIndex[orgID]))
+	for connID := range cm.orgIndex[orgID] {
+		info, ok := cm.connections[connID]
+		if !ok {
+			continue
+		}
+		if _, guest := info.guest[orgID]; guest && !containsString(sharedWith, info.userID) {
+			continue
+		}
+		connIDs = append(connIDs, connID)
 	}
+	cm.mu.RUnlock()
 
-	for connID := range connIDs {
+	for _, connID := range connIDs {
 		if err := cm.write(connID, message); err != nil {
 			logging.S().Warnw("websocket broadcast failed", "orgId", orgID, "connectionId", connID, "error", err)
 			cm.Remove(connID)
@@ -181,3 +276,12 @@ func (cm *ConnectionManager) write(connID string, message contracts.WSMessage) e
 
 	return info.conn.WriteJSON(message)
 }
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
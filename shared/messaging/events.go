@@ -4,9 +4,16 @@ const (
 	EventExchange           = "events"
 	TaskEventsQueue         = "task-events"
 	TaskSearchEventsQueue   = "task-search-events"
+	// Task events counted towards organization usage
+	TaskUsageEventsQueue    = "task-usage-events"
 	CommentEventsQueue      = "comment-events"
 	CommentSearchEventsQueue = "comment-search-events"
 	UserEventsQueue         = "user-events"
+	AuthUserEventsQueue     = "auth-user-events"
 	NotificationsQueue      = "notifications"
+	// Organization lifecycle events, one queue per consuming service
+	OrganizationTaskEventsQueue         = "organization-task-events"
+	OrganizationNotificationEventsQueue = "organization-notification-events"
+	OrganizationSearchEventsQueue       = "organization-search-events"
 	DeadLetterQueue         = "dead_letter_queue"
 )
@@ -10,9 +10,14 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// NotificationFilter reports whether a notification event may be sent for
+// an organization
+type NotificationFilter func(ctx context.Context, organizationID, eventType string) bool
+
 // NotificationPublisher handles publishing notification events
 type NotificationPublisher struct {
-	rmq *RabbitMQ
+	rmq    *RabbitMQ
+	filter NotificationFilter
 }
 
 // NewNotificationPublisher creates a new notification publisher
@@ -20,11 +25,23 @@ func NewNotificationPublisher(rmq *RabbitMQ) *NotificationPublisher {
 	return &NotificationPublisher{rmq: rmq}
 }
 
+// SetFilter drops notifications the filter rejects before they are published
+func (p *NotificationPublisher) SetFilter(filter NotificationFilter) {
+	p.filter = filter
+}
+
 // PublishNotification publishes a notification event to RabbitMQ
 func (p *NotificationPublisher) PublishNotification(ctx context.Context, event *contracts.NotificationEvent) error {
 	if p.rmq == nil || p.rmq.Channel == nil {
 		return fmt.Errorf("rabbitmq connection not initialized")
 	}
+	if p.filter != nil && !p.filter(ctx, event.OrganizationID, event.EventType) {
+		logging.S().Debugw("notification muted by organization",
+			"eventType", event.EventType,
+			"organizationId", event.OrganizationID,
+		)
+		return nil
+	}
 
 	body, err := json.Marshal(event)
 	if err != nil {
@@ -182,3 +199,21 @@ func (p *NotificationPublisher) PublishCommentMention(
 	}
 	return p.PublishNotification(ctx, event)
 }
+
+// PublishOrganizationInvited publishes an organization invitation notification
+func (p *NotificationPublisher) PublishOrganizationInvited(
+	ctx context.Context,
+	organizationID string,
+	triggerUserID string,
+	recipients []string,
+	data *contracts.InvitationNotificationData,
+) error {
+	event := &contracts.NotificationEvent{
+		OrganizationID: organizationID,
+		TriggerUserID:  triggerUserID,
+		Recipients:     recipients,
+		EventType:      contracts.NotificationEventOrganizationInvited,
+		Data:           data,
+	}
+	return p.PublishNotification(ctx, event)
+}
@@ -234,6 +234,16 @@ func (r *RabbitMQ) setupExchangesAndQueues() error {
 		return err
 	}
 
+	if err := r.declareAndBindQueue(
+		TaskUsageEventsQueue,
+		[]string{
+			"task.*",
+		},
+		EventExchange,
+	); err != nil {
+		return err
+	}
+
 	if err := r.declareAndBindQueue(
 		CommentEventsQueue,
 		[]string{
@@ -274,6 +284,32 @@ func (r *RabbitMQ) setupExchangesAndQueues() error {
 		return err
 	}
 
+	if err := r.declareAndBindQueue(
+		AuthUserEventsQueue,
+		[]string{
+			"user.*",
+		},
+		EventExchange,
+	); err != nil {
+		return err
+	}
+
+	for _, queue := range []string{
+		OrganizationTaskEventsQueue,
+		OrganizationNotificationEventsQueue,
+		OrganizationSearchEventsQueue,
+	} {
+		if err := r.declareAndBindQueue(
+			queue,
+			[]string{
+				"organization.*", // Bind to all organization events for any organization
+			},
+			EventExchange,
+		); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -330,6 +366,31 @@ func (r *RabbitMQ) declareAndBindQueue(queueName string, messageTypes []string,
 	return nil
 }
 
+// DeclareInstanceQueue declares a server-named, exclusive queue bound to the
+// given routing keys. Every process gets its own copy of each message and the
+// queue disappears with the connection, which suits cache invalidation.
+func (r *RabbitMQ) DeclareInstanceQueue(messageTypes []string, exchange string) (string, error) {
+	q, err := r.Channel.QueueDeclare(
+		"",    // name: let the server pick one
+		false, // durable
+		true,  // delete when unused
+		true,  // exclusive
+		false, // no-wait
+		nil,   // arguments
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to declare instance queue: %w", err)
+	}
+
+	for _, msg := range messageTypes {
+		if err := r.Channel.QueueBind(q.Name, msg, exchange, false, nil); err != nil {
+			return "", fmt.Errorf("failed to bind instance queue to %s: %v", msg, err)
+		}
+	}
+
+	return q.Name, nil
+}
+
 func (r *RabbitMQ) Close() {
 	if r.conn != nil {
 		r.conn.Close()
@@ -122,4 +122,31 @@ var (
 		},
 		[]string{"queue", "event_type"},
 	)
+
+	// TokenVerificationTotal counts access token checks by where they were decided
+	TokenVerificationTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "token_verifications_total",
+			Help: "Total number of access token verifications",
+		},
+		[]string{"result"}, // local, fallback, api_token, revoked, invalid
+	)
+
+	// LoginAttemptsTotal counts password logins by outcome
+	LoginAttemptsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_attempts_total",
+			Help: "Total number of password login attempts",
+		},
+		[]string{"result"}, // success, invalid, locked, throttled
+	)
+
+	// JWKSRefreshTotal counts key set fetches from the auth service
+	JWKSRefreshTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "jwks_refresh_total",
+			Help: "Total number of JWKS refreshes",
+		},
+		[]string{"status"},
+	)
 )
@@ -0,0 +1,144 @@
+// Package orgsettings lets services read organization settings owned by
+// organization-service. Settings are cached per organization and dropped as
+// soon as organization-service announces a change.
+package orgsettings
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aliirah/task-flow/shared/contracts"
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	"github.com/aliirah/task-flow/shared/logging"
+	"github.com/aliirah/task-flow/shared/messaging"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// DefaultTTL bounds how long cached settings are used when a change event
+// gets lost
+const DefaultTTL = 5 * time.Minute
+
+// Client reads organization settings through organization-service
+type Client struct {
+	org organizationpb.OrganizationServiceClient
+	ttl time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]entry
+	// minVersions holds the latest version announced for an organization so
+	// a fetch racing with a change doesn't cache the old settings
+	minVersions map[string]int64
+}
+
+type entry struct {
+	settings  orgdomain.Settings
+	version   int64
+	expiresAt time.Time
+}
+
+// NewClient creates a settings client, a ttl of zero uses DefaultTTL
+func NewClient(org organizationpb.OrganizationServiceClient, ttl time.Duration) *Client {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Client{
+		org:         org,
+		ttl:         ttl,
+		entries:     make(map[string]entry),
+		minVersions: make(map[string]int64),
+	}
+}
+
+// Get returns an organization's settings. A cached copy past its ttl is still
+// returned when organization-service can't be reached.
+func (c *Client) Get(ctx context.Context, organizationID string) (orgdomain.Settings, error) {
+	c.mu.RLock()
+	cached, ok := c.entries[organizationID]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.settings, nil
+	}
+
+	if c.org == nil {
+		return orgdomain.Settings{}, fmt.Errorf("organization service not available")
+	}
+	resp, err := c.org.GetOrganizationSettings(ctx, &organizationpb.GetOrganizationSettingsRequest{
+		OrganizationId: organizationID,
+	})
+	if err != nil {
+		if ok {
+			logging.S().Warnw("serving stale organization settings", "organizationId", organizationID, "error", err)
+			return cached.settings, nil
+		}
+		return orgdomain.Settings{}, fmt.Errorf("failed to fetch organization settings: %w", err)
+	}
+
+	settings := FromProto(resp)
+	settings.Normalize()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if resp.GetVersion() >= c.minVersions[organizationID] {
+		c.entries[organizationID] = entry{
+			settings:  settings,
+			version:   resp.GetVersion(),
+			expiresAt: time.Now().Add(c.ttl),
+		}
+	}
+	return settings, nil
+}
+
+// Invalidate drops the cached settings of an organization older than version
+func (c *Client) Invalidate(organizationID string, version int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version > c.minVersions[organizationID] {
+		c.minVersions[organizationID] = version
+	}
+	if cached, ok := c.entries[organizationID]; ok && cached.version < version {
+		delete(c.entries, organizationID)
+	}
+}
+
+// forget drops everything known about a deleted organization
+func (c *Client) forget(organizationID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, organizationID)
+	delete(c.minVersions, organizationID)
+}
+
+// Listen consumes organization events on a queue of this process, so every
+// replica invalidates its own cache
+func (c *Client) Listen(rmq *messaging.RabbitMQ) error {
+	queue, err := rmq.DeclareInstanceQueue([]string{"organization.*"}, messaging.EventExchange)
+	if err != nil {
+		return err
+	}
+	logging.S().Infow("organization settings cache listening for events", "queue", queue)
+	return rmq.ConsumeMessages(queue, c.handle)
+}
+
+func (c *Client) handle(ctx context.Context, msg amqp.Delivery) error {
+	var amqpMsg contracts.AmqpMessage
+	if err := json.Unmarshal(msg.Body, &amqpMsg); err != nil {
+		return fmt.Errorf("failed to unmarshal AMQP message: %w", err)
+	}
+
+	switch amqpMsg.EventType {
+	case contracts.OrganizationEventSettingsUpdated:
+		var event contracts.OrganizationSettingsUpdatedEvent
+		if err := json.Unmarshal(amqpMsg.Data, &event); err != nil {
+			logging.S().Errorw("settings cache failed to parse event", "error", err)
+			return nil
+		}
+		c.Invalidate(event.OrganizationID, event.Version)
+	case contracts.OrganizationEventDeleted:
+		c.forget(amqpMsg.OrganizationID)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package orgsettings
+
+import (
+	"time"
+
+	orgdomain "github.com/aliirah/task-flow/shared/domain/organization"
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// FromProto converts a settings message into the domain document
+func FromProto(msg *organizationpb.OrganizationSettings) orgdomain.Settings {
+	return orgdomain.Settings{
+		SchemaVersion: int(msg.GetSchemaVersion()),
+		Tasks: orgdomain.TaskSettings{
+			DefaultPriority: msg.GetTasks().GetDefaultPriority(),
+			AllowedTypes:    msg.GetTasks().GetAllowedTypes(),
+		},
+		Notifications: orgdomain.NotificationSettings{
+			MutedEvents: msg.GetNotifications().GetMutedEvents(),
+		},
+		Security: orgdomain.SecuritySettings{
+			RequireTwoFactor: msg.GetSecurity().GetRequireTwoFactor(),
+		},
+		Membership: orgdomain.MembershipSettings{
+			RequireApproval: msg.GetMembership().GetRequireApproval(),
+		},
+		Retention: orgdomain.RetentionSettings{
+			ArchivedTaskDays: int(msg.GetRetention().GetArchivedTaskDays()),
+		},
+	}
+}
+
+// ToProto converts the domain document of an organization into its message
+func ToProto(organizationID string, version int64, settings orgdomain.Settings, updatedAt time.Time) *organizationpb.OrganizationSettings {
+	return &organizationpb.OrganizationSettings{
+		OrganizationId: organizationID,
+		Version:        version,
+		SchemaVersion:  int32(settings.SchemaVersion),
+		Tasks: &organizationpb.OrganizationTaskSettings{
+			DefaultPriority: settings.Tasks.DefaultPriority,
+			AllowedTypes:    settings.Tasks.AllowedTypes,
+		},
+		Notifications: &organizationpb.OrganizationNotificationSettings{
+			MutedEvents: settings.Notifications.MutedEvents,
+		},
+		Security: &organizationpb.OrganizationSecuritySettings{
+			RequireTwoFactor: settings.Security.RequireTwoFactor,
+		},
+		Membership: &organizationpb.OrganizationMembershipSettings{
+			RequireApproval: settings.Membership.RequireApproval,
+		},
+		Retention: &organizationpb.OrganizationRetentionSettings{
+			ArchivedTaskDays: int32(settings.Retention.ArchivedTaskDays),
+		},
+		UpdatedAt: timestamppb.New(updatedAt),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,15 +25,26 @@ const (
 )
 
 type Organization struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	OwnerId       string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OwnerId              string                 `protobuf:"bytes,4,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	RequireVerifiedEmail bool                   `protobuf:"varint,7,opt,name=require_verified_email,json=requireVerifiedEmail,proto3" json:"require_verified_email,omitempty"`
+	RequireTwoFactor     bool                   `protobuf:"varint,8,opt,name=require_two_factor,json=requireTwoFactor,proto3" json:"require_two_factor,omitempty"`
+	// Password rules for members, stricter than the deployment policy only
+	PasswordMinLength  int32 `protobuf:"varint,9,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`
+	PasswordMinClasses int32 `protobuf:"varint,10,opt,name=password_min_classes,json=passwordMinClasses,proto3" json:"password_min_classes,omitempty"` // of lower, upper, digit and symbol
+	PasswordHistory    int32 `protobuf:"varint,11,opt,name=password_history,json=passwordHistory,proto3" json:"password_history,omitempty"`            // previous passwords that cannot be reused
+	// Set while a deletion is pending, the organization is purged after
+	// delete_after
+	DeletionRequestedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=deletion_requested_at,json=deletionRequestedAt,proto3" json:"deletion_requested_at,omitempty"`
+	DeleteAfter         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=delete_after,json=deleteAfter,proto3" json:"delete_after,omitempty"`
+	Plan                string                 `protobuf:"bytes,14,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Organization) Reset() {
@@ -107,6 +119,62 @@ func (x *Organization) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Organization) GetRequireVerifiedEmail() bool {
+	if x != nil {
+		return x.RequireVerifiedEmail
+	}
+	return false
+}
+
+func (x *Organization) GetRequireTwoFactor() bool {
+	if x != nil {
+		return x.RequireTwoFactor
+	}
+	return false
+}
+
+func (x *Organization) GetPasswordMinLength() int32 {
+	if x != nil {
+		return x.PasswordMinLength
+	}
+	return 0
+}
+
+func (x *Organization) GetPasswordMinClasses() int32 {
+	if x != nil {
+		return x.PasswordMinClasses
+	}
+	return 0
+}
+
+func (x *Organization) GetPasswordHistory() int32 {
+	if x != nil {
+		return x.PasswordHistory
+	}
+	return 0
+}
+
+func (x *Organization) GetDeletionRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionRequestedAt
+	}
+	return nil
+}
+
+func (x *Organization) GetDeleteAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteAfter
+	}
+	return nil
+}
+
+func (x *Organization) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
 type OrganizationMember struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -488,12 +556,17 @@ func (x *ListOrganizationsByIDsResponse) GetItems() []*Organization {
 }
 
 type UpdateOrganizationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RequireVerifiedEmail *wrapperspb.BoolValue  `protobuf:"bytes,4,opt,name=require_verified_email,json=requireVerifiedEmail,proto3" json:"require_verified_email,omitempty"` // unset leaves it unchanged
+	RequireTwoFactor     *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=require_two_factor,json=requireTwoFactor,proto3" json:"require_two_factor,omitempty"`
+	PasswordMinLength    *wrapperspb.Int32Value `protobuf:"bytes,6,opt,name=password_min_length,json=passwordMinLength,proto3" json:"password_min_length,omitempty"`
+	PasswordMinClasses   *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=password_min_classes,json=passwordMinClasses,proto3" json:"password_min_classes,omitempty"`
+	PasswordHistory      *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=password_history,json=passwordHistory,proto3" json:"password_history,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *UpdateOrganizationRequest) Reset() {
@@ -547,9 +620,45 @@ func (x *UpdateOrganizationRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateOrganizationRequest) GetRequireVerifiedEmail() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.RequireVerifiedEmail
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequest) GetRequireTwoFactor() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.RequireTwoFactor
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequest) GetPasswordMinLength() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PasswordMinLength
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequest) GetPasswordMinClasses() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PasswordMinClasses
+	}
+	return nil
+}
+
+func (x *UpdateOrganizationRequest) GetPasswordHistory() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.PasswordHistory
+	}
+	return nil
+}
+
 type DeleteOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ConfirmName   string                 `protobuf:"bytes,2,opt,name=confirm_name,json=confirmName,proto3" json:"confirm_name,omitempty"` // must match the organization name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -591,6 +700,109 @@ func (x *DeleteOrganizationRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteOrganizationRequest) GetConfirmName() string {
+	if x != nil {
+		return x.ConfirmName
+	}
+	return ""
+}
+
+type CancelOrganizationDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrganizationDeletionRequest) Reset() {
+	*x = CancelOrganizationDeletionRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrganizationDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrganizationDeletionRequest) ProtoMessage() {}
+
+func (x *CancelOrganizationDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrganizationDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrganizationDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelOrganizationDeletionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type TransferOwnershipRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	NewOwnerId     string                 `protobuf:"bytes,2,opt,name=new_owner_id,json=newOwnerId,proto3" json:"new_owner_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TransferOwnershipRequest) Reset() {
+	*x = TransferOwnershipRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOwnershipRequest) ProtoMessage() {}
+
+func (x *TransferOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*TransferOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferOwnershipRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TransferOwnershipRequest) GetNewOwnerId() string {
+	if x != nil {
+		return x.NewOwnerId
+	}
+	return ""
+}
+
 type AddMemberRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
@@ -602,7 +814,7 @@ type AddMemberRequest struct {
 
 func (x *AddMemberRequest) Reset() {
 	*x = AddMemberRequest{}
-	mi := &file_organization_v1_organization_proto_msgTypes[10]
+	mi := &file_organization_v1_organization_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -614,7 +826,7 @@ func (x *AddMemberRequest) String() string {
 func (*AddMemberRequest) ProtoMessage() {}
 
 func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[10]
+	mi := &file_organization_v1_organization_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +839,7 @@ func (x *AddMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddMemberRequest.ProtoReflect.Descriptor instead.
 func (*AddMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{10}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AddMemberRequest) GetOrganizationId() string {
@@ -661,7 +873,7 @@ type RemoveMemberRequest struct {
 
 func (x *RemoveMemberRequest) Reset() {
 	*x = RemoveMemberRequest{}
-	mi := &file_organization_v1_organization_proto_msgTypes[11]
+	mi := &file_organization_v1_organization_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +885,7 @@ func (x *RemoveMemberRequest) String() string {
 func (*RemoveMemberRequest) ProtoMessage() {}
 
 func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[11]
+	mi := &file_organization_v1_organization_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +898,7 @@ func (x *RemoveMemberRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveMemberRequest.ProtoReflect.Descriptor instead.
 func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{11}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RemoveMemberRequest) GetOrganizationId() string {
@@ -714,7 +926,7 @@ type ListMembersRequest struct {
 
 func (x *ListMembersRequest) Reset() {
 	*x = ListMembersRequest{}
-	mi := &file_organization_v1_organization_proto_msgTypes[12]
+	mi := &file_organization_v1_organization_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -726,7 +938,7 @@ func (x *ListMembersRequest) String() string {
 func (*ListMembersRequest) ProtoMessage() {}
 
 func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[12]
+	mi := &file_organization_v1_organization_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,7 +951,7 @@ func (x *ListMembersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersRequest.ProtoReflect.Descriptor instead.
 func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{12}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListMembersRequest) GetOrganizationId() string {
@@ -772,7 +984,7 @@ type ListMembersResponse struct {
 
 func (x *ListMembersResponse) Reset() {
 	*x = ListMembersResponse{}
-	mi := &file_organization_v1_organization_proto_msgTypes[13]
+	mi := &file_organization_v1_organization_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -784,7 +996,7 @@ func (x *ListMembersResponse) String() string {
 func (*ListMembersResponse) ProtoMessage() {}
 
 func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[13]
+	mi := &file_organization_v1_organization_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,7 +1009,7 @@ func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
 func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{13}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListMembersResponse) GetItems() []*OrganizationMember {
@@ -816,7 +1028,7 @@ type ListUserMembershipsRequest struct {
 
 func (x *ListUserMembershipsRequest) Reset() {
 	*x = ListUserMembershipsRequest{}
-	mi := &file_organization_v1_organization_proto_msgTypes[14]
+	mi := &file_organization_v1_organization_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -828,7 +1040,7 @@ func (x *ListUserMembershipsRequest) String() string {
 func (*ListUserMembershipsRequest) ProtoMessage() {}
 
 func (x *ListUserMembershipsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[14]
+	mi := &file_organization_v1_organization_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1053,7 @@ func (x *ListUserMembershipsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserMembershipsRequest.ProtoReflect.Descriptor instead.
 func (*ListUserMembershipsRequest) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{14}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListUserMembershipsRequest) GetUserId() string {
@@ -860,7 +1072,7 @@ type ListUserMembershipsResponse struct {
 
 func (x *ListUserMembershipsResponse) Reset() {
 	*x = ListUserMembershipsResponse{}
-	mi := &file_organization_v1_organization_proto_msgTypes[15]
+	mi := &file_organization_v1_organization_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -872,7 +1084,7 @@ func (x *ListUserMembershipsResponse) String() string {
 func (*ListUserMembershipsResponse) ProtoMessage() {}
 
 func (x *ListUserMembershipsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_organization_v1_organization_proto_msgTypes[15]
+	mi := &file_organization_v1_organization_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,7 +1097,7 @@ func (x *ListUserMembershipsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserMembershipsResponse.ProtoReflect.Descriptor instead.
 func (*ListUserMembershipsResponse) Descriptor() ([]byte, []int) {
-	return file_organization_v1_organization_proto_rawDescGZIP(), []int{15}
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListUserMembershipsResponse) GetMemberships() []*OrganizationMember {
@@ -895,78 +1107,2746 @@ func (x *ListUserMembershipsResponse) GetMemberships() []*OrganizationMember {
 	return nil
 }
 
-var File_organization_v1_organization_proto protoreflect.FileDescriptor
+type UpdateMemberRoleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
 
-const file_organization_v1_organization_proto_rawDesc = "" +
-	"\n" +
-	"\"organization/v1/organization.proto\x12\x0forganization.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x01\n" +
-	"\fOrganization\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
-	"\bowner_id\x18\x04 \x01(\tR\aownerId\x129\n" +
-	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcd\x01\n" +
-	"\x12OrganizationMember\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
-	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"l\n" +
-	"\x19CreateOrganizationRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
-	"\bowner_id\x18\x03 \x01(\tR\aownerId\"(\n" +
-	"\x16GetOrganizationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
-	"\x18ListOrganizationsRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
-	"\x19ListOrganizationsResponse\x123\n" +
-	"\x05items\x18\x01 \x03(\v2\x1d.organization.v1.OrganizationR\x05items\"1\n" +
-	"\x1dListOrganizationsByIDsRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\"U\n" +
-	"\x1eListOrganizationsByIDsResponse\x123\n" +
-	"\x05items\x18\x01 \x03(\v2\x1d.organization.v1.OrganizationR\x05items\"a\n" +
-	"\x19UpdateOrganizationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"+\n" +
-	"\x19DeleteOrganizationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"h\n" +
-	"\x10AddMemberRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"W\n" +
-	"\x13RemoveMemberRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"g\n" +
-	"\x12ListMembersRequest\x12'\n" +
-	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
-	"\x13ListMembersResponse\x129\n" +
-	"\x05items\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\x05items\"5\n" +
-	"\x1aListUserMembershipsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"d\n" +
-	"\x1bListUserMembershipsResponse\x12E\n" +
-	"\vmemberships\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\vmemberships2\xe2\a\n" +
-	"\x13OrganizationService\x12_\n" +
-	"\x12CreateOrganization\x12*.organization.v1.CreateOrganizationRequest\x1a\x1d.organization.v1.Organization\x12Y\n" +
-	"\x0fGetOrganization\x12'.organization.v1.GetOrganizationRequest\x1a\x1d.organization.v1.Organization\x12j\n" +
-	"\x11ListOrganizations\x12).organization.v1.ListOrganizationsRequest\x1a*.organization.v1.ListOrganizationsResponse\x12y\n" +
-	"\x16ListOrganizationsByIDs\x12..organization.v1.ListOrganizationsByIDsRequest\x1a/.organization.v1.ListOrganizationsByIDsResponse\x12_\n" +
-	"\x12UpdateOrganization\x12*.organization.v1.UpdateOrganizationRequest\x1a\x1d.organization.v1.Organization\x12X\n" +
-	"\x12DeleteOrganization\x12*.organization.v1.DeleteOrganizationRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\tAddMember\x12!.organization.v1.AddMemberRequest\x1a#.organization.v1.OrganizationMember\x12L\n" +
-	"\fRemoveMember\x12$.organization.v1.RemoveMemberRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
-	"\vListMembers\x12#.organization.v1.ListMembersRequest\x1a$.organization.v1.ListMembersResponse\x12p\n" +
-	"\x13ListUserMemberships\x12+.organization.v1.ListUserMembershipsRequest\x1a,.organization.v1.ListUserMembershipsResponseBJZHgithub.com/aliirah/task-flow/shared/proto/organization/v1;organizationpbb\x06proto3"
+func (x *UpdateMemberRoleRequest) Reset() {
+	*x = UpdateMemberRoleRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateMemberRoleRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateMemberRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type MemberStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MemberStatusRequest) Reset() {
+	*x = MemberStatusRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberStatusRequest) ProtoMessage() {}
+
+func (x *MemberStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberStatusRequest.ProtoReflect.Descriptor instead.
+func (*MemberStatusRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MemberStatusRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *MemberStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type LeaveOrganizationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaveOrganizationRequest) Reset() {
+	*x = LeaveOrganizationRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveOrganizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveOrganizationRequest) ProtoMessage() {}
+
+func (x *LeaveOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*LeaveOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LeaveOrganizationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *LeaveOrganizationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CheckPermissionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Permission     string                 `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission,omitempty"` // e.g. task.delete, empty checks membership only
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CheckPermissionRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Member        bool                   `protobuf:"varint,2,opt,name=member,proto3" json:"member,omitempty"` // false when the user is not an active member
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // membership status, empty when the user never joined
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetMember() bool {
+	if x != nil {
+		return x.Member
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CheckPermissionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type Invitation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId   string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	OrganizationName string                 `protobuf:"bytes,3,opt,name=organization_name,json=organizationName,proto3" json:"organization_name,omitempty"`
+	Email            string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Role             string                 `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	InviterId        string                 `protobuf:"bytes,6,opt,name=inviter_id,json=inviterId,proto3" json:"inviter_id,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"` // pending, accepted, declined, revoked or expired
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RespondedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	Domain           string                 `protobuf:"bytes,11,opt,name=domain,proto3" json:"domain,omitempty"` // set when offered because of the email domain
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	mi := &file_organization_v1_organization_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Invitation) GetOrganizationName() string {
+	if x != nil {
+		return x.OrganizationName
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invitation) GetInviterId() string {
+	if x != nil {
+		return x.InviterId
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type CreateInvitationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Email          string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role           string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateInvitationRequest) Reset() {
+	*x = CreateInvitationRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvitationRequest) ProtoMessage() {}
+
+func (x *CreateInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateInvitationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateInvitationRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ListInvitationsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // empty lists pending invitations
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListInvitationsRequest) Reset() {
+	*x = ListInvitationsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsRequest) ProtoMessage() {}
+
+func (x *ListInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListInvitationsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListInvitationsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Invitation          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInvitationsResponse) Reset() {
+	*x = ListInvitationsResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResponse) ProtoMessage() {}
+
+func (x *ListInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListInvitationsResponse) GetItems() []*Invitation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InvitationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InvitationRequest) Reset() {
+	*x = InvitationRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationRequest) ProtoMessage() {}
+
+func (x *InvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationRequest.ProtoReflect.Descriptor instead.
+func (*InvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InvitationRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *InvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListUserInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserInvitationsRequest) Reset() {
+	*x = ListUserInvitationsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserInvitationsRequest) ProtoMessage() {}
+
+func (x *ListUserInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListUserInvitationsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RespondInvitationRequest identifies the invitation by id or by the token
+// from the invitation email. The email must match the invited address.
+type RespondInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondInvitationRequest) Reset() {
+	*x = RespondInvitationRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondInvitationRequest) ProtoMessage() {}
+
+func (x *RespondInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RespondInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RespondInvitationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RespondInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RespondInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RespondInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ClaimInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInvitationsRequest) Reset() {
+	*x = ClaimInvitationsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInvitationsRequest) ProtoMessage() {}
+
+func (x *ClaimInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ClaimInvitationsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClaimInvitationsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ClaimInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*OrganizationMember  `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimInvitationsResponse) Reset() {
+	*x = ClaimInvitationsResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimInvitationsResponse) ProtoMessage() {}
+
+func (x *ClaimInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ClaimInvitationsResponse) GetMemberships() []*OrganizationMember {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+type Team struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LeadId         string                 `protobuf:"bytes,5,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"` // empty when the team has no lead
+	MemberIds      []string               `protobuf:"bytes,6,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_organization_v1_organization_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Team) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *Team) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+func (x *Team) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Team) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	LeadId         string                 `protobuf:"bytes,4,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	MemberIds      []string               `protobuf:"bytes,5,rep,name=member_ids,json=memberIds,proto3" json:"member_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateTeamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+func (x *CreateTeamRequest) GetMemberIds() []string {
+	if x != nil {
+		return x.MemberIds
+	}
+	return nil
+}
+
+type TeamRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamRequest) Reset() {
+	*x = TeamRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamRequest) ProtoMessage() {}
+
+func (x *TeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamRequest.ProtoReflect.Descriptor instead.
+func (*TeamRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TeamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTeamsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // only teams this user belongs to
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListTeamsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Team                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListTeamsResponse) GetItems() []*Team {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateTeamRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	OrganizationId string                  `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Id             string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description    *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	LeadId         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"` // empty clears the lead
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamRequest) ProtoMessage() {}
+
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateTeamRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTeamRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateTeamRequest) GetLeadId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LeadId
+	}
+	return nil
+}
+
+type TeamMemberRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	TeamId         string                 `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TeamMemberRequest) Reset() {
+	*x = TeamMemberRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMemberRequest) ProtoMessage() {}
+
+func (x *TeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*TeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TeamMemberRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *TeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+func (x *TeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type OrganizationSettings struct {
+	state          protoimpl.MessageState            `protogen:"open.v1"`
+	OrganizationId string                            `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Version        int64                             `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // bumped on every change
+	SchemaVersion  int32                             `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Tasks          *OrganizationTaskSettings         `protobuf:"bytes,4,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Notifications  *OrganizationNotificationSettings `protobuf:"bytes,5,opt,name=notifications,proto3" json:"notifications,omitempty"`
+	Security       *OrganizationSecuritySettings     `protobuf:"bytes,6,opt,name=security,proto3" json:"security,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp            `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Membership     *OrganizationMembershipSettings   `protobuf:"bytes,8,opt,name=membership,proto3" json:"membership,omitempty"`
+	Retention      *OrganizationRetentionSettings    `protobuf:"bytes,9,opt,name=retention,proto3" json:"retention,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationSettings) Reset() {
+	*x = OrganizationSettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSettings) ProtoMessage() {}
+
+func (x *OrganizationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationSettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *OrganizationSettings) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationSettings) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *OrganizationSettings) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *OrganizationSettings) GetTasks() *OrganizationTaskSettings {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetNotifications() *OrganizationNotificationSettings {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetSecurity() *OrganizationSecuritySettings {
+	if x != nil {
+		return x.Security
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetMembership() *OrganizationMembershipSettings {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+func (x *OrganizationSettings) GetRetention() *OrganizationRetentionSettings {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+type OrganizationTaskSettings struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DefaultPriority string                 `protobuf:"bytes,1,opt,name=default_priority,json=defaultPriority,proto3" json:"default_priority,omitempty"`
+	AllowedTypes    []string               `protobuf:"bytes,2,rep,name=allowed_types,json=allowedTypes,proto3" json:"allowed_types,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrganizationTaskSettings) Reset() {
+	*x = OrganizationTaskSettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationTaskSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationTaskSettings) ProtoMessage() {}
+
+func (x *OrganizationTaskSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationTaskSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationTaskSettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *OrganizationTaskSettings) GetDefaultPriority() string {
+	if x != nil {
+		return x.DefaultPriority
+	}
+	return ""
+}
+
+func (x *OrganizationTaskSettings) GetAllowedTypes() []string {
+	if x != nil {
+		return x.AllowedTypes
+	}
+	return nil
+}
+
+type OrganizationNotificationSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MutedEvents   []string               `protobuf:"bytes,1,rep,name=muted_events,json=mutedEvents,proto3" json:"muted_events,omitempty"` // notification event types not sent to members
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrganizationNotificationSettings) Reset() {
+	*x = OrganizationNotificationSettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationNotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationNotificationSettings) ProtoMessage() {}
+
+func (x *OrganizationNotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationNotificationSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationNotificationSettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *OrganizationNotificationSettings) GetMutedEvents() []string {
+	if x != nil {
+		return x.MutedEvents
+	}
+	return nil
+}
+
+type OrganizationSecuritySettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RequireTwoFactor bool                   `protobuf:"varint,1,opt,name=require_two_factor,json=requireTwoFactor,proto3" json:"require_two_factor,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrganizationSecuritySettings) Reset() {
+	*x = OrganizationSecuritySettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationSecuritySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationSecuritySettings) ProtoMessage() {}
+
+func (x *OrganizationSecuritySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationSecuritySettings.ProtoReflect.Descriptor instead.
+func (*OrganizationSecuritySettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *OrganizationSecuritySettings) GetRequireTwoFactor() bool {
+	if x != nil {
+		return x.RequireTwoFactor
+	}
+	return false
+}
+
+type OrganizationMembershipSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Members joining through an invitation stay pending until approved
+	RequireApproval bool `protobuf:"varint,1,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OrganizationMembershipSettings) Reset() {
+	*x = OrganizationMembershipSettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationMembershipSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationMembershipSettings) ProtoMessage() {}
+
+func (x *OrganizationMembershipSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationMembershipSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationMembershipSettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *OrganizationMembershipSettings) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+type OrganizationRetentionSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days archived tasks are kept before they are purged, 1 to 365
+	ArchivedTaskDays int32 `protobuf:"varint,1,opt,name=archived_task_days,json=archivedTaskDays,proto3" json:"archived_task_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OrganizationRetentionSettings) Reset() {
+	*x = OrganizationRetentionSettings{}
+	mi := &file_organization_v1_organization_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationRetentionSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationRetentionSettings) ProtoMessage() {}
+
+func (x *OrganizationRetentionSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationRetentionSettings.ProtoReflect.Descriptor instead.
+func (*OrganizationRetentionSettings) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *OrganizationRetentionSettings) GetArchivedTaskDays() int32 {
+	if x != nil {
+		return x.ArchivedTaskDays
+	}
+	return 0
+}
+
+type GetOrganizationUsageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationUsageRequest) Reset() {
+	*x = GetOrganizationUsageRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationUsageRequest) ProtoMessage() {}
+
+func (x *GetOrganizationUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationUsageRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetOrganizationUsageRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type SetOrganizationPlanRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Plan           string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"` // free, team or business
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetOrganizationPlanRequest) Reset() {
+	*x = SetOrganizationPlanRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetOrganizationPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOrganizationPlanRequest) ProtoMessage() {}
+
+func (x *SetOrganizationPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOrganizationPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetOrganizationPlanRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetOrganizationPlanRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *SetOrganizationPlanRequest) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+// OrganizationLimits caps what an organization may use, zero is unlimited
+type OrganizationLimits struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxMembers           int64                  `protobuf:"varint,1,opt,name=max_members,json=maxMembers,proto3" json:"max_members,omitempty"`
+	MaxTasks             int64                  `protobuf:"varint,2,opt,name=max_tasks,json=maxTasks,proto3" json:"max_tasks,omitempty"` // tasks that are not archived
+	MaxStorageBytes      int64                  `protobuf:"varint,3,opt,name=max_storage_bytes,json=maxStorageBytes,proto3" json:"max_storage_bytes,omitempty"`
+	ApiRequestsPerMinute int64                  `protobuf:"varint,4,opt,name=api_requests_per_minute,json=apiRequestsPerMinute,proto3" json:"api_requests_per_minute,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OrganizationLimits) Reset() {
+	*x = OrganizationLimits{}
+	mi := &file_organization_v1_organization_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationLimits) ProtoMessage() {}
+
+func (x *OrganizationLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationLimits.ProtoReflect.Descriptor instead.
+func (*OrganizationLimits) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *OrganizationLimits) GetMaxMembers() int64 {
+	if x != nil {
+		return x.MaxMembers
+	}
+	return 0
+}
+
+func (x *OrganizationLimits) GetMaxTasks() int64 {
+	if x != nil {
+		return x.MaxTasks
+	}
+	return 0
+}
+
+func (x *OrganizationLimits) GetMaxStorageBytes() int64 {
+	if x != nil {
+		return x.MaxStorageBytes
+	}
+	return 0
+}
+
+func (x *OrganizationLimits) GetApiRequestsPerMinute() int64 {
+	if x != nil {
+		return x.ApiRequestsPerMinute
+	}
+	return 0
+}
+
+type OrganizationUsage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Plan           string                 `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	Limits         *OrganizationLimits    `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	Members        int64                  `protobuf:"varint,4,opt,name=members,proto3" json:"members,omitempty"` // members who haven't left
+	Tasks          int64                  `protobuf:"varint,5,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	StorageBytes   int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // last counted domain event
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrganizationUsage) Reset() {
+	*x = OrganizationUsage{}
+	mi := &file_organization_v1_organization_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationUsage) ProtoMessage() {}
+
+func (x *OrganizationUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationUsage.ProtoReflect.Descriptor instead.
+func (*OrganizationUsage) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *OrganizationUsage) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationUsage) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *OrganizationUsage) GetLimits() *OrganizationLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *OrganizationUsage) GetMembers() int64 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *OrganizationUsage) GetTasks() int64 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *OrganizationUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *OrganizationUsage) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetOrganizationSettingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetOrganizationSettingsRequest) Reset() {
+	*x = GetOrganizationSettingsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrganizationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrganizationSettingsRequest) ProtoMessage() {}
+
+func (x *GetOrganizationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrganizationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetOrganizationSettingsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type UpdateOrganizationSettingsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	ExpectedVersion int64                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // rejects the update when settings changed since, 0 skips the check
+	Settings        *OrganizationSettings  `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`                                       // replaces the whole document
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateOrganizationSettingsRequest) Reset() {
+	*x = UpdateOrganizationSettingsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrganizationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrganizationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateOrganizationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrganizationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrganizationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateOrganizationSettingsRequest) GetSettings() *OrganizationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type OrganizationDomain struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	DefaultRole    string                 `protobuf:"bytes,4,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`
+	AutoJoin       bool                   `protobuf:"varint,5,opt,name=auto_join,json=autoJoin,proto3" json:"auto_join,omitempty"` // false offers users an invitation instead
+	// DNS TXT record the organization publishes to prove it owns the domain
+	VerificationRecord string                 `protobuf:"bytes,6,opt,name=verification_record,json=verificationRecord,proto3" json:"verification_record,omitempty"`
+	VerificationValue  string                 `protobuf:"bytes,7,opt,name=verification_value,json=verificationValue,proto3" json:"verification_value,omitempty"`
+	VerifiedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OrganizationDomain) Reset() {
+	*x = OrganizationDomain{}
+	mi := &file_organization_v1_organization_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrganizationDomain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationDomain) ProtoMessage() {}
+
+func (x *OrganizationDomain) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationDomain.ProtoReflect.Descriptor instead.
+func (*OrganizationDomain) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *OrganizationDomain) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetAutoJoin() bool {
+	if x != nil {
+		return x.AutoJoin
+	}
+	return false
+}
+
+func (x *OrganizationDomain) GetVerificationRecord() string {
+	if x != nil {
+		return x.VerificationRecord
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetVerificationValue() string {
+	if x != nil {
+		return x.VerificationValue
+	}
+	return ""
+}
+
+func (x *OrganizationDomain) GetVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return nil
+}
+
+func (x *OrganizationDomain) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddDomainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Domain         string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DefaultRole    string                 `protobuf:"bytes,3,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`
+	AutoJoin       bool                   `protobuf:"varint,4,opt,name=auto_join,json=autoJoin,proto3" json:"auto_join,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddDomainRequest) Reset() {
+	*x = AddDomainRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDomainRequest) ProtoMessage() {}
+
+func (x *AddDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDomainRequest.ProtoReflect.Descriptor instead.
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *AddDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *AddDomainRequest) GetDefaultRole() string {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return ""
+}
+
+func (x *AddDomainRequest) GetAutoJoin() bool {
+	if x != nil {
+		return x.AutoJoin
+	}
+	return false
+}
+
+type ListDomainsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListDomainsRequest) Reset() {
+	*x = ListDomainsRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsRequest) ProtoMessage() {}
+
+func (x *ListDomainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsRequest.ProtoReflect.Descriptor instead.
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListDomainsRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type ListDomainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*OrganizationDomain  `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDomainsResponse) Reset() {
+	*x = ListDomainsResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDomainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDomainsResponse) ProtoMessage() {}
+
+func (x *ListDomainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDomainsResponse.ProtoReflect.Descriptor instead.
+func (*ListDomainsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListDomainsResponse) GetItems() []*OrganizationDomain {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type UpdateDomainRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	OrganizationId string                  `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Id             string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	DefaultRole    *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=default_role,json=defaultRole,proto3" json:"default_role,omitempty"`
+	AutoJoin       *wrapperspb.BoolValue   `protobuf:"bytes,4,opt,name=auto_join,json=autoJoin,proto3" json:"auto_join,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateDomainRequest) Reset() {
+	*x = UpdateDomainRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDomainRequest) ProtoMessage() {}
+
+func (x *UpdateDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDomainRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDomainRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateDomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *UpdateDomainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDomainRequest) GetDefaultRole() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DefaultRole
+	}
+	return nil
+}
+
+func (x *UpdateDomainRequest) GetAutoJoin() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.AutoJoin
+	}
+	return nil
+}
+
+type DomainRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DomainRequest) Reset() {
+	*x = DomainRequest{}
+	mi := &file_organization_v1_organization_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainRequest) ProtoMessage() {}
+
+func (x *DomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainRequest.ProtoReflect.Descriptor instead.
+func (*DomainRequest) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DomainRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *DomainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ClaimDomainMembershipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memberships   []*OrganizationMember  `protobuf:"bytes,1,rep,name=memberships,proto3" json:"memberships,omitempty"`
+	Offers        []*Invitation          `protobuf:"bytes,2,rep,name=offers,proto3" json:"offers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClaimDomainMembershipsResponse) Reset() {
+	*x = ClaimDomainMembershipsResponse{}
+	mi := &file_organization_v1_organization_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClaimDomainMembershipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimDomainMembershipsResponse) ProtoMessage() {}
+
+func (x *ClaimDomainMembershipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_organization_v1_organization_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClaimDomainMembershipsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimDomainMembershipsResponse) Descriptor() ([]byte, []int) {
+	return file_organization_v1_organization_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ClaimDomainMembershipsResponse) GetMemberships() []*OrganizationMember {
+	if x != nil {
+		return x.Memberships
+	}
+	return nil
+}
+
+func (x *ClaimDomainMembershipsResponse) GetOffers() []*Invitation {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+var File_organization_v1_organization_proto protoreflect.FileDescriptor
+
+const file_organization_v1_organization_proto_rawDesc = "" +
+	"\n" +
+	"\"organization/v1/organization.proto\x12\x0forganization.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf9\x04\n" +
+	"\fOrganization\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x19\n" +
+	"\bowner_id\x18\x04 \x01(\tR\aownerId\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x124\n" +
+	"\x16require_verified_email\x18\a \x01(\bR\x14requireVerifiedEmail\x12,\n" +
+	"\x12require_two_factor\x18\b \x01(\bR\x10requireTwoFactor\x12.\n" +
+	"\x13password_min_length\x18\t \x01(\x05R\x11passwordMinLength\x120\n" +
+	"\x14password_min_classes\x18\n" +
+	" \x01(\x05R\x12passwordMinClasses\x12)\n" +
+	"\x10password_history\x18\v \x01(\x05R\x0fpasswordHistory\x12N\n" +
+	"\x15deletion_requested_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x13deletionRequestedAt\x12=\n" +
+	"\fdelete_after\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vdeleteAfter\x12\x12\n" +
+	"\x04plan\x18\x0e \x01(\tR\x04plan\"\xcd\x01\n" +
+	"\x12OrganizationMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"l\n" +
+	"\x19CreateOrganizationRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\"(\n" +
+	"\x16GetOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"Z\n" +
+	"\x18ListOrganizationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
+	"\x19ListOrganizationsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.organization.v1.OrganizationR\x05items\"1\n" +
+	"\x1dListOrganizationsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"U\n" +
+	"\x1eListOrganizationsByIDsResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.organization.v1.OrganizationR\x05items\"\xe1\x03\n" +
+	"\x19UpdateOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12P\n" +
+	"\x16require_verified_email\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\x14requireVerifiedEmail\x12H\n" +
+	"\x12require_two_factor\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x10requireTwoFactor\x12K\n" +
+	"\x13password_min_length\x18\x06 \x01(\v2\x1b.google.protobuf.Int32ValueR\x11passwordMinLength\x12M\n" +
+	"\x14password_min_classes\x18\a \x01(\v2\x1b.google.protobuf.Int32ValueR\x12passwordMinClasses\x12F\n" +
+	"\x10password_history\x18\b \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fpasswordHistory\"N\n" +
+	"\x19DeleteOrganizationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fconfirm_name\x18\x02 \x01(\tR\vconfirmName\"3\n" +
+	"!CancelOrganizationDeletionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"e\n" +
+	"\x18TransferOwnershipRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12 \n" +
+	"\fnew_owner_id\x18\x02 \x01(\tR\n" +
+	"newOwnerId\"h\n" +
+	"\x10AddMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"W\n" +
+	"\x13RemoveMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"g\n" +
+	"\x12ListMembersRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"P\n" +
+	"\x13ListMembersResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\x05items\"5\n" +
+	"\x1aListUserMembershipsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"d\n" +
+	"\x1bListUserMembershipsResponse\x12E\n" +
+	"\vmemberships\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\vmemberships\"o\n" +
+	"\x17UpdateMemberRoleRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"W\n" +
+	"\x13MemberStatusRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\\\n" +
+	"\x18LeaveOrganizationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"z\n" +
+	"\x16CheckPermissionRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x03 \x01(\tR\n" +
+	"permission\"w\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
+	"\x06member\x18\x02 \x01(\bR\x06member\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"\xa0\x03\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12+\n" +
+	"\x11organization_name\x18\x03 \x01(\tR\x10organizationName\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x05 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"inviter_id\x18\x06 \x01(\tR\tinviterId\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fresponded_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\x12\x16\n" +
+	"\x06domain\x18\v \x01(\tR\x06domain\"l\n" +
+	"\x17CreateInvitationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"Y\n" +
+	"\x16ListInvitationsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"L\n" +
+	"\x17ListInvitationsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.organization.v1.InvitationR\x05items\"L\n" +
+	"\x11InvitationRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
+	"\x1aListUserInvitationsRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"o\n" +
+	"\x18RespondInvitationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"H\n" +
+	"\x17ClaimInvitationsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"a\n" +
+	"\x18ClaimInvitationsResponse\x12E\n" +
+	"\vmemberships\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\vmemberships\"\xa3\x02\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x17\n" +
+	"\alead_id\x18\x05 \x01(\tR\x06leadId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x06 \x03(\tR\tmemberIds\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x01\n" +
+	"\x11CreateTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\alead_id\x18\x04 \x01(\tR\x06leadId\x12\x1d\n" +
+	"\n" +
+	"member_ids\x18\x05 \x03(\tR\tmemberIds\"F\n" +
+	"\vTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"T\n" +
+	"\x10ListTeamsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"@\n" +
+	"\x11ListTeamsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.organization.v1.TeamR\x05items\"\xf5\x01\n" +
+	"\x11UpdateTeamRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x120\n" +
+	"\x04name\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12>\n" +
+	"\vdescription\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x125\n" +
+	"\alead_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x06leadId\"n\n" +
+	"\x11TeamMemberRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\ateam_id\x18\x02 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xbf\x04\n" +
+	"\x14OrganizationSettings\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12?\n" +
+	"\x05tasks\x18\x04 \x01(\v2).organization.v1.OrganizationTaskSettingsR\x05tasks\x12W\n" +
+	"\rnotifications\x18\x05 \x01(\v21.organization.v1.OrganizationNotificationSettingsR\rnotifications\x12I\n" +
+	"\bsecurity\x18\x06 \x01(\v2-.organization.v1.OrganizationSecuritySettingsR\bsecurity\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12O\n" +
+	"\n" +
+	"membership\x18\b \x01(\v2/.organization.v1.OrganizationMembershipSettingsR\n" +
+	"membership\x12L\n" +
+	"\tretention\x18\t \x01(\v2..organization.v1.OrganizationRetentionSettingsR\tretention\"j\n" +
+	"\x18OrganizationTaskSettings\x12)\n" +
+	"\x10default_priority\x18\x01 \x01(\tR\x0fdefaultPriority\x12#\n" +
+	"\rallowed_types\x18\x02 \x03(\tR\fallowedTypes\"E\n" +
+	" OrganizationNotificationSettings\x12!\n" +
+	"\fmuted_events\x18\x01 \x03(\tR\vmutedEvents\"L\n" +
+	"\x1cOrganizationSecuritySettings\x12,\n" +
+	"\x12require_two_factor\x18\x01 \x01(\bR\x10requireTwoFactor\"K\n" +
+	"\x1eOrganizationMembershipSettings\x12)\n" +
+	"\x10require_approval\x18\x01 \x01(\bR\x0frequireApproval\"M\n" +
+	"\x1dOrganizationRetentionSettings\x12,\n" +
+	"\x12archived_task_days\x18\x01 \x01(\x05R\x10archivedTaskDays\"F\n" +
+	"\x1bGetOrganizationUsageRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"Y\n" +
+	"\x1aSetOrganizationPlanRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\"\xb5\x01\n" +
+	"\x12OrganizationLimits\x12\x1f\n" +
+	"\vmax_members\x18\x01 \x01(\x03R\n" +
+	"maxMembers\x12\x1b\n" +
+	"\tmax_tasks\x18\x02 \x01(\x03R\bmaxTasks\x12*\n" +
+	"\x11max_storage_bytes\x18\x03 \x01(\x03R\x0fmaxStorageBytes\x125\n" +
+	"\x17api_requests_per_minute\x18\x04 \x01(\x03R\x14apiRequestsPerMinute\"\x9d\x02\n" +
+	"\x11OrganizationUsage\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\x12;\n" +
+	"\x06limits\x18\x03 \x01(\v2#.organization.v1.OrganizationLimitsR\x06limits\x12\x18\n" +
+	"\amembers\x18\x04 \x01(\x03R\amembers\x12\x14\n" +
+	"\x05tasks\x18\x05 \x01(\x03R\x05tasks\x12#\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03R\fstorageBytes\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"I\n" +
+	"\x1eGetOrganizationSettingsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xba\x01\n" +
+	"!UpdateOrganizationSettingsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\x12A\n" +
+	"\bsettings\x18\x03 \x01(\v2%.organization.v1.OrganizationSettingsR\bsettings\"\xfd\x02\n" +
+	"\x12OrganizationDomain\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12!\n" +
+	"\fdefault_role\x18\x04 \x01(\tR\vdefaultRole\x12\x1b\n" +
+	"\tauto_join\x18\x05 \x01(\bR\bautoJoin\x12/\n" +
+	"\x13verification_record\x18\x06 \x01(\tR\x12verificationRecord\x12-\n" +
+	"\x12verification_value\x18\a \x01(\tR\x11verificationValue\x12;\n" +
+	"\vverified_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"verifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x93\x01\n" +
+	"\x10AddDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12!\n" +
+	"\fdefault_role\x18\x03 \x01(\tR\vdefaultRole\x12\x1b\n" +
+	"\tauto_join\x18\x04 \x01(\bR\bautoJoin\"=\n" +
+	"\x12ListDomainsRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"P\n" +
+	"\x13ListDomainsResponse\x129\n" +
+	"\x05items\x18\x01 \x03(\v2#.organization.v1.OrganizationDomainR\x05items\"\xc8\x01\n" +
+	"\x13UpdateDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12?\n" +
+	"\fdefault_role\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdefaultRole\x127\n" +
+	"\tauto_join\x18\x04 \x01(\v2\x1a.google.protobuf.BoolValueR\bautoJoin\"H\n" +
+	"\rDomainRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x9c\x01\n" +
+	"\x1eClaimDomainMembershipsResponse\x12E\n" +
+	"\vmemberships\x18\x01 \x03(\v2#.organization.v1.OrganizationMemberR\vmemberships\x123\n" +
+	"\x06offers\x18\x02 \x03(\v2\x1b.organization.v1.InvitationR\x06offers2\xc9\x1f\n" +
+	"\x13OrganizationService\x12_\n" +
+	"\x12CreateOrganization\x12*.organization.v1.CreateOrganizationRequest\x1a\x1d.organization.v1.Organization\x12Y\n" +
+	"\x0fGetOrganization\x12'.organization.v1.GetOrganizationRequest\x1a\x1d.organization.v1.Organization\x12j\n" +
+	"\x11ListOrganizations\x12).organization.v1.ListOrganizationsRequest\x1a*.organization.v1.ListOrganizationsResponse\x12y\n" +
+	"\x16ListOrganizationsByIDs\x12..organization.v1.ListOrganizationsByIDsRequest\x1a/.organization.v1.ListOrganizationsByIDsResponse\x12_\n" +
+	"\x12UpdateOrganization\x12*.organization.v1.UpdateOrganizationRequest\x1a\x1d.organization.v1.Organization\x12_\n" +
+	"\x12DeleteOrganization\x12*.organization.v1.DeleteOrganizationRequest\x1a\x1d.organization.v1.Organization\x12o\n" +
+	"\x1aCancelOrganizationDeletion\x122.organization.v1.CancelOrganizationDeletionRequest\x1a\x1d.organization.v1.Organization\x12]\n" +
+	"\x11TransferOwnership\x12).organization.v1.TransferOwnershipRequest\x1a\x1d.organization.v1.Organization\x12S\n" +
+	"\tAddMember\x12!.organization.v1.AddMemberRequest\x1a#.organization.v1.OrganizationMember\x12L\n" +
+	"\fRemoveMember\x12$.organization.v1.RemoveMemberRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\vListMembers\x12#.organization.v1.ListMembersRequest\x1a$.organization.v1.ListMembersResponse\x12p\n" +
+	"\x13ListUserMemberships\x12+.organization.v1.ListUserMembershipsRequest\x1a,.organization.v1.ListUserMembershipsResponse\x12a\n" +
+	"\x10UpdateMemberRole\x12(.organization.v1.UpdateMemberRoleRequest\x1a#.organization.v1.OrganizationMember\x12Z\n" +
+	"\rSuspendMember\x12$.organization.v1.MemberStatusRequest\x1a#.organization.v1.OrganizationMember\x12]\n" +
+	"\x10ReactivateMember\x12$.organization.v1.MemberStatusRequest\x1a#.organization.v1.OrganizationMember\x12Z\n" +
+	"\rApproveMember\x12$.organization.v1.MemberStatusRequest\x1a#.organization.v1.OrganizationMember\x12V\n" +
+	"\x11LeaveOrganization\x12).organization.v1.LeaveOrganizationRequest\x1a\x16.google.protobuf.Empty\x12d\n" +
+	"\x0fCheckPermission\x12'.organization.v1.CheckPermissionRequest\x1a(.organization.v1.CheckPermissionResponse\x12Y\n" +
+	"\x10CreateInvitation\x12(.organization.v1.CreateInvitationRequest\x1a\x1b.organization.v1.Invitation\x12d\n" +
+	"\x0fListInvitations\x12'.organization.v1.ListInvitationsRequest\x1a(.organization.v1.ListInvitationsResponse\x12S\n" +
+	"\x10ResendInvitation\x12\".organization.v1.InvitationRequest\x1a\x1b.organization.v1.Invitation\x12N\n" +
+	"\x10RevokeInvitation\x12\".organization.v1.InvitationRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
+	"\x13ListUserInvitations\x12+.organization.v1.ListUserInvitationsRequest\x1a(.organization.v1.ListInvitationsResponse\x12b\n" +
+	"\x10AcceptInvitation\x12).organization.v1.RespondInvitationRequest\x1a#.organization.v1.OrganizationMember\x12V\n" +
+	"\x11DeclineInvitation\x12).organization.v1.RespondInvitationRequest\x1a\x16.google.protobuf.Empty\x12g\n" +
+	"\x10ClaimInvitations\x12(.organization.v1.ClaimInvitationsRequest\x1a).organization.v1.ClaimInvitationsResponse\x12G\n" +
+	"\n" +
+	"CreateTeam\x12\".organization.v1.CreateTeamRequest\x1a\x15.organization.v1.Team\x12>\n" +
+	"\aGetTeam\x12\x1c.organization.v1.TeamRequest\x1a\x15.organization.v1.Team\x12R\n" +
+	"\tListTeams\x12!.organization.v1.ListTeamsRequest\x1a\".organization.v1.ListTeamsResponse\x12G\n" +
+	"\n" +
+	"UpdateTeam\x12\".organization.v1.UpdateTeamRequest\x1a\x15.organization.v1.Team\x12B\n" +
+	"\n" +
+	"DeleteTeam\x12\x1c.organization.v1.TeamRequest\x1a\x16.google.protobuf.Empty\x12J\n" +
+	"\rAddTeamMember\x12\".organization.v1.TeamMemberRequest\x1a\x15.organization.v1.Team\x12M\n" +
+	"\x10RemoveTeamMember\x12\".organization.v1.TeamMemberRequest\x1a\x15.organization.v1.Team\x12q\n" +
+	"\x17GetOrganizationSettings\x12/.organization.v1.GetOrganizationSettingsRequest\x1a%.organization.v1.OrganizationSettings\x12w\n" +
+	"\x1aUpdateOrganizationSettings\x122.organization.v1.UpdateOrganizationSettingsRequest\x1a%.organization.v1.OrganizationSettings\x12S\n" +
+	"\tAddDomain\x12!.organization.v1.AddDomainRequest\x1a#.organization.v1.OrganizationDomain\x12X\n" +
+	"\vListDomains\x12#.organization.v1.ListDomainsRequest\x1a$.organization.v1.ListDomainsResponse\x12Y\n" +
+	"\fUpdateDomain\x12$.organization.v1.UpdateDomainRequest\x1a#.organization.v1.OrganizationDomain\x12S\n" +
+	"\fVerifyDomain\x12\x1e.organization.v1.DomainRequest\x1a#.organization.v1.OrganizationDomain\x12F\n" +
+	"\fRemoveDomain\x12\x1e.organization.v1.DomainRequest\x1a\x16.google.protobuf.Empty\x12s\n" +
+	"\x16ClaimDomainMemberships\x12(.organization.v1.ClaimInvitationsRequest\x1a/.organization.v1.ClaimDomainMembershipsResponse\x12h\n" +
+	"\x14GetOrganizationUsage\x12,.organization.v1.GetOrganizationUsageRequest\x1a\".organization.v1.OrganizationUsage\x12f\n" +
+	"\x13SetOrganizationPlan\x12+.organization.v1.SetOrganizationPlanRequest\x1a\".organization.v1.OrganizationUsageBJZHgithub.com/aliirah/task-flow/shared/proto/organization/v1;organizationpbb\x06proto3"
 
 var (
 	file_organization_v1_organization_proto_rawDescOnce sync.Once
@@ -980,60 +3860,205 @@ func file_organization_v1_organization_proto_rawDescGZIP() []byte {
 	return file_organization_v1_organization_proto_rawDescData
 }
 
-var file_organization_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_organization_v1_organization_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_organization_v1_organization_proto_goTypes = []any{
-	(*Organization)(nil),                   // 0: organization.v1.Organization
-	(*OrganizationMember)(nil),             // 1: organization.v1.OrganizationMember
-	(*CreateOrganizationRequest)(nil),      // 2: organization.v1.CreateOrganizationRequest
-	(*GetOrganizationRequest)(nil),         // 3: organization.v1.GetOrganizationRequest
-	(*ListOrganizationsRequest)(nil),       // 4: organization.v1.ListOrganizationsRequest
-	(*ListOrganizationsResponse)(nil),      // 5: organization.v1.ListOrganizationsResponse
-	(*ListOrganizationsByIDsRequest)(nil),  // 6: organization.v1.ListOrganizationsByIDsRequest
-	(*ListOrganizationsByIDsResponse)(nil), // 7: organization.v1.ListOrganizationsByIDsResponse
-	(*UpdateOrganizationRequest)(nil),      // 8: organization.v1.UpdateOrganizationRequest
-	(*DeleteOrganizationRequest)(nil),      // 9: organization.v1.DeleteOrganizationRequest
-	(*AddMemberRequest)(nil),               // 10: organization.v1.AddMemberRequest
-	(*RemoveMemberRequest)(nil),            // 11: organization.v1.RemoveMemberRequest
-	(*ListMembersRequest)(nil),             // 12: organization.v1.ListMembersRequest
-	(*ListMembersResponse)(nil),            // 13: organization.v1.ListMembersResponse
-	(*ListUserMembershipsRequest)(nil),     // 14: organization.v1.ListUserMembershipsRequest
-	(*ListUserMembershipsResponse)(nil),    // 15: organization.v1.ListUserMembershipsResponse
-	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 17: google.protobuf.Empty
+	(*Organization)(nil),                      // 0: organization.v1.Organization
+	(*OrganizationMember)(nil),                // 1: organization.v1.OrganizationMember
+	(*CreateOrganizationRequest)(nil),         // 2: organization.v1.CreateOrganizationRequest
+	(*GetOrganizationRequest)(nil),            // 3: organization.v1.GetOrganizationRequest
+	(*ListOrganizationsRequest)(nil),          // 4: organization.v1.ListOrganizationsRequest
+	(*ListOrganizationsResponse)(nil),         // 5: organization.v1.ListOrganizationsResponse
+	(*ListOrganizationsByIDsRequest)(nil),     // 6: organization.v1.ListOrganizationsByIDsRequest
+	(*ListOrganizationsByIDsResponse)(nil),    // 7: organization.v1.ListOrganizationsByIDsResponse
+	(*UpdateOrganizationRequest)(nil),         // 8: organization.v1.UpdateOrganizationRequest
+	(*DeleteOrganizationRequest)(nil),         // 9: organization.v1.DeleteOrganizationRequest
+	(*CancelOrganizationDeletionRequest)(nil), // 10: organization.v1.CancelOrganizationDeletionRequest
+	(*TransferOwnershipRequest)(nil),          // 11: organization.v1.TransferOwnershipRequest
+	(*AddMemberRequest)(nil),                  // 12: organization.v1.AddMemberRequest
+	(*RemoveMemberRequest)(nil),               // 13: organization.v1.RemoveMemberRequest
+	(*ListMembersRequest)(nil),                // 14: organization.v1.ListMembersRequest
+	(*ListMembersResponse)(nil),               // 15: organization.v1.ListMembersResponse
+	(*ListUserMembershipsRequest)(nil),        // 16: organization.v1.ListUserMembershipsRequest
+	(*ListUserMembershipsResponse)(nil),       // 17: organization.v1.ListUserMembershipsResponse
+	(*UpdateMemberRoleRequest)(nil),           // 18: organization.v1.UpdateMemberRoleRequest
+	(*MemberStatusRequest)(nil),               // 19: organization.v1.MemberStatusRequest
+	(*LeaveOrganizationRequest)(nil),          // 20: organization.v1.LeaveOrganizationRequest
+	(*CheckPermissionRequest)(nil),            // 21: organization.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),           // 22: organization.v1.CheckPermissionResponse
+	(*Invitation)(nil),                        // 23: organization.v1.Invitation
+	(*CreateInvitationRequest)(nil),           // 24: organization.v1.CreateInvitationRequest
+	(*ListInvitationsRequest)(nil),            // 25: organization.v1.ListInvitationsRequest
+	(*ListInvitationsResponse)(nil),           // 26: organization.v1.ListInvitationsResponse
+	(*InvitationRequest)(nil),                 // 27: organization.v1.InvitationRequest
+	(*ListUserInvitationsRequest)(nil),        // 28: organization.v1.ListUserInvitationsRequest
+	(*RespondInvitationRequest)(nil),          // 29: organization.v1.RespondInvitationRequest
+	(*ClaimInvitationsRequest)(nil),           // 30: organization.v1.ClaimInvitationsRequest
+	(*ClaimInvitationsResponse)(nil),          // 31: organization.v1.ClaimInvitationsResponse
+	(*Team)(nil),                              // 32: organization.v1.Team
+	(*CreateTeamRequest)(nil),                 // 33: organization.v1.CreateTeamRequest
+	(*TeamRequest)(nil),                       // 34: organization.v1.TeamRequest
+	(*ListTeamsRequest)(nil),                  // 35: organization.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),                 // 36: organization.v1.ListTeamsResponse
+	(*UpdateTeamRequest)(nil),                 // 37: organization.v1.UpdateTeamRequest
+	(*TeamMemberRequest)(nil),                 // 38: organization.v1.TeamMemberRequest
+	(*OrganizationSettings)(nil),              // 39: organization.v1.OrganizationSettings
+	(*OrganizationTaskSettings)(nil),          // 40: organization.v1.OrganizationTaskSettings
+	(*OrganizationNotificationSettings)(nil),  // 41: organization.v1.OrganizationNotificationSettings
+	(*OrganizationSecuritySettings)(nil),      // 42: organization.v1.OrganizationSecuritySettings
+	(*OrganizationMembershipSettings)(nil),    // 43: organization.v1.OrganizationMembershipSettings
+	(*OrganizationRetentionSettings)(nil),     // 44: organization.v1.OrganizationRetentionSettings
+	(*GetOrganizationUsageRequest)(nil),       // 45: organization.v1.GetOrganizationUsageRequest
+	(*SetOrganizationPlanRequest)(nil),        // 46: organization.v1.SetOrganizationPlanRequest
+	(*OrganizationLimits)(nil),                // 47: organization.v1.OrganizationLimits
+	(*OrganizationUsage)(nil),                 // 48: organization.v1.OrganizationUsage
+	(*GetOrganizationSettingsRequest)(nil),    // 49: organization.v1.GetOrganizationSettingsRequest
+	(*UpdateOrganizationSettingsRequest)(nil), // 50: organization.v1.UpdateOrganizationSettingsRequest
+	(*OrganizationDomain)(nil),                // 51: organization.v1.OrganizationDomain
+	(*AddDomainRequest)(nil),                  // 52: organization.v1.AddDomainRequest
+	(*ListDomainsRequest)(nil),                // 53: organization.v1.ListDomainsRequest
+	(*ListDomainsResponse)(nil),               // 54: organization.v1.ListDomainsResponse
+	(*UpdateDomainRequest)(nil),               // 55: organization.v1.UpdateDomainRequest
+	(*DomainRequest)(nil),                     // 56: organization.v1.DomainRequest
+	(*ClaimDomainMembershipsResponse)(nil),    // 57: organization.v1.ClaimDomainMembershipsResponse
+	(*timestamppb.Timestamp)(nil),             // 58: google.protobuf.Timestamp
+	(*wrapperspb.BoolValue)(nil),              // 59: google.protobuf.BoolValue
+	(*wrapperspb.Int32Value)(nil),             // 60: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),            // 61: google.protobuf.StringValue
+	(*emptypb.Empty)(nil),                     // 62: google.protobuf.Empty
 }
 var file_organization_v1_organization_proto_depIdxs = []int32{
-	16, // 0: organization.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: organization.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 2: organization.v1.OrganizationMember.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: organization.v1.ListOrganizationsResponse.items:type_name -> organization.v1.Organization
-	0,  // 4: organization.v1.ListOrganizationsByIDsResponse.items:type_name -> organization.v1.Organization
-	1,  // 5: organization.v1.ListMembersResponse.items:type_name -> organization.v1.OrganizationMember
-	1,  // 6: organization.v1.ListUserMembershipsResponse.memberships:type_name -> organization.v1.OrganizationMember
-	2,  // 7: organization.v1.OrganizationService.CreateOrganization:input_type -> organization.v1.CreateOrganizationRequest
-	3,  // 8: organization.v1.OrganizationService.GetOrganization:input_type -> organization.v1.GetOrganizationRequest
-	4,  // 9: organization.v1.OrganizationService.ListOrganizations:input_type -> organization.v1.ListOrganizationsRequest
-	6,  // 10: organization.v1.OrganizationService.ListOrganizationsByIDs:input_type -> organization.v1.ListOrganizationsByIDsRequest
-	8,  // 11: organization.v1.OrganizationService.UpdateOrganization:input_type -> organization.v1.UpdateOrganizationRequest
-	9,  // 12: organization.v1.OrganizationService.DeleteOrganization:input_type -> organization.v1.DeleteOrganizationRequest
-	10, // 13: organization.v1.OrganizationService.AddMember:input_type -> organization.v1.AddMemberRequest
-	11, // 14: organization.v1.OrganizationService.RemoveMember:input_type -> organization.v1.RemoveMemberRequest
-	12, // 15: organization.v1.OrganizationService.ListMembers:input_type -> organization.v1.ListMembersRequest
-	14, // 16: organization.v1.OrganizationService.ListUserMemberships:input_type -> organization.v1.ListUserMembershipsRequest
-	0,  // 17: organization.v1.OrganizationService.CreateOrganization:output_type -> organization.v1.Organization
-	0,  // 18: organization.v1.OrganizationService.GetOrganization:output_type -> organization.v1.Organization
-	5,  // 19: organization.v1.OrganizationService.ListOrganizations:output_type -> organization.v1.ListOrganizationsResponse
-	7,  // 20: organization.v1.OrganizationService.ListOrganizationsByIDs:output_type -> organization.v1.ListOrganizationsByIDsResponse
-	0,  // 21: organization.v1.OrganizationService.UpdateOrganization:output_type -> organization.v1.Organization
-	17, // 22: organization.v1.OrganizationService.DeleteOrganization:output_type -> google.protobuf.Empty
-	1,  // 23: organization.v1.OrganizationService.AddMember:output_type -> organization.v1.OrganizationMember
-	17, // 24: organization.v1.OrganizationService.RemoveMember:output_type -> google.protobuf.Empty
-	13, // 25: organization.v1.OrganizationService.ListMembers:output_type -> organization.v1.ListMembersResponse
-	15, // 26: organization.v1.OrganizationService.ListUserMemberships:output_type -> organization.v1.ListUserMembershipsResponse
-	17, // [17:27] is the sub-list for method output_type
-	7,  // [7:17] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	58, // 0: organization.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	58, // 1: organization.v1.Organization.updated_at:type_name -> google.protobuf.Timestamp
+	58, // 2: organization.v1.Organization.deletion_requested_at:type_name -> google.protobuf.Timestamp
+	58, // 3: organization.v1.Organization.delete_after:type_name -> google.protobuf.Timestamp
+	58, // 4: organization.v1.OrganizationMember.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: organization.v1.ListOrganizationsResponse.items:type_name -> organization.v1.Organization
+	0,  // 6: organization.v1.ListOrganizationsByIDsResponse.items:type_name -> organization.v1.Organization
+	59, // 7: organization.v1.UpdateOrganizationRequest.require_verified_email:type_name -> google.protobuf.BoolValue
+	59, // 8: organization.v1.UpdateOrganizationRequest.require_two_factor:type_name -> google.protobuf.BoolValue
+	60, // 9: organization.v1.UpdateOrganizationRequest.password_min_length:type_name -> google.protobuf.Int32Value
+	60, // 10: organization.v1.UpdateOrganizationRequest.password_min_classes:type_name -> google.protobuf.Int32Value
+	60, // 11: organization.v1.UpdateOrganizationRequest.password_history:type_name -> google.protobuf.Int32Value
+	1,  // 12: organization.v1.ListMembersResponse.items:type_name -> organization.v1.OrganizationMember
+	1,  // 13: organization.v1.ListUserMembershipsResponse.memberships:type_name -> organization.v1.OrganizationMember
+	58, // 14: organization.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	58, // 15: organization.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	58, // 16: organization.v1.Invitation.responded_at:type_name -> google.protobuf.Timestamp
+	23, // 17: organization.v1.ListInvitationsResponse.items:type_name -> organization.v1.Invitation
+	1,  // 18: organization.v1.ClaimInvitationsResponse.memberships:type_name -> organization.v1.OrganizationMember
+	58, // 19: organization.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	58, // 20: organization.v1.Team.updated_at:type_name -> google.protobuf.Timestamp
+	32, // 21: organization.v1.ListTeamsResponse.items:type_name -> organization.v1.Team
+	61, // 22: organization.v1.UpdateTeamRequest.name:type_name -> google.protobuf.StringValue
+	61, // 23: organization.v1.UpdateTeamRequest.description:type_name -> google.protobuf.StringValue
+	61, // 24: organization.v1.UpdateTeamRequest.lead_id:type_name -> google.protobuf.StringValue
+	40, // 25: organization.v1.OrganizationSettings.tasks:type_name -> organization.v1.OrganizationTaskSettings
+	41, // 26: organization.v1.OrganizationSettings.notifications:type_name -> organization.v1.OrganizationNotificationSettings
+	42, // 27: organization.v1.OrganizationSettings.security:type_name -> organization.v1.OrganizationSecuritySettings
+	58, // 28: organization.v1.OrganizationSettings.updated_at:type_name -> google.protobuf.Timestamp
+	43, // 29: organization.v1.OrganizationSettings.membership:type_name -> organization.v1.OrganizationMembershipSettings
+	44, // 30: organization.v1.OrganizationSettings.retention:type_name -> organization.v1.OrganizationRetentionSettings
+	47, // 31: organization.v1.OrganizationUsage.limits:type_name -> organization.v1.OrganizationLimits
+	58, // 32: organization.v1.OrganizationUsage.updated_at:type_name -> google.protobuf.Timestamp
+	39, // 33: organization.v1.UpdateOrganizationSettingsRequest.settings:type_name -> organization.v1.OrganizationSettings
+	58, // 34: organization.v1.OrganizationDomain.verified_at:type_name -> google.protobuf.Timestamp
+	58, // 35: organization.v1.OrganizationDomain.created_at:type_name -> google.protobuf.Timestamp
+	51, // 36: organization.v1.ListDomainsResponse.items:type_name -> organization.v1.OrganizationDomain
+	61, // 37: organization.v1.UpdateDomainRequest.default_role:type_name -> google.protobuf.StringValue
+	59, // 38: organization.v1.UpdateDomainRequest.auto_join:type_name -> google.protobuf.BoolValue
+	1,  // 39: organization.v1.ClaimDomainMembershipsResponse.memberships:type_name -> organization.v1.OrganizationMember
+	23, // 40: organization.v1.ClaimDomainMembershipsResponse.offers:type_name -> organization.v1.Invitation
+	2,  // 41: organization.v1.OrganizationService.CreateOrganization:input_type -> organization.v1.CreateOrganizationRequest
+	3,  // 42: organization.v1.OrganizationService.GetOrganization:input_type -> organization.v1.GetOrganizationRequest
+	4,  // 43: organization.v1.OrganizationService.ListOrganizations:input_type -> organization.v1.ListOrganizationsRequest
+	6,  // 44: organization.v1.OrganizationService.ListOrganizationsByIDs:input_type -> organization.v1.ListOrganizationsByIDsRequest
+	8,  // 45: organization.v1.OrganizationService.UpdateOrganization:input_type -> organization.v1.UpdateOrganizationRequest
+	9,  // 46: organization.v1.OrganizationService.DeleteOrganization:input_type -> organization.v1.DeleteOrganizationRequest
+	10, // 47: organization.v1.OrganizationService.CancelOrganizationDeletion:input_type -> organization.v1.CancelOrganizationDeletionRequest
+	11, // 48: organization.v1.OrganizationService.TransferOwnership:input_type -> organization.v1.TransferOwnershipRequest
+	12, // 49: organization.v1.OrganizationService.AddMember:input_type -> organization.v1.AddMemberRequest
+	13, // 50: organization.v1.OrganizationService.RemoveMember:input_type -> organization.v1.RemoveMemberRequest
+	14, // 51: organization.v1.OrganizationService.ListMembers:input_type -> organization.v1.ListMembersRequest
+	16, // 52: organization.v1.OrganizationService.ListUserMemberships:input_type -> organization.v1.ListUserMembershipsRequest
+	18, // 53: organization.v1.OrganizationService.UpdateMemberRole:input_type -> organization.v1.UpdateMemberRoleRequest
+	19, // 54: organization.v1.OrganizationService.SuspendMember:input_type -> organization.v1.MemberStatusRequest
+	19, // 55: organization.v1.OrganizationService.ReactivateMember:input_type -> organization.v1.MemberStatusRequest
+	19, // 56: organization.v1.OrganizationService.ApproveMember:input_type -> organization.v1.MemberStatusRequest
+	20, // 57: organization.v1.OrganizationService.LeaveOrganization:input_type -> organization.v1.LeaveOrganizationRequest
+	21, // 58: organization.v1.OrganizationService.CheckPermission:input_type -> organization.v1.CheckPermissionRequest
+	24, // 59: organization.v1.OrganizationService.CreateInvitation:input_type -> organization.v1.CreateInvitationRequest
+	25, // 60: organization.v1.OrganizationService.ListInvitations:input_type -> organization.v1.ListInvitationsRequest
+	27, // 61: organization.v1.OrganizationService.ResendInvitation:input_type -> organization.v1.InvitationRequest
+	27, // 62: organization.v1.OrganizationService.RevokeInvitation:input_type -> organization.v1.InvitationRequest
+	28, // 63: organization.v1.OrganizationService.ListUserInvitations:input_type -> organization.v1.ListUserInvitationsRequest
+	29, // 64: organization.v1.OrganizationService.AcceptInvitation:input_type -> organization.v1.RespondInvitationRequest
+	29, // 65: organization.v1.OrganizationService.DeclineInvitation:input_type -> organization.v1.RespondInvitationRequest
+	30, // 66: organization.v1.OrganizationService.ClaimInvitations:input_type -> organization.v1.ClaimInvitationsRequest
+	33, // 67: organization.v1.OrganizationService.CreateTeam:input_type -> organization.v1.CreateTeamRequest
+	34, // 68: organization.v1.OrganizationService.GetTeam:input_type -> organization.v1.TeamRequest
+	35, // 69: organization.v1.OrganizationService.ListTeams:input_type -> organization.v1.ListTeamsRequest
+	37, // 70: organization.v1.OrganizationService.UpdateTeam:input_type -> organization.v1.UpdateTeamRequest
+	34, // 71: organization.v1.OrganizationService.DeleteTeam:input_type -> organization.v1.TeamRequest
+	38, // 72: organization.v1.OrganizationService.AddTeamMember:input_type -> organization.v1.TeamMemberRequest
+	38, // 73: organization.v1.OrganizationService.RemoveTeamMember:input_type -> organization.v1.TeamMemberRequest
+	49, // 74: organization.v1.OrganizationService.GetOrganizationSettings:input_type -> organization.v1.GetOrganizationSettingsRequest
+	50, // 75: organization.v1.OrganizationService.UpdateOrganizationSettings:input_type -> organization.v1.UpdateOrganizationSettingsRequest
+	52, // 76: organization.v1.OrganizationService.AddDomain:input_type -> organization.v1.AddDomainRequest
+	53, // 77: organization.v1.OrganizationService.ListDomains:input_type -> organization.v1.ListDomainsRequest
+	55, // 78: organization.v1.OrganizationService.UpdateDomain:input_type -> organization.v1.UpdateDomainRequest
+	56, // 79: organization.v1.OrganizationService.VerifyDomain:input_type -> organization.v1.DomainRequest
+	56, // 80: organization.v1.OrganizationService.RemoveDomain:input_type -> organization.v1.DomainRequest
+	30, // 81: organization.v1.OrganizationService.ClaimDomainMemberships:input_type -> organization.v1.ClaimInvitationsRequest
+	45, // 82: organization.v1.OrganizationService.GetOrganizationUsage:input_type -> organization.v1.GetOrganizationUsageRequest
+	46, // 83: organization.v1.OrganizationService.SetOrganizationPlan:input_type -> organization.v1.SetOrganizationPlanRequest
+	0,  // 84: organization.v1.OrganizationService.CreateOrganization:output_type -> organization.v1.Organization
+	0,  // 85: organization.v1.OrganizationService.GetOrganization:output_type -> organization.v1.Organization
+	5,  // 86: organization.v1.OrganizationService.ListOrganizations:output_type -> organization.v1.ListOrganizationsResponse
+	7,  // 87: organization.v1.OrganizationService.ListOrganizationsByIDs:output_type -> organization.v1.ListOrganizationsByIDsResponse
+	0,  // 88: organization.v1.OrganizationService.UpdateOrganization:output_type -> organization.v1.Organization
+	0,  // 89: organization.v1.OrganizationService.DeleteOrganization:output_type -> organization.v1.Organization
+	0,  // 90: organization.v1.OrganizationService.CancelOrganizationDeletion:output_type -> organization.v1.Organization
+	0,  // 91: organization.v1.OrganizationService.TransferOwnership:output_type -> organization.v1.Organization
+	1,  // 92: organization.v1.OrganizationService.AddMember:output_type -> organization.v1.OrganizationMember
+	62, // 93: organization.v1.OrganizationService.RemoveMember:output_type -> google.protobuf.Empty
+	15, // 94: organization.v1.OrganizationService.ListMembers:output_type -> organization.v1.ListMembersResponse
+	17, // 95: organization.v1.OrganizationService.ListUserMemberships:output_type -> organization.v1.ListUserMembershipsResponse
+	1,  // 96: organization.v1.OrganizationService.UpdateMemberRole:output_type -> organization.v1.OrganizationMember
+	1,  // 97: organization.v1.OrganizationService.SuspendMember:output_type -> organization.v1.OrganizationMember
+	1,  // 98: organization.v1.OrganizationService.ReactivateMember:output_type -> organization.v1.OrganizationMember
+	1,  // 99: organization.v1.OrganizationService.ApproveMember:output_type -> organization.v1.OrganizationMember
+	62, // 100: organization.v1.OrganizationService.LeaveOrganization:output_type -> google.protobuf.Empty
+	22, // 101: organization.v1.OrganizationService.CheckPermission:output_type -> organization.v1.CheckPermissionResponse
+	23, // 102: organization.v1.OrganizationService.CreateInvitation:output_type -> organization.v1.Invitation
+	26, // 103: organization.v1.OrganizationService.ListInvitations:output_type -> organization.v1.ListInvitationsResponse
+	23, // 104: organization.v1.OrganizationService.ResendInvitation:output_type -> organization.v1.Invitation
+	62, // 105: organization.v1.OrganizationService.RevokeInvitation:output_type -> google.protobuf.Empty
+	26, // 106: organization.v1.OrganizationService.ListUserInvitations:output_type -> organization.v1.ListInvitationsResponse
+	1,  // 107: organization.v1.OrganizationService.AcceptInvitation:output_type -> organization.v1.OrganizationMember
+	62, // 108: organization.v1.OrganizationService.DeclineInvitation:output_type -> google.protobuf.Empty
+	31, // 109: organization.v1.OrganizationService.ClaimInvitations:output_type -> organization.v1.ClaimInvitationsResponse
+	32, // 110: organization.v1.OrganizationService.CreateTeam:output_type -> organization.v1.Team
+	32, // 111: organization.v1.OrganizationService.GetTeam:output_type -> organization.v1.Team
+	36, // 112: organization.v1.OrganizationService.ListTeams:output_type -> organization.v1.ListTeamsResponse
+	32, // 113: organization.v1.OrganizationService.UpdateTeam:output_type -> organization.v1.Team
+	62, // 114: organization.v1.OrganizationService.DeleteTeam:output_type -> google.protobuf.Empty
+	32, // 115: organization.v1.OrganizationService.AddTeamMember:output_type -> organization.v1.Team
+	32, // 116: organization.v1.OrganizationService.RemoveTeamMember:output_type -> organization.v1.Team
+	39, // 117: organization.v1.OrganizationService.GetOrganizationSettings:output_type -> organization.v1.OrganizationSettings
+	39, // 118: organization.v1.OrganizationService.UpdateOrganizationSettings:output_type -> organization.v1.OrganizationSettings
+	51, // 119: organization.v1.OrganizationService.AddDomain:output_type -> organization.v1.OrganizationDomain
+	54, // 120: organization.v1.OrganizationService.ListDomains:output_type -> organization.v1.ListDomainsResponse
+	51, // 121: organization.v1.OrganizationService.UpdateDomain:output_type -> organization.v1.OrganizationDomain
+	51, // 122: organization.v1.OrganizationService.VerifyDomain:output_type -> organization.v1.OrganizationDomain
+	62, // 123: organization.v1.OrganizationService.RemoveDomain:output_type -> google.protobuf.Empty
+	57, // 124: organization.v1.OrganizationService.ClaimDomainMemberships:output_type -> organization.v1.ClaimDomainMembershipsResponse
+	48, // 125: organization.v1.OrganizationService.GetOrganizationUsage:output_type -> organization.v1.OrganizationUsage
+	48, // 126: organization.v1.OrganizationService.SetOrganizationPlan:output_type -> organization.v1.OrganizationUsage
+	84, // [84:127] is the sub-list for method output_type
+	41, // [41:84] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_organization_v1_organization_proto_init() }
@@ -1047,7 +4072,7 @@ func file_organization_v1_organization_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_organization_v1_organization_proto_rawDesc), len(file_organization_v1_organization_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
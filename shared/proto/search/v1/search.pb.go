@@ -28,8 +28,10 @@ type SearchRequest struct {
 	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	OrganizationId string                 `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	UserId         string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Only return tasks and comments shared with user_id, for guests
+	SharedOnly    bool `protobuf:"varint,6,opt,name=shared_only,json=sharedOnly,proto3" json:"shared_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SearchRequest) Reset() {
@@ -97,6 +99,13 @@ func (x *SearchRequest) GetUserId() string {
 	return ""
 }
 
+func (x *SearchRequest) GetSharedOnly() bool {
+	if x != nil {
+		return x.SharedOnly
+	}
+	return false
+}
+
 type SearchResult struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -389,13 +398,15 @@ var File_search_v1_search_proto protoreflect.FileDescriptor
 
 const file_search_v1_search_proto_rawDesc = "" +
 	"\n" +
-	"\x16search/v1/search.proto\x12\tsearch.v1\"\x93\x01\n" +
+	"\x16search/v1/search.proto\x12\tsearch.v1\"\xb4\x01\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05types\x18\x02 \x03(\tR\x05types\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12'\n" +
 	"\x0forganization_id\x18\x04 \x01(\tR\x0eorganizationId\x12\x17\n" +
-	"\auser_id\x18\x05 \x01(\tR\x06userId\"\x83\x03\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vshared_only\x18\x06 \x01(\bR\n" +
+	"sharedOnly\"\x83\x03\n" +
 	"\fSearchResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
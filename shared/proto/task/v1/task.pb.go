@@ -40,6 +40,12 @@ type Task struct {
 	Type           string                 `protobuf:"bytes,12,opt,name=type,proto3" json:"type,omitempty"` // task, story, sub-task
 	ParentTaskId   string                 `protobuf:"bytes,13,opt,name=parent_task_id,json=parentTaskId,proto3" json:"parent_task_id,omitempty"`
 	DisplayOrder   int32                  `protobuf:"varint,14,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	ChecklistTotal int32                  `protobuf:"varint,15,opt,name=checklist_total,json=checklistTotal,proto3" json:"checklist_total,omitempty"`
+	ChecklistDone  int32                  `protobuf:"varint,16,opt,name=checklist_done,json=checklistDone,proto3" json:"checklist_done,omitempty"`
+	ArchivedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+	PurgeAt        *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	AssigneeTeamId string                 `protobuf:"bytes,19,opt,name=assignee_team_id,json=assigneeTeamId,proto3" json:"assignee_team_id,omitempty"` // team the task is assigned to, next to or instead of a person
+	SharedWith     []string               `protobuf:"bytes,20,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`               // guests the task is shared with
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -172,6 +178,48 @@ func (x *Task) GetDisplayOrder() int32 {
 	return 0
 }
 
+func (x *Task) GetChecklistTotal() int32 {
+	if x != nil {
+		return x.ChecklistTotal
+	}
+	return 0
+}
+
+func (x *Task) GetChecklistDone() int32 {
+	if x != nil {
+		return x.ChecklistDone
+	}
+	return 0
+}
+
+func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Task) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+func (x *Task) GetAssigneeTeamId() string {
+	if x != nil {
+		return x.AssigneeTeamId
+	}
+	return ""
+}
+
+func (x *Task) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
 type CreateTaskRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Title          string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -185,6 +233,7 @@ type CreateTaskRequest struct {
 	Type           string                 `protobuf:"bytes,9,opt,name=type,proto3" json:"type,omitempty"` // task, story, sub-task
 	ParentTaskId   string                 `protobuf:"bytes,10,opt,name=parent_task_id,json=parentTaskId,proto3" json:"parent_task_id,omitempty"`
 	DisplayOrder   int32                  `protobuf:"varint,11,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	AssigneeTeamId string                 `protobuf:"bytes,12,opt,name=assignee_team_id,json=assigneeTeamId,proto3" json:"assignee_team_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -296,6 +345,13 @@ func (x *CreateTaskRequest) GetDisplayOrder() int32 {
 	return 0
 }
 
+func (x *CreateTaskRequest) GetAssigneeTeamId() string {
+	if x != nil {
+		return x.AssigneeTeamId
+	}
+	return ""
+}
+
 type GetTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -351,8 +407,12 @@ type ListTasksRequest struct {
 	SortBy         string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	SortOrder      string                 `protobuf:"bytes,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	Search         string                 `protobuf:"bytes,9,opt,name=search,proto3" json:"search,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Archived       bool                   `protobuf:"varint,10,opt,name=archived,proto3" json:"archived,omitempty"` // list archived tasks instead of active ones
+	AssigneeTeamId string                 `protobuf:"bytes,11,opt,name=assignee_team_id,json=assigneeTeamId,proto3" json:"assignee_team_id,omitempty"`
+	// With assignee_id, also list tasks assigned to the assignee's teams
+	IncludeAssigneeTeams bool `protobuf:"varint,12,opt,name=include_assignee_teams,json=includeAssigneeTeams,proto3" json:"include_assignee_teams,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest) Reset() {
@@ -448,6 +508,27 @@ func (x *ListTasksRequest) GetSearch() string {
 	return ""
 }
 
+func (x *ListTasksRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetAssigneeTeamId() string {
+	if x != nil {
+		return x.AssigneeTeamId
+	}
+	return ""
+}
+
+func (x *ListTasksRequest) GetIncludeAssigneeTeams() bool {
+	if x != nil {
+		return x.IncludeAssigneeTeams
+	}
+	return false
+}
+
 type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*Task                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -506,6 +587,7 @@ type UpdateTaskRequest struct {
 	Type           *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=type,proto3" json:"type,omitempty"`
 	ParentTaskId   *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=parent_task_id,json=parentTaskId,proto3" json:"parent_task_id,omitempty"`
 	DisplayOrder   *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	AssigneeTeamId *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=assignee_team_id,json=assigneeTeamId,proto3" json:"assignee_team_id,omitempty"` // empty unassigns the team
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -624,6 +706,13 @@ func (x *UpdateTaskRequest) GetDisplayOrder() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *UpdateTaskRequest) GetAssigneeTeamId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AssigneeTeamId
+	}
+	return nil
+}
+
 type DeleteTaskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -668,6 +757,194 @@ func (x *DeleteTaskRequest) GetId() string {
 	return ""
 }
 
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CloneTaskRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetOrganizationId string                 `protobuf:"bytes,2,opt,name=target_organization_id,json=targetOrganizationId,proto3" json:"target_organization_id,omitempty"` // defaults to the source task organization
+	Title                string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`                                                             // defaults to the source title with a copy suffix
+	IncludeSubtasks      bool                   `protobuf:"varint,4,opt,name=include_subtasks,json=includeSubtasks,proto3" json:"include_subtasks,omitempty"`
+	IncludeChecklist     bool                   `protobuf:"varint,5,opt,name=include_checklist,json=includeChecklist,proto3" json:"include_checklist,omitempty"`
+	IncludeComments      bool                   `protobuf:"varint,6,opt,name=include_comments,json=includeComments,proto3" json:"include_comments,omitempty"` // only honoured within the same organization
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CloneTaskRequest) Reset() {
+	*x = CloneTaskRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTaskRequest) ProtoMessage() {}
+
+func (x *CloneTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTaskRequest.ProtoReflect.Descriptor instead.
+func (*CloneTaskRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CloneTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetTargetOrganizationId() string {
+	if x != nil {
+		return x.TargetOrganizationId
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CloneTaskRequest) GetIncludeSubtasks() bool {
+	if x != nil {
+		return x.IncludeSubtasks
+	}
+	return false
+}
+
+func (x *CloneTaskRequest) GetIncludeChecklist() bool {
+	if x != nil {
+		return x.IncludeChecklist
+	}
+	return false
+}
+
+func (x *CloneTaskRequest) GetIncludeComments() bool {
+	if x != nil {
+		return x.IncludeComments
+	}
+	return false
+}
+
+type CloneTaskResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Task              *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Subtasks          []*Task                `protobuf:"bytes,2,rep,name=subtasks,proto3" json:"subtasks,omitempty"`
+	UnassignedTaskIds []string               `protobuf:"bytes,3,rep,name=unassigned_task_ids,json=unassignedTaskIds,proto3" json:"unassigned_task_ids,omitempty"` // clones whose assignee can't be assigned in the target organization
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CloneTaskResponse) Reset() {
+	*x = CloneTaskResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneTaskResponse) ProtoMessage() {}
+
+func (x *CloneTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneTaskResponse.ProtoReflect.Descriptor instead.
+func (*CloneTaskResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloneTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *CloneTaskResponse) GetSubtasks() []*Task {
+	if x != nil {
+		return x.Subtasks
+	}
+	return nil
+}
+
+func (x *CloneTaskResponse) GetUnassignedTaskIds() []string {
+	if x != nil {
+		return x.UnassignedTaskIds
+	}
+	return nil
+}
+
 type TaskOrder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -678,7 +955,7 @@ type TaskOrder struct {
 
 func (x *TaskOrder) Reset() {
 	*x = TaskOrder{}
-	mi := &file_task_v1_task_proto_msgTypes[7]
+	mi := &file_task_v1_task_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +967,7 @@ func (x *TaskOrder) String() string {
 func (*TaskOrder) ProtoMessage() {}
 
 func (x *TaskOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[7]
+	mi := &file_task_v1_task_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +980,7 @@ func (x *TaskOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskOrder.ProtoReflect.Descriptor instead.
 func (*TaskOrder) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{7}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TaskOrder) GetId() string {
@@ -730,7 +1007,7 @@ type ReorderTasksRequest struct {
 
 func (x *ReorderTasksRequest) Reset() {
 	*x = ReorderTasksRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[8]
+	mi := &file_task_v1_task_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +1019,7 @@ func (x *ReorderTasksRequest) String() string {
 func (*ReorderTasksRequest) ProtoMessage() {}
 
 func (x *ReorderTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[8]
+	mi := &file_task_v1_task_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +1032,7 @@ func (x *ReorderTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReorderTasksRequest.ProtoReflect.Descriptor instead.
 func (*ReorderTasksRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{8}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReorderTasksRequest) GetOrganizationId() string {
@@ -773,6 +1050,58 @@ func (x *ReorderTasksRequest) GetTasks() []*TaskOrder {
 }
 
 // Comment messages
+type TaskShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // guest of the task's organization
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskShareRequest) Reset() {
+	*x = TaskShareRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskShareRequest) ProtoMessage() {}
+
+func (x *TaskShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskShareRequest.ProtoReflect.Descriptor instead.
+func (*TaskShareRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TaskShareRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskShareRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
 type Comment struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -790,7 +1119,7 @@ type Comment struct {
 
 func (x *Comment) Reset() {
 	*x = Comment{}
-	mi := &file_task_v1_task_proto_msgTypes[9]
+	mi := &file_task_v1_task_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +1131,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[9]
+	mi := &file_task_v1_task_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +1144,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{9}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Comment) GetId() string {
@@ -893,7 +1222,7 @@ type CreateCommentRequest struct {
 
 func (x *CreateCommentRequest) Reset() {
 	*x = CreateCommentRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[10]
+	mi := &file_task_v1_task_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -905,7 +1234,7 @@ func (x *CreateCommentRequest) String() string {
 func (*CreateCommentRequest) ProtoMessage() {}
 
 func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[10]
+	mi := &file_task_v1_task_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,7 +1247,7 @@ func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
 func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{10}
+	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateCommentRequest) GetTaskId() string {
@@ -958,7 +1287,7 @@ type GetCommentRequest struct {
 
 func (x *GetCommentRequest) Reset() {
 	*x = GetCommentRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[11]
+	mi := &file_task_v1_task_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -970,7 +1299,876 @@ func (x *GetCommentRequest) String() string {
 func (*GetCommentRequest) ProtoMessage() {}
 
 func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[11]
+	mi := &file_task_v1_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListCommentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeReplies bool                   `protobuf:"varint,4,opt,name=include_replies,json=includeReplies,proto3" json:"include_replies,omitempty"` // If false, only fetch parent comments
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCommentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetIncludeReplies() bool {
+	if x != nil {
+		return x.IncludeReplies
+	}
+	return false
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Comment             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCommentsResponse) GetItems() []*Comment {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UpdateCommentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	MentionedUsers []string               `protobuf:"bytes,3,rep,name=mentioned_users,json=mentionedUsers,proto3" json:"mentioned_users,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCommentRequest) Reset() {
+	*x = UpdateCommentRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdateCommentRequest) GetMentionedUsers() []string {
+	if x != nil {
+		return x.MentionedUsers
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Checklist messages
+type ChecklistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	DoneBy        string                 `protobuf:"bytes,5,opt,name=done_by,json=doneBy,proto3" json:"done_by,omitempty"`
+	DoneAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=done_at,json=doneAt,proto3" json:"done_at,omitempty"`
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChecklistItem) Reset() {
+	*x = ChecklistItem{}
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChecklistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChecklistItem) ProtoMessage() {}
+
+func (x *ChecklistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChecklistItem.ProtoReflect.Descriptor instead.
+func (*ChecklistItem) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChecklistItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ChecklistItem) GetDoneBy() string {
+	if x != nil {
+		return x.DoneBy
+	}
+	return ""
+}
+
+func (x *ChecklistItem) GetDoneAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DoneAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ChecklistItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ChecklistItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsRequest) Reset() {
+	*x = ListChecklistItemsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ListChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type ListChecklistItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ChecklistItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChecklistItemsResponse) Reset() {
+	*x = ListChecklistItemsResponse{}
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChecklistItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChecklistItemsResponse) ProtoMessage() {}
+
+func (x *ListChecklistItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChecklistItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListChecklistItemsResponse) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListChecklistItemsResponse) GetItems() []*ChecklistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddChecklistItemRequest) Reset() {
+	*x = AddChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddChecklistItemRequest) ProtoMessage() {}
+
+func (x *AddChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*AddChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddChecklistItemRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *AddChecklistItemRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ToggleChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Done          *wrapperspb.BoolValue  `protobuf:"bytes,2,opt,name=done,proto3" json:"done,omitempty"` // If unset, the current state is flipped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ToggleChecklistItemRequest) Reset() {
+	*x = ToggleChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleChecklistItemRequest) ProtoMessage() {}
+
+func (x *ToggleChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*ToggleChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ToggleChecklistItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ToggleChecklistItemRequest) GetDone() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Done
+	}
+	return nil
+}
+
+type ReorderChecklistItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ItemIds       []string               `protobuf:"bytes,2,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"` // Item ids in their new order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderChecklistItemsRequest) Reset() {
+	*x = ReorderChecklistItemsRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderChecklistItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderChecklistItemsRequest) ProtoMessage() {}
+
+func (x *ReorderChecklistItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderChecklistItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderChecklistItemsRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderChecklistItemsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ReorderChecklistItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type DeleteChecklistItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteChecklistItemRequest) Reset() {
+	*x = DeleteChecklistItemRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteChecklistItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteChecklistItemRequest) ProtoMessage() {}
+
+func (x *DeleteChecklistItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteChecklistItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteChecklistItemRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteChecklistItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Import messages
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	CreatedBy      string                 `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Format         string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"` // csv, json
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // validated, running, completed, failed
+	DryRun         bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows      int32                  `protobuf:"varint,7,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ProcessedRows  int32                  `protobuf:"varint,8,opt,name=processed_rows,json=processedRows,proto3" json:"processed_rows,omitempty"`
+	CreatedCount   int32                  `protobuf:"varint,9,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	Errors         []*ImportRowError      `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJob) ProtoMessage() {}
+
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportJob) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *ImportJob) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ImportJob) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportJob) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportJob) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetProcessedRows() int32 {
+	if x != nil {
+		return x.ProcessedRows
+	}
+	return 0
+}
+
+func (x *ImportJob) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportJob) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type StartTaskImportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Format         string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // csv, json
+	Data           []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	DryRun         bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only validate rows and report errors
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartTaskImportRequest) Reset() {
+	*x = StartTaskImportRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTaskImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTaskImportRequest) ProtoMessage() {}
+
+func (x *StartTaskImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,43 +2179,114 @@ func (x *GetCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StartTaskImportRequest.ProtoReflect.Descriptor instead.
+func (*StartTaskImportRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetCommentRequest) GetId() string {
+func (x *StartTaskImportRequest) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *StartTaskImportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *StartTaskImportRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StartTaskImportRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type GetTaskImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskImportRequest) Reset() {
+	*x = GetTaskImportRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskImportRequest) ProtoMessage() {}
+
+func (x *GetTaskImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskImportRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskImportRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTaskImportRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type ListCommentsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TaskId         string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Page           int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	IncludeReplies bool                   `protobuf:"varint,4,opt,name=include_replies,json=includeReplies,proto3" json:"include_replies,omitempty"` // If false, only fetch parent comments
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Export messages
+type ExportTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId  string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	AssigneeId      string                 `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3" json:"assignee_id,omitempty"`
+	ReporterId      string                 `protobuf:"bytes,3,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SortBy          string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder       string                 `protobuf:"bytes,6,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Search          string                 `protobuf:"bytes,7,opt,name=search,proto3" json:"search,omitempty"`
+	OnlyWithDueDate bool                   `protobuf:"varint,8,opt,name=only_with_due_date,json=onlyWithDueDate,proto3" json:"only_with_due_date,omitempty"`
+	FeedToken       string                 `protobuf:"bytes,9,opt,name=feed_token,json=feedToken,proto3" json:"feed_token,omitempty"` // Calendar feed token used instead of the caller identity
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListCommentsRequest) Reset() {
-	*x = ListCommentsRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *ExportTasksRequest) Reset() {
+	*x = ExportTasksRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsRequest) String() string {
+func (x *ExportTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsRequest) ProtoMessage() {}
+func (*ExportTasksRequest) ProtoMessage() {}
 
-func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[12]
+func (x *ExportTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,62 +2297,100 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ExportTasksRequest.ProtoReflect.Descriptor instead.
+func (*ExportTasksRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListCommentsRequest) GetTaskId() string {
+func (x *ExportTasksRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.TaskId
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *ListCommentsRequest) GetPage() int32 {
+func (x *ExportTasksRequest) GetAssigneeId() string {
 	if x != nil {
-		return x.Page
+		return x.AssigneeId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCommentsRequest) GetLimit() int32 {
+func (x *ExportTasksRequest) GetReporterId() string {
 	if x != nil {
-		return x.Limit
+		return x.ReporterId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListCommentsRequest) GetIncludeReplies() bool {
+func (x *ExportTasksRequest) GetStatus() string {
 	if x != nil {
-		return x.IncludeReplies
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportTasksRequest) GetOnlyWithDueDate() bool {
+	if x != nil {
+		return x.OnlyWithDueDate
 	}
 	return false
 }
 
-type ListCommentsResponse struct {
+func (x *ExportTasksRequest) GetFeedToken() string {
+	if x != nil {
+		return x.FeedToken
+	}
+	return ""
+}
+
+type ExportTaskRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*Comment             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	HasMore       bool                   `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	AssigneeName  string                 `protobuf:"bytes,2,opt,name=assignee_name,json=assigneeName,proto3" json:"assignee_name,omitempty"`
+	AssigneeEmail string                 `protobuf:"bytes,3,opt,name=assignee_email,json=assigneeEmail,proto3" json:"assignee_email,omitempty"`
+	ReporterName  string                 `protobuf:"bytes,4,opt,name=reporter_name,json=reporterName,proto3" json:"reporter_name,omitempty"`
+	ReporterEmail string                 `protobuf:"bytes,5,opt,name=reporter_email,json=reporterEmail,proto3" json:"reporter_email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsResponse) Reset() {
-	*x = ListCommentsResponse{}
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *ExportTaskRow) Reset() {
+	*x = ExportTaskRow{}
+	mi := &file_task_v1_task_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsResponse) String() string {
+func (x *ExportTaskRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsResponse) ProtoMessage() {}
+func (*ExportTaskRow) ProtoMessage() {}
 
-func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[13]
+func (x *ExportTaskRow) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,49 +2401,68 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExportTaskRow.ProtoReflect.Descriptor instead.
+func (*ExportTaskRow) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ListCommentsResponse) GetItems() []*Comment {
+func (x *ExportTaskRow) GetTask() *Task {
 	if x != nil {
-		return x.Items
+		return x.Task
 	}
 	return nil
 }
 
-func (x *ListCommentsResponse) GetHasMore() bool {
+func (x *ExportTaskRow) GetAssigneeName() string {
 	if x != nil {
-		return x.HasMore
+		return x.AssigneeName
 	}
-	return false
+	return ""
 }
 
-type UpdateCommentRequest struct {
+func (x *ExportTaskRow) GetAssigneeEmail() string {
+	if x != nil {
+		return x.AssigneeEmail
+	}
+	return ""
+}
+
+func (x *ExportTaskRow) GetReporterName() string {
+	if x != nil {
+		return x.ReporterName
+	}
+	return ""
+}
+
+func (x *ExportTaskRow) GetReporterEmail() string {
+	if x != nil {
+		return x.ReporterEmail
+	}
+	return ""
+}
+
+type CreateCalendarFeedTokenRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content        string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	MentionedUsers []string               `protobuf:"bytes,3,rep,name=mentioned_users,json=mentionedUsers,proto3" json:"mentioned_users,omitempty"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateCommentRequest) Reset() {
-	*x = UpdateCommentRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *CreateCalendarFeedTokenRequest) Reset() {
+	*x = CreateCalendarFeedTokenRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCommentRequest) String() string {
+func (x *CreateCalendarFeedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCommentRequest) ProtoMessage() {}
+func (*CreateCalendarFeedTokenRequest) ProtoMessage() {}
 
-func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[14]
+func (x *CreateCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,54 +2473,108 @@ func (x *UpdateCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCommentRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreateCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *UpdateCommentRequest) GetId() string {
+func (x *CreateCalendarFeedTokenRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
 
-func (x *UpdateCommentRequest) GetContent() string {
+type CalendarFeedToken struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Token          string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // Only returned when the token is created
+	OrganizationId string                 `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CalendarFeedToken) Reset() {
+	*x = CalendarFeedToken{}
+	mi := &file_task_v1_task_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarFeedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarFeedToken) ProtoMessage() {}
+
+func (x *CalendarFeedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[34]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarFeedToken.ProtoReflect.Descriptor instead.
+func (*CalendarFeedToken) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CalendarFeedToken) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-func (x *UpdateCommentRequest) GetMentionedUsers() []string {
+func (x *CalendarFeedToken) GetOrganizationId() string {
 	if x != nil {
-		return x.MentionedUsers
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CalendarFeedToken) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CalendarFeedToken) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type DeleteCommentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RevokeCalendarFeedTokenRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationId string                 `protobuf:"bytes,1,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RevokeCalendarFeedTokenRequest) Reset() {
+	*x = RevokeCalendarFeedTokenRequest{}
+	mi := &file_task_v1_task_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *RevokeCalendarFeedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*RevokeCalendarFeedTokenRequest) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_task_v1_task_proto_msgTypes[15]
+func (x *RevokeCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_task_v1_task_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,14 +2585,14 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
-	return file_task_v1_task_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RevokeCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_task_v1_task_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *DeleteCommentRequest) GetId() string {
+func (x *RevokeCalendarFeedTokenRequest) GetOrganizationId() string {
 	if x != nil {
-		return x.Id
+		return x.OrganizationId
 	}
 	return ""
 }
@@ -1221,7 +2601,7 @@ var File_task_v1_task_proto protoreflect.FileDescriptor
 
 const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
-	"\x12task/v1/task.proto\x12\atask.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xf5\x03\n" +
+	"\x12task/v1/task.proto\x12\atask.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x84\x06\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -1241,7 +2621,15 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
 	"\x04type\x18\f \x01(\tR\x04type\x12$\n" +
 	"\x0eparent_task_id\x18\r \x01(\tR\fparentTaskId\x12#\n" +
-	"\rdisplay_order\x18\x0e \x01(\x05R\fdisplayOrder\"\xfc\x02\n" +
+	"\rdisplay_order\x18\x0e \x01(\x05R\fdisplayOrder\x12'\n" +
+	"\x0fchecklist_total\x18\x0f \x01(\x05R\x0echecklistTotal\x12%\n" +
+	"\x0echecklist_done\x18\x10 \x01(\x05R\rchecklistDone\x12;\n" +
+	"\varchived_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"archivedAt\x125\n" +
+	"\bpurge_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\apurgeAt\x12(\n" +
+	"\x10assignee_team_id\x18\x13 \x01(\tR\x0eassigneeTeamId\x12\x1f\n" +
+	"\vshared_with\x18\x14 \x03(\tR\n" +
+	"sharedWith\"\xa6\x03\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -1256,9 +2644,10 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\x04type\x18\t \x01(\tR\x04type\x12$\n" +
 	"\x0eparent_task_id\x18\n" +
 	" \x01(\tR\fparentTaskId\x12#\n" +
-	"\rdisplay_order\x18\v \x01(\x05R\fdisplayOrder\" \n" +
+	"\rdisplay_order\x18\v \x01(\x05R\fdisplayOrder\x12(\n" +
+	"\x10assignee_team_id\x18\f \x01(\tR\x0eassigneeTeamId\" \n" +
 	"\x0eGetTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x8f\x02\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x03\n" +
 	"\x10ListTasksRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
 	"\vassignee_id\x18\x02 \x01(\tR\n" +
@@ -1271,9 +2660,13 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1d\n" +
 	"\n" +
 	"sort_order\x18\b \x01(\tR\tsortOrder\x12\x16\n" +
-	"\x06search\x18\t \x01(\tR\x06search\"8\n" +
+	"\x06search\x18\t \x01(\tR\x06search\x12\x1a\n" +
+	"\barchived\x18\n" +
+	" \x01(\bR\barchived\x12(\n" +
+	"\x10assignee_team_id\x18\v \x01(\tR\x0eassigneeTeamId\x124\n" +
+	"\x16include_assignee_teams\x18\f \x01(\bR\x14includeAssigneeTeams\"8\n" +
 	"\x11ListTasksResponse\x12#\n" +
-	"\x05items\x18\x01 \x03(\v2\r.task.v1.TaskR\x05items\"\xb7\x05\n" +
+	"\x05items\x18\x01 \x03(\v2\r.task.v1.TaskR\x05items\"\xff\x05\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\x05title\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x05title\x12>\n" +
@@ -1289,15 +2682,32 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\x04type\x18\n" +
 	" \x01(\v2\x1c.google.protobuf.StringValueR\x04type\x12B\n" +
 	"\x0eparent_task_id\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\fparentTaskId\x12@\n" +
-	"\rdisplay_order\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\fdisplayOrder\"#\n" +
+	"\rdisplay_order\x18\f \x01(\v2\x1b.google.protobuf.Int32ValueR\fdisplayOrder\x12F\n" +
+	"\x10assignee_team_id\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\x0eassigneeTeamId\"#\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"$\n" +
+	"\x12RestoreTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xf1\x01\n" +
+	"\x10CloneTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x16target_organization_id\x18\x02 \x01(\tR\x14targetOrganizationId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12)\n" +
+	"\x10include_subtasks\x18\x04 \x01(\bR\x0fincludeSubtasks\x12+\n" +
+	"\x11include_checklist\x18\x05 \x01(\bR\x10includeChecklist\x12)\n" +
+	"\x10include_comments\x18\x06 \x01(\bR\x0fincludeComments\"\x91\x01\n" +
+	"\x11CloneTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x12)\n" +
+	"\bsubtasks\x18\x02 \x03(\v2\r.task.v1.TaskR\bsubtasks\x12.\n" +
+	"\x13unassigned_task_ids\x18\x03 \x03(\tR\x11unassignedTaskIds\"@\n" +
 	"\tTaskOrder\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rdisplay_order\x18\x02 \x01(\x05R\fdisplayOrder\"h\n" +
 	"\x13ReorderTasksRequest\x12'\n" +
 	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12(\n" +
-	"\x05tasks\x18\x02 \x03(\v2\x12.task.v1.TaskOrderR\x05tasks\"\xdc\x02\n" +
+	"\x05tasks\x18\x02 \x03(\v2\x12.task.v1.TaskOrderR\x05tasks\"D\n" +
+	"\x10TaskShareRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xdc\x02\n" +
 	"\aComment\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x17\n" +
@@ -1330,7 +2740,94 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12'\n" +
 	"\x0fmentioned_users\x18\x03 \x03(\tR\x0ementionedUsers\"&\n" +
 	"\x14DeleteCommentRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\xd3\x05\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc0\x02\n" +
+	"\rChecklistItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x12\x17\n" +
+	"\adone_by\x18\x05 \x01(\tR\x06doneBy\x123\n" +
+	"\adone_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06doneAt\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"4\n" +
+	"\x19ListChecklistItemsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"J\n" +
+	"\x1aListChecklistItemsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.task.v1.ChecklistItemR\x05items\"F\n" +
+	"\x17AddChecklistItemRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\\\n" +
+	"\x1aToggleChecklistItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x04done\x18\x02 \x01(\v2\x1a.google.protobuf.BoolValueR\x04done\"R\n" +
+	"\x1cReorderChecklistItemsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x19\n" +
+	"\bitem_ids\x18\x02 \x03(\tR\aitemIds\",\n" +
+	"\x1aDeleteChecklistItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xfd\x03\n" +
+	"\tImportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x03 \x01(\tR\tcreatedBy\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\a \x01(\x05R\ttotalRows\x12%\n" +
+	"\x0eprocessed_rows\x18\b \x01(\x05R\rprocessedRows\x12#\n" +
+	"\rcreated_count\x18\t \x01(\x05R\fcreatedCount\x12/\n" +
+	"\x06errors\x18\n" +
+	" \x03(\v2\x17.task.v1.ImportRowErrorR\x06errors\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x86\x01\n" +
+	"\x16StartTaskImportRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\"&\n" +
+	"\x14GetTaskImportRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb3\x02\n" +
+	"\x12ExportTasksRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\x12\x1f\n" +
+	"\vassignee_id\x18\x02 \x01(\tR\n" +
+	"assigneeId\x12\x1f\n" +
+	"\vreporter_id\x18\x03 \x01(\tR\n" +
+	"reporterId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x17\n" +
+	"\asort_by\x18\x05 \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x06 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06search\x18\a \x01(\tR\x06search\x12+\n" +
+	"\x12only_with_due_date\x18\b \x01(\bR\x0fonlyWithDueDate\x12\x1d\n" +
+	"\n" +
+	"feed_token\x18\t \x01(\tR\tfeedToken\"\xca\x01\n" +
+	"\rExportTaskRow\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.task.v1.TaskR\x04task\x12#\n" +
+	"\rassignee_name\x18\x02 \x01(\tR\fassigneeName\x12%\n" +
+	"\x0eassignee_email\x18\x03 \x01(\tR\rassigneeEmail\x12#\n" +
+	"\rreporter_name\x18\x04 \x01(\tR\freporterName\x12%\n" +
+	"\x0ereporter_email\x18\x05 \x01(\tR\rreporterEmail\"I\n" +
+	"\x1eCreateCalendarFeedTokenRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId\"\xa6\x01\n" +
+	"\x11CalendarFeedToken\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12'\n" +
+	"\x0forganization_id\x18\x02 \x01(\tR\x0eorganizationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x1eRevokeCalendarFeedTokenRequest\x12'\n" +
+	"\x0forganization_id\x18\x01 \x01(\tR\x0eorganizationId2\x8a\x0e\n" +
 	"\vTaskService\x127\n" +
 	"\n" +
 	"CreateTask\x12\x1a.task.v1.CreateTaskRequest\x1a\r.task.v1.Task\x121\n" +
@@ -1339,14 +2836,28 @@ const file_task_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"UpdateTask\x12\x1a.task.v1.UpdateTaskRequest\x1a\r.task.v1.Task\x12@\n" +
 	"\n" +
-	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\x12D\n" +
-	"\fReorderTasks\x12\x1c.task.v1.ReorderTasksRequest\x1a\x16.google.protobuf.Empty\x12@\n" +
+	"DeleteTask\x12\x1a.task.v1.DeleteTaskRequest\x1a\x16.google.protobuf.Empty\x129\n" +
+	"\vRestoreTask\x12\x1b.task.v1.RestoreTaskRequest\x1a\r.task.v1.Task\x12B\n" +
+	"\tCloneTask\x12\x19.task.v1.CloneTaskRequest\x1a\x1a.task.v1.CloneTaskResponse\x12D\n" +
+	"\fReorderTasks\x12\x1c.task.v1.ReorderTasksRequest\x1a\x16.google.protobuf.Empty\x125\n" +
+	"\tShareTask\x12\x19.task.v1.TaskShareRequest\x1a\r.task.v1.Task\x127\n" +
+	"\vUnshareTask\x12\x19.task.v1.TaskShareRequest\x1a\r.task.v1.Task\x12@\n" +
 	"\rCreateComment\x12\x1d.task.v1.CreateCommentRequest\x1a\x10.task.v1.Comment\x12:\n" +
 	"\n" +
 	"GetComment\x12\x1a.task.v1.GetCommentRequest\x1a\x10.task.v1.Comment\x12K\n" +
 	"\fListComments\x12\x1c.task.v1.ListCommentsRequest\x1a\x1d.task.v1.ListCommentsResponse\x12@\n" +
 	"\rUpdateComment\x12\x1d.task.v1.UpdateCommentRequest\x1a\x10.task.v1.Comment\x12F\n" +
-	"\rDeleteComment\x12\x1d.task.v1.DeleteCommentRequest\x1a\x16.google.protobuf.EmptyB:Z8github.com/aliirah/task-flow/shared/proto/task/v1;taskpbb\x06proto3"
+	"\rDeleteComment\x12\x1d.task.v1.DeleteCommentRequest\x1a\x16.google.protobuf.Empty\x12]\n" +
+	"\x12ListChecklistItems\x12\".task.v1.ListChecklistItemsRequest\x1a#.task.v1.ListChecklistItemsResponse\x12L\n" +
+	"\x10AddChecklistItem\x12 .task.v1.AddChecklistItemRequest\x1a\x16.task.v1.ChecklistItem\x12R\n" +
+	"\x13ToggleChecklistItem\x12#.task.v1.ToggleChecklistItemRequest\x1a\x16.task.v1.ChecklistItem\x12c\n" +
+	"\x15ReorderChecklistItems\x12%.task.v1.ReorderChecklistItemsRequest\x1a#.task.v1.ListChecklistItemsResponse\x12R\n" +
+	"\x13DeleteChecklistItem\x12#.task.v1.DeleteChecklistItemRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0fStartTaskImport\x12\x1f.task.v1.StartTaskImportRequest\x1a\x12.task.v1.ImportJob\x12B\n" +
+	"\rGetTaskImport\x12\x1d.task.v1.GetTaskImportRequest\x1a\x12.task.v1.ImportJob\x12D\n" +
+	"\vExportTasks\x12\x1b.task.v1.ExportTasksRequest\x1a\x16.task.v1.ExportTaskRow0\x01\x12^\n" +
+	"\x17CreateCalendarFeedToken\x12'.task.v1.CreateCalendarFeedTokenRequest\x1a\x1a.task.v1.CalendarFeedToken\x12Z\n" +
+	"\x17RevokeCalendarFeedToken\x12'.task.v1.RevokeCalendarFeedTokenRequest\x1a\x16.google.protobuf.EmptyB:Z8github.com/aliirah/task-flow/shared/proto/task/v1;taskpbb\x06proto3"
 
 var (
 	file_task_v1_task_proto_rawDescOnce sync.Once
@@ -1360,78 +2871,143 @@ func file_task_v1_task_proto_rawDescGZIP() []byte {
 	return file_task_v1_task_proto_rawDescData
 }
 
-var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_task_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_task_v1_task_proto_goTypes = []any{
-	(*Task)(nil),                   // 0: task.v1.Task
-	(*CreateTaskRequest)(nil),      // 1: task.v1.CreateTaskRequest
-	(*GetTaskRequest)(nil),         // 2: task.v1.GetTaskRequest
-	(*ListTasksRequest)(nil),       // 3: task.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),      // 4: task.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),      // 5: task.v1.UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),      // 6: task.v1.DeleteTaskRequest
-	(*TaskOrder)(nil),              // 7: task.v1.TaskOrder
-	(*ReorderTasksRequest)(nil),    // 8: task.v1.ReorderTasksRequest
-	(*Comment)(nil),                // 9: task.v1.Comment
-	(*CreateCommentRequest)(nil),   // 10: task.v1.CreateCommentRequest
-	(*GetCommentRequest)(nil),      // 11: task.v1.GetCommentRequest
-	(*ListCommentsRequest)(nil),    // 12: task.v1.ListCommentsRequest
-	(*ListCommentsResponse)(nil),   // 13: task.v1.ListCommentsResponse
-	(*UpdateCommentRequest)(nil),   // 14: task.v1.UpdateCommentRequest
-	(*DeleteCommentRequest)(nil),   // 15: task.v1.DeleteCommentRequest
-	(*timestamppb.Timestamp)(nil),  // 16: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 17: google.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),  // 18: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),          // 19: google.protobuf.Empty
+	(*Task)(nil),                           // 0: task.v1.Task
+	(*CreateTaskRequest)(nil),              // 1: task.v1.CreateTaskRequest
+	(*GetTaskRequest)(nil),                 // 2: task.v1.GetTaskRequest
+	(*ListTasksRequest)(nil),               // 3: task.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),              // 4: task.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),              // 5: task.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),              // 6: task.v1.DeleteTaskRequest
+	(*RestoreTaskRequest)(nil),             // 7: task.v1.RestoreTaskRequest
+	(*CloneTaskRequest)(nil),               // 8: task.v1.CloneTaskRequest
+	(*CloneTaskResponse)(nil),              // 9: task.v1.CloneTaskResponse
+	(*TaskOrder)(nil),                      // 10: task.v1.TaskOrder
+	(*ReorderTasksRequest)(nil),            // 11: task.v1.ReorderTasksRequest
+	(*TaskShareRequest)(nil),               // 12: task.v1.TaskShareRequest
+	(*Comment)(nil),                        // 13: task.v1.Comment
+	(*CreateCommentRequest)(nil),           // 14: task.v1.CreateCommentRequest
+	(*GetCommentRequest)(nil),              // 15: task.v1.GetCommentRequest
+	(*ListCommentsRequest)(nil),            // 16: task.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),           // 17: task.v1.ListCommentsResponse
+	(*UpdateCommentRequest)(nil),           // 18: task.v1.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),           // 19: task.v1.DeleteCommentRequest
+	(*ChecklistItem)(nil),                  // 20: task.v1.ChecklistItem
+	(*ListChecklistItemsRequest)(nil),      // 21: task.v1.ListChecklistItemsRequest
+	(*ListChecklistItemsResponse)(nil),     // 22: task.v1.ListChecklistItemsResponse
+	(*AddChecklistItemRequest)(nil),        // 23: task.v1.AddChecklistItemRequest
+	(*ToggleChecklistItemRequest)(nil),     // 24: task.v1.ToggleChecklistItemRequest
+	(*ReorderChecklistItemsRequest)(nil),   // 25: task.v1.ReorderChecklistItemsRequest
+	(*DeleteChecklistItemRequest)(nil),     // 26: task.v1.DeleteChecklistItemRequest
+	(*ImportRowError)(nil),                 // 27: task.v1.ImportRowError
+	(*ImportJob)(nil),                      // 28: task.v1.ImportJob
+	(*StartTaskImportRequest)(nil),         // 29: task.v1.StartTaskImportRequest
+	(*GetTaskImportRequest)(nil),           // 30: task.v1.GetTaskImportRequest
+	(*ExportTasksRequest)(nil),             // 31: task.v1.ExportTasksRequest
+	(*ExportTaskRow)(nil),                  // 32: task.v1.ExportTaskRow
+	(*CreateCalendarFeedTokenRequest)(nil), // 33: task.v1.CreateCalendarFeedTokenRequest
+	(*CalendarFeedToken)(nil),              // 34: task.v1.CalendarFeedToken
+	(*RevokeCalendarFeedTokenRequest)(nil), // 35: task.v1.RevokeCalendarFeedTokenRequest
+	(*timestamppb.Timestamp)(nil),          // 36: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),         // 37: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),          // 38: google.protobuf.Int32Value
+	(*wrapperspb.BoolValue)(nil),           // 39: google.protobuf.BoolValue
+	(*emptypb.Empty)(nil),                  // 40: google.protobuf.Empty
 }
 var file_task_v1_task_proto_depIdxs = []int32{
-	16, // 0: task.v1.Task.due_at:type_name -> google.protobuf.Timestamp
-	16, // 1: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	16, // 2: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	16, // 3: task.v1.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: task.v1.ListTasksResponse.items:type_name -> task.v1.Task
-	17, // 5: task.v1.UpdateTaskRequest.title:type_name -> google.protobuf.StringValue
-	17, // 6: task.v1.UpdateTaskRequest.description:type_name -> google.protobuf.StringValue
-	17, // 7: task.v1.UpdateTaskRequest.status:type_name -> google.protobuf.StringValue
-	17, // 8: task.v1.UpdateTaskRequest.priority:type_name -> google.protobuf.StringValue
-	17, // 9: task.v1.UpdateTaskRequest.organization_id:type_name -> google.protobuf.StringValue
-	17, // 10: task.v1.UpdateTaskRequest.assignee_id:type_name -> google.protobuf.StringValue
-	17, // 11: task.v1.UpdateTaskRequest.reporter_id:type_name -> google.protobuf.StringValue
-	16, // 12: task.v1.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
-	17, // 13: task.v1.UpdateTaskRequest.type:type_name -> google.protobuf.StringValue
-	17, // 14: task.v1.UpdateTaskRequest.parent_task_id:type_name -> google.protobuf.StringValue
-	18, // 15: task.v1.UpdateTaskRequest.display_order:type_name -> google.protobuf.Int32Value
-	7,  // 16: task.v1.ReorderTasksRequest.tasks:type_name -> task.v1.TaskOrder
-	16, // 17: task.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
-	16, // 18: task.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 19: task.v1.Comment.replies:type_name -> task.v1.Comment
-	9,  // 20: task.v1.ListCommentsResponse.items:type_name -> task.v1.Comment
-	1,  // 21: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
-	2,  // 22: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
-	3,  // 23: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
-	5,  // 24: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
-	6,  // 25: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
-	8,  // 26: task.v1.TaskService.ReorderTasks:input_type -> task.v1.ReorderTasksRequest
-	10, // 27: task.v1.TaskService.CreateComment:input_type -> task.v1.CreateCommentRequest
-	11, // 28: task.v1.TaskService.GetComment:input_type -> task.v1.GetCommentRequest
-	12, // 29: task.v1.TaskService.ListComments:input_type -> task.v1.ListCommentsRequest
-	14, // 30: task.v1.TaskService.UpdateComment:input_type -> task.v1.UpdateCommentRequest
-	15, // 31: task.v1.TaskService.DeleteComment:input_type -> task.v1.DeleteCommentRequest
-	0,  // 32: task.v1.TaskService.CreateTask:output_type -> task.v1.Task
-	0,  // 33: task.v1.TaskService.GetTask:output_type -> task.v1.Task
-	4,  // 34: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
-	0,  // 35: task.v1.TaskService.UpdateTask:output_type -> task.v1.Task
-	19, // 36: task.v1.TaskService.DeleteTask:output_type -> google.protobuf.Empty
-	19, // 37: task.v1.TaskService.ReorderTasks:output_type -> google.protobuf.Empty
-	9,  // 38: task.v1.TaskService.CreateComment:output_type -> task.v1.Comment
-	9,  // 39: task.v1.TaskService.GetComment:output_type -> task.v1.Comment
-	13, // 40: task.v1.TaskService.ListComments:output_type -> task.v1.ListCommentsResponse
-	9,  // 41: task.v1.TaskService.UpdateComment:output_type -> task.v1.Comment
-	19, // 42: task.v1.TaskService.DeleteComment:output_type -> google.protobuf.Empty
-	32, // [32:43] is the sub-list for method output_type
-	21, // [21:32] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	36, // 0: task.v1.Task.due_at:type_name -> google.protobuf.Timestamp
+	36, // 1: task.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	36, // 2: task.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	36, // 3: task.v1.Task.archived_at:type_name -> google.protobuf.Timestamp
+	36, // 4: task.v1.Task.purge_at:type_name -> google.protobuf.Timestamp
+	36, // 5: task.v1.CreateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: task.v1.ListTasksResponse.items:type_name -> task.v1.Task
+	37, // 7: task.v1.UpdateTaskRequest.title:type_name -> google.protobuf.StringValue
+	37, // 8: task.v1.UpdateTaskRequest.description:type_name -> google.protobuf.StringValue
+	37, // 9: task.v1.UpdateTaskRequest.status:type_name -> google.protobuf.StringValue
+	37, // 10: task.v1.UpdateTaskRequest.priority:type_name -> google.protobuf.StringValue
+	37, // 11: task.v1.UpdateTaskRequest.organization_id:type_name -> google.protobuf.StringValue
+	37, // 12: task.v1.UpdateTaskRequest.assignee_id:type_name -> google.protobuf.StringValue
+	37, // 13: task.v1.UpdateTaskRequest.reporter_id:type_name -> google.protobuf.StringValue
+	36, // 14: task.v1.UpdateTaskRequest.due_at:type_name -> google.protobuf.Timestamp
+	37, // 15: task.v1.UpdateTaskRequest.type:type_name -> google.protobuf.StringValue
+	37, // 16: task.v1.UpdateTaskRequest.parent_task_id:type_name -> google.protobuf.StringValue
+	38, // 17: task.v1.UpdateTaskRequest.display_order:type_name -> google.protobuf.Int32Value
+	37, // 18: task.v1.UpdateTaskRequest.assignee_team_id:type_name -> google.protobuf.StringValue
+	0,  // 19: task.v1.CloneTaskResponse.task:type_name -> task.v1.Task
+	0,  // 20: task.v1.CloneTaskResponse.subtasks:type_name -> task.v1.Task
+	10, // 21: task.v1.ReorderTasksRequest.tasks:type_name -> task.v1.TaskOrder
+	36, // 22: task.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	36, // 23: task.v1.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 24: task.v1.Comment.replies:type_name -> task.v1.Comment
+	13, // 25: task.v1.ListCommentsResponse.items:type_name -> task.v1.Comment
+	36, // 26: task.v1.ChecklistItem.done_at:type_name -> google.protobuf.Timestamp
+	36, // 27: task.v1.ChecklistItem.created_at:type_name -> google.protobuf.Timestamp
+	36, // 28: task.v1.ChecklistItem.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 29: task.v1.ListChecklistItemsResponse.items:type_name -> task.v1.ChecklistItem
+	39, // 30: task.v1.ToggleChecklistItemRequest.done:type_name -> google.protobuf.BoolValue
+	27, // 31: task.v1.ImportJob.errors:type_name -> task.v1.ImportRowError
+	36, // 32: task.v1.ImportJob.created_at:type_name -> google.protobuf.Timestamp
+	36, // 33: task.v1.ImportJob.updated_at:type_name -> google.protobuf.Timestamp
+	36, // 34: task.v1.ImportJob.completed_at:type_name -> google.protobuf.Timestamp
+	0,  // 35: task.v1.ExportTaskRow.task:type_name -> task.v1.Task
+	36, // 36: task.v1.CalendarFeedToken.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 37: task.v1.TaskService.CreateTask:input_type -> task.v1.CreateTaskRequest
+	2,  // 38: task.v1.TaskService.GetTask:input_type -> task.v1.GetTaskRequest
+	3,  // 39: task.v1.TaskService.ListTasks:input_type -> task.v1.ListTasksRequest
+	5,  // 40: task.v1.TaskService.UpdateTask:input_type -> task.v1.UpdateTaskRequest
+	6,  // 41: task.v1.TaskService.DeleteTask:input_type -> task.v1.DeleteTaskRequest
+	7,  // 42: task.v1.TaskService.RestoreTask:input_type -> task.v1.RestoreTaskRequest
+	8,  // 43: task.v1.TaskService.CloneTask:input_type -> task.v1.CloneTaskRequest
+	11, // 44: task.v1.TaskService.ReorderTasks:input_type -> task.v1.ReorderTasksRequest
+	12, // 45: task.v1.TaskService.ShareTask:input_type -> task.v1.TaskShareRequest
+	12, // 46: task.v1.TaskService.UnshareTask:input_type -> task.v1.TaskShareRequest
+	14, // 47: task.v1.TaskService.CreateComment:input_type -> task.v1.CreateCommentRequest
+	15, // 48: task.v1.TaskService.GetComment:input_type -> task.v1.GetCommentRequest
+	16, // 49: task.v1.TaskService.ListComments:input_type -> task.v1.ListCommentsRequest
+	18, // 50: task.v1.TaskService.UpdateComment:input_type -> task.v1.UpdateCommentRequest
+	19, // 51: task.v1.TaskService.DeleteComment:input_type -> task.v1.DeleteCommentRequest
+	21, // 52: task.v1.TaskService.ListChecklistItems:input_type -> task.v1.ListChecklistItemsRequest
+	23, // 53: task.v1.TaskService.AddChecklistItem:input_type -> task.v1.AddChecklistItemRequest
+	24, // 54: task.v1.TaskService.ToggleChecklistItem:input_type -> task.v1.ToggleChecklistItemRequest
+	25, // 55: task.v1.TaskService.ReorderChecklistItems:input_type -> task.v1.ReorderChecklistItemsRequest
+	26, // 56: task.v1.TaskService.DeleteChecklistItem:input_type -> task.v1.DeleteChecklistItemRequest
+	29, // 57: task.v1.TaskService.StartTaskImport:input_type -> task.v1.StartTaskImportRequest
+	30, // 58: task.v1.TaskService.GetTaskImport:input_type -> task.v1.GetTaskImportRequest
+	31, // 59: task.v1.TaskService.ExportTasks:input_type -> task.v1.ExportTasksRequest
+	33, // 60: task.v1.TaskService.CreateCalendarFeedToken:input_type -> task.v1.CreateCalendarFeedTokenRequest
+	35, // 61: task.v1.TaskService.RevokeCalendarFeedToken:input_type -> task.v1.RevokeCalendarFeedTokenRequest
+	0,  // 62: task.v1.TaskService.CreateTask:output_type -> task.v1.Task
+	0,  // 63: task.v1.TaskService.GetTask:output_type -> task.v1.Task
+	4,  // 64: task.v1.TaskService.ListTasks:output_type -> task.v1.ListTasksResponse
+	0,  // 65: task.v1.TaskService.UpdateTask:output_type -> task.v1.Task
+	40, // 66: task.v1.TaskService.DeleteTask:output_type -> google.protobuf.Empty
+	0,  // 67: task.v1.TaskService.RestoreTask:output_type -> task.v1.Task
+	9,  // 68: task.v1.TaskService.CloneTask:output_type -> task.v1.CloneTaskResponse
+	40, // 69: task.v1.TaskService.ReorderTasks:output_type -> google.protobuf.Empty
+	0,  // 70: task.v1.TaskService.ShareTask:output_type -> task.v1.Task
+	0,  // 71: task.v1.TaskService.UnshareTask:output_type -> task.v1.Task
+	13, // 72: task.v1.TaskService.CreateComment:output_type -> task.v1.Comment
+	13, // 73: task.v1.TaskService.GetComment:output_type -> task.v1.Comment
+	17, // 74: task.v1.TaskService.ListComments:output_type -> task.v1.ListCommentsResponse
+	13, // 75: task.v1.TaskService.UpdateComment:output_type -> task.v1.Comment
+	40, // 76: task.v1.TaskService.DeleteComment:output_type -> google.protobuf.Empty
+	22, // 77: task.v1.TaskService.ListChecklistItems:output_type -> task.v1.ListChecklistItemsResponse
+	20, // 78: task.v1.TaskService.AddChecklistItem:output_type -> task.v1.ChecklistItem
+	20, // 79: task.v1.TaskService.ToggleChecklistItem:output_type -> task.v1.ChecklistItem
+	22, // 80: task.v1.TaskService.ReorderChecklistItems:output_type -> task.v1.ListChecklistItemsResponse
+	40, // 81: task.v1.TaskService.DeleteChecklistItem:output_type -> google.protobuf.Empty
+	28, // 82: task.v1.TaskService.StartTaskImport:output_type -> task.v1.ImportJob
+	28, // 83: task.v1.TaskService.GetTaskImport:output_type -> task.v1.ImportJob
+	32, // 84: task.v1.TaskService.ExportTasks:output_type -> task.v1.ExportTaskRow
+	34, // 85: task.v1.TaskService.CreateCalendarFeedToken:output_type -> task.v1.CalendarFeedToken
+	40, // 86: task.v1.TaskService.RevokeCalendarFeedToken:output_type -> google.protobuf.Empty
+	62, // [62:87] is the sub-list for method output_type
+	37, // [37:62] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_task_v1_task_proto_init() }
@@ -1445,7 +3021,7 @@ func file_task_v1_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_task_v1_task_proto_rawDesc), len(file_task_v1_task_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,17 +20,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_CreateTask_FullMethodName    = "/task.v1.TaskService/CreateTask"
-	TaskService_GetTask_FullMethodName       = "/task.v1.TaskService/GetTask"
-	TaskService_ListTasks_FullMethodName     = "/task.v1.TaskService/ListTasks"
-	TaskService_UpdateTask_FullMethodName    = "/task.v1.TaskService/UpdateTask"
-	TaskService_DeleteTask_FullMethodName    = "/task.v1.TaskService/DeleteTask"
-	TaskService_ReorderTasks_FullMethodName  = "/task.v1.TaskService/ReorderTasks"
-	TaskService_CreateComment_FullMethodName = "/task.v1.TaskService/CreateComment"
-	TaskService_GetComment_FullMethodName    = "/task.v1.TaskService/GetComment"
-	TaskService_ListComments_FullMethodName  = "/task.v1.TaskService/ListComments"
-	TaskService_UpdateComment_FullMethodName = "/task.v1.TaskService/UpdateComment"
-	TaskService_DeleteComment_FullMethodName = "/task.v1.TaskService/DeleteComment"
+	TaskService_CreateTask_FullMethodName              = "/task.v1.TaskService/CreateTask"
+	TaskService_GetTask_FullMethodName                 = "/task.v1.TaskService/GetTask"
+	TaskService_ListTasks_FullMethodName               = "/task.v1.TaskService/ListTasks"
+	TaskService_UpdateTask_FullMethodName              = "/task.v1.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName              = "/task.v1.TaskService/DeleteTask"
+	TaskService_RestoreTask_FullMethodName             = "/task.v1.TaskService/RestoreTask"
+	TaskService_CloneTask_FullMethodName               = "/task.v1.TaskService/CloneTask"
+	TaskService_ReorderTasks_FullMethodName            = "/task.v1.TaskService/ReorderTasks"
+	TaskService_ShareTask_FullMethodName               = "/task.v1.TaskService/ShareTask"
+	TaskService_UnshareTask_FullMethodName             = "/task.v1.TaskService/UnshareTask"
+	TaskService_CreateComment_FullMethodName           = "/task.v1.TaskService/CreateComment"
+	TaskService_GetComment_FullMethodName              = "/task.v1.TaskService/GetComment"
+	TaskService_ListComments_FullMethodName            = "/task.v1.TaskService/ListComments"
+	TaskService_UpdateComment_FullMethodName           = "/task.v1.TaskService/UpdateComment"
+	TaskService_DeleteComment_FullMethodName           = "/task.v1.TaskService/DeleteComment"
+	TaskService_ListChecklistItems_FullMethodName      = "/task.v1.TaskService/ListChecklistItems"
+	TaskService_AddChecklistItem_FullMethodName        = "/task.v1.TaskService/AddChecklistItem"
+	TaskService_ToggleChecklistItem_FullMethodName     = "/task.v1.TaskService/ToggleChecklistItem"
+	TaskService_ReorderChecklistItems_FullMethodName   = "/task.v1.TaskService/ReorderChecklistItems"
+	TaskService_DeleteChecklistItem_FullMethodName     = "/task.v1.TaskService/DeleteChecklistItem"
+	TaskService_StartTaskImport_FullMethodName         = "/task.v1.TaskService/StartTaskImport"
+	TaskService_GetTaskImport_FullMethodName           = "/task.v1.TaskService/GetTaskImport"
+	TaskService_ExportTasks_FullMethodName             = "/task.v1.TaskService/ExportTasks"
+	TaskService_CreateCalendarFeedToken_FullMethodName = "/task.v1.TaskService/CreateCalendarFeedToken"
+	TaskService_RevokeCalendarFeedToken_FullMethodName = "/task.v1.TaskService/RevokeCalendarFeedToken"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -42,13 +56,31 @@ type TaskServiceClient interface {
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error)
 	DeleteTask(ctx context.Context, in *DeleteTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*Task, error)
+	CloneTask(ctx context.Context, in *CloneTaskRequest, opts ...grpc.CallOption) (*CloneTaskResponse, error)
 	ReorderTasks(ctx context.Context, in *ReorderTasksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sharing with guests
+	ShareTask(ctx context.Context, in *TaskShareRequest, opts ...grpc.CallOption) (*Task, error)
+	UnshareTask(ctx context.Context, in *TaskShareRequest, opts ...grpc.CallOption) (*Task, error)
 	// Comment operations
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	GetComment(ctx context.Context, in *GetCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
 	UpdateComment(ctx context.Context, in *UpdateCommentRequest, opts ...grpc.CallOption) (*Comment, error)
 	DeleteComment(ctx context.Context, in *DeleteCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Checklist operations
+	ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error)
+	AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistItem, error)
+	ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error)
+	DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Bulk import
+	StartTaskImport(ctx context.Context, in *StartTaskImportRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	GetTaskImport(ctx context.Context, in *GetTaskImportRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	// Export
+	ExportTasks(ctx context.Context, in *ExportTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTaskRow], error)
+	CreateCalendarFeedToken(ctx context.Context, in *CreateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedToken, error)
+	RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type taskServiceClient struct {
@@ -109,6 +141,26 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, in *DeleteTaskReques
 	return out, nil
 }
 
+func (c *taskServiceClient) RestoreTask(ctx context.Context, in *RestoreTaskRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) CloneTask(ctx context.Context, in *CloneTaskRequest, opts ...grpc.CallOption) (*CloneTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_CloneTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) ReorderTasks(ctx context.Context, in *ReorderTasksRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -119,6 +171,26 @@ func (c *taskServiceClient) ReorderTasks(ctx context.Context, in *ReorderTasksRe
 	return out, nil
 }
 
+func (c *taskServiceClient) ShareTask(ctx context.Context, in *TaskShareRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_ShareTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UnshareTask(ctx context.Context, in *TaskShareRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_UnshareTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*Comment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Comment)
@@ -169,6 +241,115 @@ func (c *taskServiceClient) DeleteComment(ctx context.Context, in *DeleteComment
 	return out, nil
 }
 
+func (c *taskServiceClient) ListChecklistItems(ctx context.Context, in *ListChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecklistItemsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListChecklistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddChecklistItem(ctx context.Context, in *AddChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistItem)
+	err := c.cc.Invoke(ctx, TaskService_AddChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ToggleChecklistItem(ctx context.Context, in *ToggleChecklistItemRequest, opts ...grpc.CallOption) (*ChecklistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChecklistItem)
+	err := c.cc.Invoke(ctx, TaskService_ToggleChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderChecklistItems(ctx context.Context, in *ReorderChecklistItemsRequest, opts ...grpc.CallOption) (*ListChecklistItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChecklistItemsResponse)
+	err := c.cc.Invoke(ctx, TaskService_ReorderChecklistItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteChecklistItem(ctx context.Context, in *DeleteChecklistItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_DeleteChecklistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) StartTaskImport(ctx context.Context, in *StartTaskImportRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, TaskService_StartTaskImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskImport(ctx context.Context, in *GetTaskImportRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskImport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ExportTasks(ctx context.Context, in *ExportTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportTaskRow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], TaskService_ExportTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportTasksRequest, ExportTaskRow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_ExportTasksClient = grpc.ServerStreamingClient[ExportTaskRow]
+
+func (c *taskServiceClient) CreateCalendarFeedToken(ctx context.Context, in *CreateCalendarFeedTokenRequest, opts ...grpc.CallOption) (*CalendarFeedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeedToken)
+	err := c.cc.Invoke(ctx, TaskService_CreateCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RevokeCalendarFeedToken(ctx context.Context, in *RevokeCalendarFeedTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TaskService_RevokeCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -178,13 +359,31 @@ type TaskServiceServer interface {
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	UpdateTask(context.Context, *UpdateTaskRequest) (*Task, error)
 	DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
+	RestoreTask(context.Context, *RestoreTaskRequest) (*Task, error)
+	CloneTask(context.Context, *CloneTaskRequest) (*CloneTaskResponse, error)
 	ReorderTasks(context.Context, *ReorderTasksRequest) (*emptypb.Empty, error)
+	// Sharing with guests
+	ShareTask(context.Context, *TaskShareRequest) (*Task, error)
+	UnshareTask(context.Context, *TaskShareRequest) (*Task, error)
 	// Comment operations
 	CreateComment(context.Context, *CreateCommentRequest) (*Comment, error)
 	GetComment(context.Context, *GetCommentRequest) (*Comment, error)
 	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
 	UpdateComment(context.Context, *UpdateCommentRequest) (*Comment, error)
 	DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error)
+	// Checklist operations
+	ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*ListChecklistItemsResponse, error)
+	AddChecklistItem(context.Context, *AddChecklistItemRequest) (*ChecklistItem, error)
+	ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*ChecklistItem, error)
+	ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ListChecklistItemsResponse, error)
+	DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*emptypb.Empty, error)
+	// Bulk import
+	StartTaskImport(context.Context, *StartTaskImportRequest) (*ImportJob, error)
+	GetTaskImport(context.Context, *GetTaskImportRequest) (*ImportJob, error)
+	// Export
+	ExportTasks(*ExportTasksRequest, grpc.ServerStreamingServer[ExportTaskRow]) error
+	CreateCalendarFeedToken(context.Context, *CreateCalendarFeedTokenRequest) (*CalendarFeedToken, error)
+	RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -210,9 +409,21 @@ func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskReq
 func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTaskServiceServer) RestoreTask(context.Context, *RestoreTaskRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTaskServiceServer) CloneTask(context.Context, *CloneTaskRequest) (*CloneTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneTask not implemented")
+}
 func (UnimplementedTaskServiceServer) ReorderTasks(context.Context, *ReorderTasksRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReorderTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) ShareTask(context.Context, *TaskShareRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareTask not implemented")
+}
+func (UnimplementedTaskServiceServer) UnshareTask(context.Context, *TaskShareRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareTask not implemented")
+}
 func (UnimplementedTaskServiceServer) CreateComment(context.Context, *CreateCommentRequest) (*Comment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
 }
@@ -228,6 +439,36 @@ func (UnimplementedTaskServiceServer) UpdateComment(context.Context, *UpdateComm
 func (UnimplementedTaskServiceServer) DeleteComment(context.Context, *DeleteCommentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
 }
+func (UnimplementedTaskServiceServer) ListChecklistItems(context.Context, *ListChecklistItemsRequest) (*ListChecklistItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChecklistItems not implemented")
+}
+func (UnimplementedTaskServiceServer) AddChecklistItem(context.Context, *AddChecklistItemRequest) (*ChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ToggleChecklistItem(context.Context, *ToggleChecklistItemRequest) (*ChecklistItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderChecklistItems(context.Context, *ReorderChecklistItemsRequest) (*ListChecklistItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderChecklistItems not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteChecklistItem(context.Context, *DeleteChecklistItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChecklistItem not implemented")
+}
+func (UnimplementedTaskServiceServer) StartTaskImport(context.Context, *StartTaskImportRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTaskImport not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskImport(context.Context, *GetTaskImportRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskImport not implemented")
+}
+func (UnimplementedTaskServiceServer) ExportTasks(*ExportTasksRequest, grpc.ServerStreamingServer[ExportTaskRow]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) CreateCalendarFeedToken(context.Context, *CreateCalendarFeedTokenRequest) (*CalendarFeedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarFeedToken not implemented")
+}
+func (UnimplementedTaskServiceServer) RevokeCalendarFeedToken(context.Context, *RevokeCalendarFeedTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeedToken not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -339,6 +580,42 @@ func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreTask(ctx, req.(*RestoreTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CloneTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CloneTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CloneTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CloneTask(ctx, req.(*CloneTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_ReorderTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReorderTasksRequest)
 	if err := dec(in); err != nil {
@@ -357,6 +634,42 @@ func _TaskService_ReorderTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ShareTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ShareTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ShareTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ShareTask(ctx, req.(*TaskShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UnshareTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UnshareTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UnshareTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UnshareTask(ctx, req.(*TaskShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCommentRequest)
 	if err := dec(in); err != nil {
@@ -447,6 +760,179 @@ func _TaskService_DeleteComment_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_ListChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListChecklistItems(ctx, req.(*ListChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_AddChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddChecklistItem(ctx, req.(*AddChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ToggleChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ToggleChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ToggleChecklistItem(ctx, req.(*ToggleChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderChecklistItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChecklistItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReorderChecklistItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderChecklistItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderChecklistItems(ctx, req.(*ReorderChecklistItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteChecklistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChecklistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteChecklistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteChecklistItem(ctx, req.(*DeleteChecklistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_StartTaskImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).StartTaskImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_StartTaskImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).StartTaskImport(ctx, req.(*StartTaskImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskImport(ctx, req.(*GetTaskImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ExportTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).ExportTasks(m, &grpc.GenericServerStream[ExportTasksRequest, ExportTaskRow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_ExportTasksServer = grpc.ServerStreamingServer[ExportTaskRow]
+
+func _TaskService_CreateCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CreateCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_CreateCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CreateCalendarFeedToken(ctx, req.(*CreateCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RevokeCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RevokeCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RevokeCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RevokeCalendarFeedToken(ctx, req.(*RevokeCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -474,10 +960,26 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TaskService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TaskService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "CloneTask",
+			Handler:    _TaskService_CloneTask_Handler,
+		},
 		{
 			MethodName: "ReorderTasks",
 			Handler:    _TaskService_ReorderTasks_Handler,
 		},
+		{
+			MethodName: "ShareTask",
+			Handler:    _TaskService_ShareTask_Handler,
+		},
+		{
+			MethodName: "UnshareTask",
+			Handler:    _TaskService_UnshareTask_Handler,
+		},
 		{
 			MethodName: "CreateComment",
 			Handler:    _TaskService_CreateComment_Handler,
@@ -498,7 +1000,49 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteComment",
 			Handler:    _TaskService_DeleteComment_Handler,
 		},
+		{
+			MethodName: "ListChecklistItems",
+			Handler:    _TaskService_ListChecklistItems_Handler,
+		},
+		{
+			MethodName: "AddChecklistItem",
+			Handler:    _TaskService_AddChecklistItem_Handler,
+		},
+		{
+			MethodName: "ToggleChecklistItem",
+			Handler:    _TaskService_ToggleChecklistItem_Handler,
+		},
+		{
+			MethodName: "ReorderChecklistItems",
+			Handler:    _TaskService_ReorderChecklistItems_Handler,
+		},
+		{
+			MethodName: "DeleteChecklistItem",
+			Handler:    _TaskService_DeleteChecklistItem_Handler,
+		},
+		{
+			MethodName: "StartTaskImport",
+			Handler:    _TaskService_StartTaskImport_Handler,
+		},
+		{
+			MethodName: "GetTaskImport",
+			Handler:    _TaskService_GetTaskImport_Handler,
+		},
+		{
+			MethodName: "CreateCalendarFeedToken",
+			Handler:    _TaskService_CreateCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "RevokeCalendarFeedToken",
+			Handler:    _TaskService_RevokeCalendarFeedToken_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTasks",
+			Handler:       _TaskService_ExportTasks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "task/v1/task.proto",
 }
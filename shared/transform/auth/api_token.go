@@ -0,0 +1,32 @@
+package auth
+
+import (
+	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// APITokenToMap converts an API token proto into a gin.H for HTTP responses.
+// The secret is never part of it.
+func APITokenToMap(token *authpb.APIToken) gin.H {
+	if token == nil {
+		return gin.H{}
+	}
+	scopes := token.GetScopes()
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return gin.H{
+		"id":             token.GetId(),
+		"kind":           token.GetKind(),
+		"name":           token.GetName(),
+		"prefix":         token.GetPrefix(),
+		"scopes":         scopes,
+		"organizationId": token.GetOrganizationId(),
+		"createdBy":      token.GetCreatedBy(),
+		"expiresAt":      common.TimestampToString(token.GetExpiresAt()),
+		"lastUsedAt":     common.TimestampToString(token.GetLastUsedAt()),
+		"lastUsedIp":     token.GetLastUsedIp(),
+		"createdAt":      common.TimestampToString(token.GetCreatedAt()),
+	}
+}
@@ -0,0 +1,24 @@
+package auth
+
+import (
+	authpb "github.com/aliirah/task-flow/shared/proto/auth/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// SessionToMap converts a login session proto into a gin.H for HTTP responses.
+func SessionToMap(session *authpb.Session) gin.H {
+	if session == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":           session.GetId(),
+		"device":       session.GetDevice(),
+		"userAgent":    session.GetUserAgent(),
+		"ipAddress":    session.GetIpAddress(),
+		"createdAt":    common.TimestampToString(session.GetCreatedAt()),
+		"lastActiveAt": common.TimestampToString(session.GetLastActiveAt()),
+		"expiresAt":    common.TimestampToString(session.GetExpiresAt()),
+		"current":      session.GetCurrent(),
+	}
+}
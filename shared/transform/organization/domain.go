@@ -0,0 +1,38 @@
+package organization
+
+import (
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// DomainToMap converts an organization domain proto to a gin.H representation.
+func DomainToMap(domain *organizationpb.OrganizationDomain) gin.H {
+	if domain == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":             domain.GetId(),
+		"organizationId": domain.GetOrganizationId(),
+		"domain":         domain.GetDomain(),
+		"defaultRole":    domain.GetDefaultRole(),
+		"autoJoin":       domain.GetAutoJoin(),
+		"verified":       domain.GetVerifiedAt() != nil,
+		"verification": gin.H{
+			"type":  "TXT",
+			"name":  domain.GetVerificationRecord(),
+			"value": domain.GetVerificationValue(),
+		},
+		"verifiedAt": common.TimestampToString(domain.GetVerifiedAt()),
+		"createdAt":  common.TimestampToString(domain.GetCreatedAt()),
+	}
+}
+
+// DomainsToMaps converts a list of organization domain protos.
+func DomainsToMaps(domains []*organizationpb.OrganizationDomain) []gin.H {
+	items := make([]gin.H, 0, len(domains))
+	for _, domain := range domains {
+		items = append(items, DomainToMap(domain))
+	}
+	return items
+}
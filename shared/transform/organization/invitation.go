@@ -0,0 +1,36 @@
+package organization
+
+import (
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// InvitationToMap converts an invitation proto to a gin.H representation.
+func InvitationToMap(invitation *organizationpb.Invitation) gin.H {
+	if invitation == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":               invitation.GetId(),
+		"organizationId":   invitation.GetOrganizationId(),
+		"organizationName": invitation.GetOrganizationName(),
+		"email":            invitation.GetEmail(),
+		"role":             invitation.GetRole(),
+		"inviterId":        invitation.GetInviterId(),
+		"status":           invitation.GetStatus(),
+		"expiresAt":        common.TimestampToString(invitation.GetExpiresAt()),
+		"createdAt":        common.TimestampToString(invitation.GetCreatedAt()),
+		"respondedAt":      common.TimestampToString(invitation.GetRespondedAt()),
+		"domain":           invitation.GetDomain(),
+	}
+}
+
+// InvitationsToMaps converts a list of invitation protos.
+func InvitationsToMaps(invitations []*organizationpb.Invitation) []gin.H {
+	items := make([]gin.H, 0, len(invitations))
+	for _, invitation := range invitations {
+		items = append(items, InvitationToMap(invitation))
+	}
+	return items
+}
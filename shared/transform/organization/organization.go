@@ -18,5 +18,15 @@ func ToMap(org *organizationpb.Organization) gin.H {
 		"ownerId":     org.GetOwnerId(),
 		"createdAt":   common.TimestampToString(org.GetCreatedAt()),
 		"updatedAt":   common.TimestampToString(org.GetUpdatedAt()),
+		"plan":        org.GetPlan(),
+
+		"requireVerifiedEmail": org.GetRequireVerifiedEmail(),
+		"requireTwoFactor":     org.GetRequireTwoFactor(),
+		"passwordMinLength":    org.GetPasswordMinLength(),
+		"passwordMinClasses":   org.GetPasswordMinClasses(),
+		"passwordHistory":      org.GetPasswordHistory(),
+
+		"deletionRequestedAt": common.TimestampToString(org.GetDeletionRequestedAt()),
+		"deleteAfter":         common.TimestampToString(org.GetDeleteAfter()),
 	}
 }
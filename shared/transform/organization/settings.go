@@ -0,0 +1,44 @@
+package organization
+
+import (
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// SettingsToMap converts an organization settings proto to a gin.H representation.
+func SettingsToMap(settings *organizationpb.OrganizationSettings) gin.H {
+	if settings == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"organizationId": settings.GetOrganizationId(),
+		"version":        settings.GetVersion(),
+		"schemaVersion":  settings.GetSchemaVersion(),
+		"tasks": gin.H{
+			"defaultPriority": settings.GetTasks().GetDefaultPriority(),
+			"allowedTypes":    nonNil(settings.GetTasks().GetAllowedTypes()),
+		},
+		"notifications": gin.H{
+			"mutedEvents": nonNil(settings.GetNotifications().GetMutedEvents()),
+		},
+		"security": gin.H{
+			"requireTwoFactor": settings.GetSecurity().GetRequireTwoFactor(),
+		},
+		"membership": gin.H{
+			"requireApproval": settings.GetMembership().GetRequireApproval(),
+		},
+		"retention": gin.H{
+			"archivedTaskDays": settings.GetRetention().GetArchivedTaskDays(),
+		},
+		"updatedAt": common.TimestampToString(settings.GetUpdatedAt()),
+	}
+}
+
+// nonNil keeps empty lists as [] rather than null in responses
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,33 @@
+package organization
+
+import (
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// TeamToMap converts a team proto to a gin.H representation.
+func TeamToMap(team *organizationpb.Team) gin.H {
+	if team == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":             team.GetId(),
+		"organizationId": team.GetOrganizationId(),
+		"name":           team.GetName(),
+		"description":    team.GetDescription(),
+		"leadId":         team.GetLeadId(),
+		"memberIds":      nonNil(team.GetMemberIds()),
+		"createdAt":      common.TimestampToString(team.GetCreatedAt()),
+		"updatedAt":      common.TimestampToString(team.GetUpdatedAt()),
+	}
+}
+
+// TeamsToMaps converts a list of team protos.
+func TeamsToMaps(teams []*organizationpb.Team) []gin.H {
+	items := make([]gin.H, 0, len(teams))
+	for _, team := range teams {
+		items = append(items, TeamToMap(team))
+	}
+	return items
+}
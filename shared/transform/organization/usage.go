@@ -0,0 +1,32 @@
+package organization
+
+import (
+	organizationpb "github.com/aliirah/task-flow/shared/proto/organization/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// UsageToMap converts an organization usage proto to a gin.H representation.
+// A limit of 0 is unlimited.
+func UsageToMap(usage *organizationpb.OrganizationUsage) gin.H {
+	if usage == nil {
+		return gin.H{}
+	}
+	limits := usage.GetLimits()
+	return gin.H{
+		"organizationId": usage.GetOrganizationId(),
+		"plan":           usage.GetPlan(),
+		"limits": gin.H{
+			"maxMembers":           limits.GetMaxMembers(),
+			"maxTasks":             limits.GetMaxTasks(),
+			"maxStorageBytes":      limits.GetMaxStorageBytes(),
+			"apiRequestsPerMinute": limits.GetApiRequestsPerMinute(),
+		},
+		"usage": gin.H{
+			"members":      usage.GetMembers(),
+			"tasks":        usage.GetTasks(),
+			"storageBytes": usage.GetStorageBytes(),
+		},
+		"updatedAt": common.TimestampToString(usage.GetUpdatedAt()),
+	}
+}
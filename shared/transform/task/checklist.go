@@ -0,0 +1,35 @@
+package task
+
+import (
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// ChecklistItemToMap converts a checklist item proto into a gin.H map suitable for HTTP responses.
+func ChecklistItemToMap(item *taskpb.ChecklistItem) gin.H {
+	if item == nil {
+		return gin.H{}
+	}
+
+	return gin.H{
+		"id":        item.GetId(),
+		"taskId":    item.GetTaskId(),
+		"text":      item.GetText(),
+		"done":      item.GetDone(),
+		"doneBy":    item.GetDoneBy(),
+		"doneAt":    common.TimestampToString(item.GetDoneAt()),
+		"position":  item.GetPosition(),
+		"createdAt": common.TimestampToString(item.GetCreatedAt()),
+		"updatedAt": common.TimestampToString(item.GetUpdatedAt()),
+	}
+}
+
+// ChecklistToMaps converts a list of checklist item protos into response maps.
+func ChecklistToMaps(items []*taskpb.ChecklistItem) []gin.H {
+	result := make([]gin.H, 0, len(items))
+	for _, item := range items {
+		result = append(result, ChecklistItemToMap(item))
+	}
+	return result
+}
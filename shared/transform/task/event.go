@@ -21,6 +21,7 @@ func ToCreatedEvent(task *taskpb.Task) contracts.TaskCreatedEvent {
 		Priority:       task.GetPriority(),
 		ReporterID:     task.GetReporterId(),
 		AssigneeID:     task.GetAssigneeId(),
+		AssigneeTeamID: task.GetAssigneeTeamId(),
 		DueAt:          common.TimestampToString(task.GetDueAt()),
 		CreatedAt:      common.TimestampToString(task.GetCreatedAt()),
 		UpdatedAt:      common.TimestampToString(task.GetUpdatedAt()),
@@ -0,0 +1,39 @@
+package task
+
+import (
+	taskpb "github.com/aliirah/task-flow/shared/proto/task/v1"
+	"github.com/aliirah/task-flow/shared/transform/common"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportJobToMap converts an import job proto into a gin.H map suitable for HTTP responses.
+func ImportJobToMap(job *taskpb.ImportJob) gin.H {
+	if job == nil {
+		return gin.H{}
+	}
+
+	rowErrors := make([]gin.H, 0, len(job.GetErrors()))
+	for _, rowErr := range job.GetErrors() {
+		rowErrors = append(rowErrors, gin.H{
+			"row":     rowErr.GetRow(),
+			"field":   rowErr.GetField(),
+			"message": rowErr.GetMessage(),
+		})
+	}
+
+	return gin.H{
+		"id":             job.GetId(),
+		"organizationId": job.GetOrganizationId(),
+		"createdBy":      job.GetCreatedBy(),
+		"format":         job.GetFormat(),
+		"status":         job.GetStatus(),
+		"dryRun":         job.GetDryRun(),
+		"totalRows":      job.GetTotalRows(),
+		"processedRows":  job.GetProcessedRows(),
+		"createdCount":   job.GetCreatedCount(),
+		"errors":         rowErrors,
+		"createdAt":      common.TimestampToString(job.GetCreatedAt()),
+		"updatedAt":      common.TimestampToString(job.GetUpdatedAt()),
+		"completedAt":    common.TimestampToString(job.GetCompletedAt()),
+	}
+}
@@ -15,6 +15,10 @@ func ToMap(task *taskpb.Task) gin.H {
 	if task == nil {
 		return gin.H{}
 	}
+	sharedWith := task.GetSharedWith()
+	if sharedWith == nil {
+		sharedWith = []string{}
+	}
 	return gin.H{
 		"id":             task.GetId(),
 		"title":          task.GetTitle(),
@@ -24,12 +28,18 @@ func ToMap(task *taskpb.Task) gin.H {
 		"type":           task.GetType(),
 		"organizationId": task.GetOrganizationId(),
 		"assigneeId":     task.GetAssigneeId(),
+		"assigneeTeamId": task.GetAssigneeTeamId(),
 		"reporterId":     task.GetReporterId(),
 		"parentTaskId":   task.GetParentTaskId(),
 		"displayOrder":   task.GetDisplayOrder(),
+		"checklistTotal": task.GetChecklistTotal(),
+		"checklistDone":  task.GetChecklistDone(),
 		"dueAt":          common.TimestampToString(task.GetDueAt()),
 		"createdAt":      common.TimestampToString(task.GetCreatedAt()),
 		"updatedAt":      common.TimestampToString(task.GetUpdatedAt()),
+		"archivedAt":     common.TimestampToString(task.GetArchivedAt()),
+		"purgeAt":        common.TimestampToString(task.GetPurgeAt()),
+		"sharedWith":     sharedWith,
 	}
 }
 
@@ -107,14 +107,14 @@ export default function OrganizationEditPage() {
   })
 
   const onDelete = async () => {
-    if (!organizationId) {
+    if (!organizationId || !organization) {
       toast.error('Invalid organization')
       return
     }
     try {
       setDeleting(true)
-      await organizationApi.remove(organizationId)
-      toast.success('Organization deleted')
+      await organizationApi.remove(organizationId, organization.name)
+      toast.success('Organization scheduled for deletion')
       router.push('/dashboard/organizations')
       router.refresh()
     } catch (error) {
@@ -26,7 +26,7 @@ import { useAuthStore } from '@/store/auth'
 const memberSchema = z.object({
   email: z.string().email('Enter a valid email address'),
   userId: z.string().min(1, 'Select a user from the dropdown'),
-  role: z.enum(['owner', 'admin', 'member']),
+  role: z.enum(['admin', 'member', 'guest']),
 })
 
 type MemberFormValues = z.infer<typeof memberSchema>
@@ -35,6 +35,7 @@ const ROLE_LABELS: Record<string, string> = {
   owner: 'Owner',
   admin: 'Admin',
   member: 'Member',
+  guest: 'Guest',
 }
 
 export default function OrganizationMembersPage() {
@@ -287,7 +288,7 @@ export default function OrganizationMembersPage() {
               <Select {...form.register('role')}>
                 <option value="member">Member</option>
                 <option value="admin">Admin</option>
-                <option value="owner">Owner</option>
+                <option value="guest">Guest</option>
               </Select>
               {form.formState.errors.role && (
                 <p className="text-xs text-rose-500">
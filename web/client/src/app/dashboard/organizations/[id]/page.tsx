@@ -22,6 +22,7 @@ const ROLE_LABELS: Record<string, string> = {
   owner: 'Owner',
   admin: 'Admin',
   member: 'Member',
+  guest: 'Guest',
 }
 
 export default function OrganizationDetailPage() {
@@ -74,7 +74,7 @@ type OrganizationFormValues = z.infer<typeof organizationFormSchema>
 const memberFormSchema = z.object({
   email: z.string().email('Enter a valid email address'),
   userId: z.string().min(1, 'Select a user from the dropdown'),
-  role: z.enum(['owner', 'admin', 'member']),
+  role: z.enum(['admin', 'member', 'guest']),
 })
 type MemberFormValues = z.infer<typeof memberFormSchema>
 
@@ -104,6 +104,7 @@ const ROLE_LABELS: Record<string, string> = {
   owner: 'Owner',
   admin: 'Admin',
   member: 'Member',
+  guest: 'Guest',
 }
 
 const PAGE_SIZE = 10
@@ -387,8 +388,8 @@ const taskForm = useForm<TaskFormValues>({
   const handleDeleteOrganization = async () => {
     if (!deleteOrgTarget) return
     try {
-      await organizationApi.remove(deleteOrgTarget.id)
-      toast.success('Organization removed')
+      await organizationApi.remove(deleteOrgTarget.id, deleteOrgTarget.name)
+      toast.success('Organization scheduled for deletion')
       setDeleteOrgTarget(null)
       await refreshOrganizations()
     } catch (error) {
@@ -1160,7 +1161,7 @@ const taskForm = useForm<TaskFormValues>({
             <Select {...memberForm.register('role')}>
               <option value="member">Member</option>
               <option value="admin">Admin</option>
-              <option value="owner">Owner</option>
+              <option value="guest">Guest</option>
             </Select>
           </div>
         </form>
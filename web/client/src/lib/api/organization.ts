@@ -1,7 +1,15 @@
 'use client'
 
 import { apiClient } from './client'
-import type { Organization, OrganizationMember } from '@/lib/types/api'
+import type {
+  Organization,
+  OrganizationDomain,
+  OrganizationInvitation,
+  OrganizationMember,
+  OrganizationSettings,
+  OrganizationUsage,
+  Team,
+} from '@/lib/types/api'
 
 type RequestOptions = RequestInit | undefined
 
@@ -19,8 +27,30 @@ export const organizationApi = {
       method: 'PATCH',
       body: JSON.stringify(payload),
     }),
-  remove: (id: string) =>
-    apiClient<void>(`/api/organizations/${id}`, { method: 'DELETE' }),
+  remove: (id: string, confirmName: string) =>
+    apiClient<Organization>(`/api/organizations/${id}`, {
+      method: 'DELETE',
+      body: JSON.stringify({ confirmName }),
+    }),
+  cancelDeletion: (id: string) =>
+    apiClient<Organization>(`/api/organizations/${id}/deletion/cancel`, { method: 'POST' }),
+  transferOwnership: (id: string, newOwnerId: string) =>
+    apiClient<Organization>(`/api/organizations/${id}/transfer`, {
+      method: 'POST',
+      body: JSON.stringify({ newOwnerId }),
+    }),
+  getSettings: (id: string, options?: RequestOptions) =>
+    apiClient<OrganizationSettings>(`/api/organizations/${id}/settings`, options),
+  updateSettings: (
+    id: string,
+    payload: Pick<OrganizationSettings, 'tasks' | 'notifications' | 'security' | 'membership'> & { version?: number },
+  ) =>
+    apiClient<OrganizationSettings>(`/api/organizations/${id}/settings`, {
+      method: 'PUT',
+      body: JSON.stringify(payload),
+    }),
+  getUsage: (id: string, options?: RequestOptions) =>
+    apiClient<OrganizationUsage>(`/api/organizations/${id}/usage`, options),
   listMembers: (id: string, options?: RequestOptions) =>
     apiClient<{ items: OrganizationMember[] }>(`/api/organizations/${id}/members`, options),
   addMember: (id: string, payload: { userId: string; role?: string }) =>
@@ -32,4 +62,85 @@ export const organizationApi = {
     apiClient<void>(`/api/organizations/${orgId}/members/${userId}`, {
       method: 'DELETE',
     }),
+  suspendMember: (orgId: string, userId: string) =>
+    apiClient<OrganizationMember>(`/api/organizations/${orgId}/members/${userId}/suspend`, {
+      method: 'POST',
+    }),
+  reactivateMember: (orgId: string, userId: string) =>
+    apiClient<OrganizationMember>(`/api/organizations/${orgId}/members/${userId}/reactivate`, {
+      method: 'POST',
+    }),
+  approveMember: (orgId: string, userId: string) =>
+    apiClient<OrganizationMember>(`/api/organizations/${orgId}/members/${userId}/approve`, {
+      method: 'POST',
+    }),
+  leave: (orgId: string) =>
+    apiClient<void>(`/api/organizations/${orgId}/leave`, {
+      method: 'POST',
+    }),
+  listInvitations: (id: string, options?: RequestOptions) =>
+    apiClient<{ items: OrganizationInvitation[] }>(`/api/organizations/${id}/invitations`, options),
+  invite: (id: string, payload: { email: string; role?: string }) =>
+    apiClient<OrganizationInvitation>(`/api/organizations/${id}/invitations`, {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    }),
+  resendInvitation: (orgId: string, invitationId: string) =>
+    apiClient<OrganizationInvitation>(`/api/organizations/${orgId}/invitations/${invitationId}/resend`, {
+      method: 'POST',
+    }),
+  revokeInvitation: (orgId: string, invitationId: string) =>
+    apiClient<void>(`/api/organizations/${orgId}/invitations/${invitationId}`, {
+      method: 'DELETE',
+    }),
+  listMyInvitations: (options?: RequestOptions) =>
+    apiClient<{ items: OrganizationInvitation[] }>('/api/invitations', options),
+  acceptInvitation: (invitationId: string) =>
+    apiClient<OrganizationMember>(`/api/invitations/${invitationId}/accept`, { method: 'POST' }),
+  declineInvitation: (invitationId: string) =>
+    apiClient<void>(`/api/invitations/${invitationId}/decline`, { method: 'POST' }),
+  acceptInvitationToken: (token: string) =>
+    apiClient<OrganizationMember>('/api/invitations/accept', {
+      method: 'POST',
+      body: JSON.stringify({ token }),
+    }),
+  listTeams: (orgId: string, options?: RequestOptions) =>
+    apiClient<{ items: Team[] }>(`/api/organizations/${orgId}/teams`, options),
+  getTeam: (orgId: string, teamId: string, options?: RequestOptions) =>
+    apiClient<Team>(`/api/organizations/${orgId}/teams/${teamId}`, options),
+  createTeam: (orgId: string, payload: { name: string; description?: string; leadId?: string; memberIds?: string[] }) =>
+    apiClient<Team>(`/api/organizations/${orgId}/teams`, {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    }),
+  updateTeam: (orgId: string, teamId: string, payload: Partial<{ name: string; description: string; leadId: string }>) =>
+    apiClient<Team>(`/api/organizations/${orgId}/teams/${teamId}`, {
+      method: 'PATCH',
+      body: JSON.stringify(payload),
+    }),
+  deleteTeam: (orgId: string, teamId: string) =>
+    apiClient<void>(`/api/organizations/${orgId}/teams/${teamId}`, { method: 'DELETE' }),
+  addTeamMember: (orgId: string, teamId: string, userId: string) =>
+    apiClient<Team>(`/api/organizations/${orgId}/teams/${teamId}/members`, {
+      method: 'POST',
+      body: JSON.stringify({ userId }),
+    }),
+  removeTeamMember: (orgId: string, teamId: string, userId: string) =>
+    apiClient<Team>(`/api/organizations/${orgId}/teams/${teamId}/members/${userId}`, { method: 'DELETE' }),
+  listDomains: (orgId: string, options?: RequestOptions) =>
+    apiClient<{ items: OrganizationDomain[] }>(`/api/organizations/${orgId}/domains`, options),
+  addDomain: (orgId: string, payload: { domain: string; defaultRole?: string; autoJoin?: boolean }) =>
+    apiClient<OrganizationDomain>(`/api/organizations/${orgId}/domains`, {
+      method: 'POST',
+      body: JSON.stringify(payload),
+    }),
+  updateDomain: (orgId: string, domainId: string, payload: Partial<{ defaultRole: string; autoJoin: boolean }>) =>
+    apiClient<OrganizationDomain>(`/api/organizations/${orgId}/domains/${domainId}`, {
+      method: 'PATCH',
+      body: JSON.stringify(payload),
+    }),
+  verifyDomain: (orgId: string, domainId: string) =>
+    apiClient<OrganizationDomain>(`/api/organizations/${orgId}/domains/${domainId}/verify`, { method: 'POST' }),
+  removeDomain: (orgId: string, domainId: string) =>
+    apiClient<void>(`/api/organizations/${orgId}/domains/${domainId}`, { method: 'DELETE' }),
 }
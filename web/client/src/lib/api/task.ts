@@ -78,4 +78,11 @@ export const taskApi = {
       method: 'POST',
       body: JSON.stringify(payload),
     }),
+  share: (id: string, userId: string) =>
+    apiClient<Task>(`/api/tasks/${id}/shares`, {
+      method: 'POST',
+      body: JSON.stringify({ userId }),
+    }),
+  unshare: (id: string, userId: string) =>
+    apiClient<Task>(`/api/tasks/${id}/shares/${userId}`, { method: 'DELETE' }),
 }
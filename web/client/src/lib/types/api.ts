@@ -36,6 +36,7 @@ export interface RegisterData {
   password: string
   firstName: string
   lastName: string
+  invitationToken?: string
 }
 
 type ApiErrorPayload = {
@@ -100,19 +101,107 @@ export type Organization = {
   ownerId: string
   createdAt?: string
   updatedAt?: string
+  plan?: OrganizationPlan
+  deletionRequestedAt?: string
+  deleteAfter?: string
 }
 
 export type OrganizationMember = {
   id: string
   organizationId: string
   userId: string
-  role: 'owner' | 'admin' | 'member'
-  status: string
+  role: 'owner' | 'admin' | 'member' | 'guest'
+  status: 'active' | 'pending' | 'suspended' | 'left'
   createdAt?: string
   user?: User
   organization?: Organization
 }
 
+export type OrganizationInvitation = {
+  id: string
+  organizationId: string
+  organizationName: string
+  email: string
+  role: 'admin' | 'member' | 'guest'
+  inviterId?: string
+  status: 'pending' | 'accepted' | 'declined' | 'revoked' | 'expired'
+  expiresAt: string
+  createdAt?: string
+  respondedAt?: string
+  // Set when offered because the user's email matches a verified domain
+  domain?: string
+}
+
+export type OrganizationDomain = {
+  id: string
+  organizationId: string
+  domain: string
+  defaultRole: 'admin' | 'member' | 'guest'
+  autoJoin: boolean
+  verified: boolean
+  verification: {
+    type: 'TXT'
+    name: string
+    value: string
+  }
+  verifiedAt?: string
+  createdAt?: string
+}
+
+export type OrganizationSettings = {
+  organizationId: string
+  version: number
+  schemaVersion: number
+  tasks: {
+    defaultPriority: TaskPriority
+    allowedTypes: TaskType[]
+  }
+  notifications: {
+    mutedEvents: string[]
+  }
+  security: {
+    requireTwoFactor: boolean
+  }
+  membership: {
+    requireApproval: boolean
+  }
+  retention: {
+    archivedTaskDays: number
+  }
+  updatedAt?: string
+}
+
+export type OrganizationPlan = 'free' | 'team' | 'business'
+
+// A limit of 0 is unlimited
+export type OrganizationUsage = {
+  organizationId: string
+  plan: OrganizationPlan
+  limits: {
+    maxMembers: number
+    maxTasks: number
+    maxStorageBytes: number
+    apiRequestsPerMinute: number
+  }
+  usage: {
+    members: number
+    tasks: number
+    storageBytes: number
+  }
+  updatedAt?: string
+}
+
+export type Team = {
+  id: string
+  organizationId: string
+  name: string
+  description?: string
+  leadId?: string
+  memberIds: string[]
+  createdAt?: string
+  updatedAt?: string
+}
+
 export type TaskStatus = 'open' | 'in_progress' | 'completed' | 'blocked' | 'cancelled'
 export type TaskPriority = 'low' | 'medium' | 'high' | 'critical'
 export type TaskType = 'task' | 'story' | 'sub-task'
@@ -126,9 +215,11 @@ export type Task = {
   type: TaskType
   organizationId: string
   assigneeId?: string
+  assigneeTeamId?: string
   reporterId?: string
   parentTaskId?: string
   displayOrder: number
+  sharedWith?: string[]
   dueAt?: string
   createdAt?: string
   updatedAt?: string